@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/backtest"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id to replay")
+	from := flag.Int("from", 2, "first gameweek to replay")
+	to := flag.Int("to", 0, "last gameweek to replay (default: last finished gameweek)")
+	out := flag.String("out", cfg.Backtest.ExportDir, "directory for the CSV export")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	runner := &backtest.Runner{
+		Inputs:     projection.NewRepo(fplDb.DB()),
+		Repo:       backtest.NewRepo(fplDb.DB()),
+		FormWindow: cfg.Projection.FormWindow,
+	}
+
+	report, err := runner.Run(ctx, &backtest.ProjectionPredictor{Model: projection.NewModel()}, *season, *from, *to)
+	if err != nil {
+		log.Fatalf("Error running backtest: %v", err)
+	}
+
+	if err := runner.Repo.SaveReport(ctx, report); err != nil {
+		log.Fatalf("Error saving backtest report: %v", err)
+	}
+
+	paths, err := backtest.ExportCSV(*out, report)
+	if err != nil {
+		log.Fatalf("Error exporting backtest report: %v", err)
+	}
+
+	for _, m := range report.Metrics {
+		log.Printf("%-10s %-8s n=%-6d MAE=%.3f RMSE=%.3f bias=%+.3f", m.SegmentType, m.Segment, m.N, m.MAE, m.RMSE, m.Bias)
+	}
+	log.Printf("Backtest run %d saved, CSV written to %v", report.RunID, paths)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/captaincy"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	managerID := flag.Int("manager", 0, "FPL manager id")
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	event := flag.Int("event", 0, "gameweek to captain (0 = next unfinished)")
+	sampleIDs := flag.String("sample", "", "comma-separated manager ids to measure ownership in (default: every indexed manager)")
+	flag.Parse()
+
+	if *managerID == 0 {
+		log.Fatal("-manager is required")
+	}
+	sample, err := parseIDs(*sampleIDs)
+	if err != nil {
+		log.Fatalf("Invalid -sample: %v", err)
+	}
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	service := &captaincy.Service{
+		Repo:         captaincy.NewRepo(fplDb.DB()),
+		Projections:  projection.NewRepo(fplDb.DB()),
+		ModelVersion: projection.ModelVersion,
+		SquadPlay:    cfg.Squad.SquadPlay,
+		Options: captaincy.Options{
+			RiskAversion:   cfg.Captaincy.RiskAversion,
+			DifferentialEO: cfg.Captaincy.DifferentialEO,
+		},
+	}
+
+	ranking, field, err := service.RankManager(ctx, *managerID, *season, *event, sample)
+	if err != nil {
+		log.Fatalf("Error ranking captains: %v", err)
+	}
+
+	log.Printf("Effective ownership from %d managers' GW%d picks", field.Managers, field.Event)
+	for i, c := range ranking.Candidates {
+		log.Printf("%2d. %-15s %5.2f xP (±%.2f), EO %5.1f%%, captained %5.1f%%, selected by %5.1f%%, floor %5.2f, ceiling %5.2f",
+			i+1, c.Name, c.XPoints, c.RelativeStdDev, 100*c.EO, 100*c.Captained, c.SelectedBy, c.Floor, c.Ceiling)
+	}
+
+	if ranking.Safe != nil {
+		log.Printf("Safe pick: %s (%.2f xP, EO %.1f%%)", ranking.Safe.Name, ranking.Safe.XPoints, 100*ranking.Safe.EO)
+	}
+	if ranking.Differential != nil {
+		log.Printf("Differential: %s (%.2f xP, EO %.1f%%)", ranking.Differential.Name, ranking.Differential.XPoints, 100*ranking.Differential.EO)
+	}
+}
+
+func parseIDs(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var ids []int
+	for _, part := range strings.Split(s, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/chips"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+	"github.com/imadeddine-belkat/analytics-service/internal/transfers"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	managerID := flag.Int("manager", 0, "FPL manager id")
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	horizon := flag.Int("horizon", cfg.Projection.Horizon, "number of projected gameweeks to simulate")
+	flag.Parse()
+
+	if *managerID == 0 {
+		log.Fatal("-manager is required")
+	}
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	squadRules := squad.DefaultRules()
+	squadRules.SquadSize = cfg.Squad.SquadSize
+	squadRules.SquadPlay = cfg.Squad.SquadPlay
+	squadRules.TeamLimit = cfg.Squad.TeamLimit
+
+	transferRules := transfers.Rules{
+		MaxFreeTransfers: cfg.Transfers.MaxFreeTransfers,
+		HitCost:          cfg.Transfers.HitCost,
+		SellOnFee:        cfg.Transfers.SellOnFee,
+	}
+
+	service := &chips.Service{
+		Repo: chips.NewRepo(fplDb.DB()),
+		Transfers: &transfers.Service{
+			Repo:         transfers.NewRepo(fplDb.DB()),
+			Squads:       squad.NewRepo(fplDb.DB()),
+			Projections:  projection.NewRepo(fplDb.DB()),
+			ModelVersion: projection.ModelVersion,
+			Planner:      &transfers.Planner{Squad: squadRules, Rules: transferRules, Options: transfers.DefaultOptions()},
+		},
+		Rules: chips.Rules{
+			SecondHalfEvent: cfg.Chips.SecondHalfEvent,
+			LastEvent:       cfg.Chips.LastEvent,
+		},
+		Advisor: &chips.Advisor{
+			Squad:       squadRules,
+			SellOnFee:   cfg.Transfers.SellOnFee,
+			BenchWeight: cfg.Squad.BenchWeight,
+		},
+	}
+
+	manager, advice, err := service.AdviseManager(ctx, *managerID, *season, *horizon)
+	if err != nil {
+		log.Fatalf("Error advising chips: %v", err)
+	}
+
+	for _, gw := range advice.Calendar {
+		if gw.IsBlank() || gw.IsDouble() {
+			log.Printf("GW%d: %d fixtures, blank for teams %v, double for teams %v", gw.Event, gw.Fixtures, gw.Blank, gw.Double)
+		}
+	}
+
+	for _, sim := range advice.Simulations {
+		log.Printf("GW%d: %.2f points; WC %+.2f, FH %+.2f, BB %+.2f, TC %+.2f", sim.Event, sim.Points,
+			sim.Gains[transfers.ChipWildcard], sim.Gains[transfers.ChipFreeHit],
+			sim.Gains[transfers.ChipBenchBoost], sim.Gains[transfers.ChipTripleCaptain])
+	}
+
+	log.Printf("Manager %d after GW%d:", manager.ManagerID, manager.Event)
+	for _, r := range advice.Recommendations {
+		if r.Event == 0 {
+			log.Printf("  %s (GW%d-%d): hold, no projected gameweek worth it", r.Chip, r.FromEvent, r.ToEvent)
+			continue
+		}
+		log.Printf("  %s (GW%d-%d): play in GW%d for %+.2f points (blank=%t, double=%t)",
+			r.Chip, r.FromEvent, r.ToEvent, r.Event, r.Gain, r.Blank, r.Double)
+	}
+}
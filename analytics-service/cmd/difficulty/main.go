@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/difficulty"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	sofascoreSeason := flag.Int("sofascore-season", cfg.Difficulty.SofascoreSeasonID, "Sofascore season id for match xG (0 = FPL xG only)")
+	from := flag.Int("from", 0, "first gameweek to rate (0 = next unfinished)")
+	to := flag.Int("to", 0, "last gameweek to rate (0 = end of the projection horizon)")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	service := &difficulty.Service{
+		Repo:        difficulty.NewRepo(fplDb.DB()),
+		Projections: projection.NewRepo(fplDb.DB()),
+		Options: difficulty.Options{
+			HalfLife:     cfg.Difficulty.HalfLife,
+			PriorHomeXG:  cfg.Difficulty.PriorHomeXG,
+			PriorAwayXG:  cfg.Difficulty.PriorAwayXG,
+			PriorMatches: cfg.Difficulty.PriorMatches,
+		},
+		Horizon: cfg.Projection.Horizon,
+	}
+
+	if *sofascoreSeason != 0 {
+		sofascoreDb, err := connection.NewRepository(
+			cfg.Postgres.Host,
+			cfg.Postgres.Port,
+			cfg.Postgres.User,
+			cfg.Postgres.Password,
+			cfg.Postgres.SofascoreDatabase,
+			cfg.Postgres.SSLMode,
+		)
+		if err != nil {
+			log.Printf("Sofascore database unavailable, rating on FPL xG: %v", err)
+		} else {
+			defer sofascoreDb.Close()
+			service.Sofascore = difficulty.NewSofascoreRepo(sofascoreDb.DB())
+		}
+	}
+
+	result, err := service.Run(ctx, *season, *sofascoreSeason, *from, *to)
+	if err != nil {
+		log.Fatalf("Error rating fixtures: %v", err)
+	}
+
+	log.Printf("Rated GW%d-%d from %d matches by xG source %v", result.FromEvent, result.ToEvent, sumSources(result.Sources), result.Sources)
+	for _, d := range result.Difficulties {
+		venue := "A"
+		if d.WasHome {
+			venue = "H"
+		}
+		log.Printf("GW%-2d team %2d vs %2d (%s): xG %.2f-%.2f, attack %.1f, defence %.1f, FPL %d",
+			d.Event, d.TeamID, d.OpponentTeamID, venue, d.XGoalsFor, d.XGoalsAgainst,
+			d.AttackDifficulty, d.DefenceDifficulty, d.FPLDifficulty)
+	}
+}
+
+func sumSources(sources map[string]int) int {
+	n := 0
+	for _, c := range sources {
+		n += c
+	}
+	return n
+}
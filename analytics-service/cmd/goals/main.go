@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/difficulty"
+	"github.com/imadeddine-belkat/analytics-service/internal/goals"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	kafkaConfig "github.com/imadeddine-belkat/tactify-kafka/config"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	sofascoreSeason := flag.Int("sofascore-season", cfg.Difficulty.SofascoreSeasonID, "Sofascore season id for match xG (0 = FPL xG only)")
+	from := flag.Int("from", 0, "first gameweek (0 = next unfinished)")
+	to := flag.Int("to", 0, "last gameweek (0 = end of the projection horizon)")
+	publish := flag.Bool("publish", true, "publish the probabilities to Kafka")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	rater := &difficulty.Service{
+		Repo:        difficulty.NewRepo(fplDb.DB()),
+		Projections: projection.NewRepo(fplDb.DB()),
+		Options: difficulty.Options{
+			HalfLife:     cfg.Difficulty.HalfLife,
+			PriorHomeXG:  cfg.Difficulty.PriorHomeXG,
+			PriorAwayXG:  cfg.Difficulty.PriorAwayXG,
+			PriorMatches: cfg.Difficulty.PriorMatches,
+		},
+		Horizon: cfg.Projection.Horizon,
+	}
+
+	if *sofascoreSeason != 0 {
+		sofascoreDb, err := connection.NewRepository(
+			cfg.Postgres.Host,
+			cfg.Postgres.Port,
+			cfg.Postgres.User,
+			cfg.Postgres.Password,
+			cfg.Postgres.SofascoreDatabase,
+			cfg.Postgres.SSLMode,
+		)
+		if err != nil {
+			log.Printf("Sofascore database unavailable, rating on FPL xG: %v", err)
+		} else {
+			defer sofascoreDb.Close()
+			rater.Sofascore = difficulty.NewSofascoreRepo(sofascoreDb.DB())
+		}
+	}
+
+	service := &goals.Service{
+		Repo:       goals.NewRepo(fplDb.DB()),
+		Difficulty: rater,
+	}
+	if *publish {
+		producer := kafka.NewProducer()
+		defer producer.Close()
+		service.Producer = producer
+		service.Topic = kafkaConfig.LoadConfig().TopicsName.FplGoalProbabilities.Name
+	}
+
+	probabilities, err := service.Run(ctx, *season, *sofascoreSeason, *from, *to)
+	if err != nil {
+		log.Fatalf("Error computing goal probabilities: %v", err)
+	}
+
+	for _, p := range probabilities {
+		log.Printf("GW%-2d fixture %3d: team %2d xG %.2f CS %.2f | team %2d xG %.2f CS %.2f | goals %.2f/%.2f/%.2f/%.2f - %.2f/%.2f/%.2f/%.2f",
+			p.Event, p.FixtureID,
+			p.Home.TeamID, p.Home.ExpectedGoals, p.Home.CleanSheet,
+			p.Away.TeamID, p.Away.ExpectedGoals, p.Away.CleanSheet,
+			p.Home.Goals[0], p.Home.Goals[1], p.Home.Goals[2], p.Home.Goals[3],
+			p.Away.Goals[0], p.Away.Goals[1], p.Away.Goals[2], p.Away.Goals[3])
+	}
+}
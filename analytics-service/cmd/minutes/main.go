@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"sort"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/minutes"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	sofascoreSeason := flag.Int("sofascore-season", cfg.Difficulty.SofascoreSeasonID, "Sofascore season id for lineups and cup matches (0 = FPL only)")
+	event := flag.Int("event", 0, "gameweek to predict (0 = next unfinished)")
+	top := flag.Int("top", 20, "number of predictions to print, most minutes first")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	opts := minutes.DefaultOptions()
+	opts.HalfLife = cfg.Minutes.HalfLife
+	opts.ShortRestDays = cfg.Minutes.ShortRestDays
+	opts.ShortRestPenalty = cfg.Minutes.ShortRestPenalty
+	opts.MissingFactor = cfg.Minutes.MissingFactor
+	opts.DoubtfulFactor = cfg.Minutes.DoubtfulFactor
+
+	service := &minutes.Service{
+		Repo:         minutes.NewRepo(fplDb.DB()),
+		Projections:  projection.NewRepo(fplDb.DB()),
+		Options:      opts,
+		LookbackDays: cfg.Minutes.LookbackDays,
+	}
+
+	if *sofascoreSeason != 0 {
+		sofascoreDb, err := connection.NewRepository(
+			cfg.Postgres.Host,
+			cfg.Postgres.Port,
+			cfg.Postgres.User,
+			cfg.Postgres.Password,
+			cfg.Postgres.SofascoreDatabase,
+			cfg.Postgres.SSLMode,
+		)
+		if err != nil {
+			log.Printf("Sofascore database unavailable, predicting from FPL only: %v", err)
+		} else {
+			defer sofascoreDb.Close()
+			service.Sofascore = minutes.NewSofascoreRepo(sofascoreDb.DB())
+		}
+	}
+
+	predictions, err := service.Run(ctx, *season, *sofascoreSeason, *event)
+	if err != nil {
+		log.Fatalf("Error predicting minutes: %v", err)
+	}
+
+	log.Printf("Predicted %d player fixtures", len(predictions))
+	sort.Slice(predictions, func(i, j int) bool { return predictions[i].ExpectedMinutes > predictions[j].ExpectedMinutes })
+	for i, p := range predictions {
+		if i == *top {
+			break
+		}
+		log.Printf("GW%-2d fixture %3d player %4d: start %.2f, sub %.2f, xMins %.1f, availability %.2f",
+			p.Event, p.FixtureID, p.PlayerID, p.StartProbability, p.SubProbability, p.ExpectedMinutes, p.Availability)
+	}
+}
@@ -0,0 +1,138 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/prices"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+	httpx "github.com/imadeddine-belkat/tactify-http"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	interval := flag.Duration("interval", cfg.Prices.Interval, "time between snapshots (0 = snapshot once)")
+	reportOnly := flag.Bool("report", false, "print the report without taking a snapshot")
+	top := flag.Int("top", 10, "players listed per direction")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	service := &prices.Service{
+		Repo:        prices.NewRepo(fplDb.DB()),
+		Projections: projection.NewRepo(fplDb.DB()),
+		Options: prices.Options{
+			TotalManagers: cfg.Prices.TotalManagers,
+			BaseThreshold: cfg.Prices.BaseThreshold,
+			RiseFraction:  cfg.Prices.RiseFraction,
+			FallFraction:  cfg.Prices.FallFraction,
+		},
+	}
+
+	if *reportOnly {
+		if err := printReport(ctx, service, *season, *top); err != nil {
+			log.Fatalf("Error %v", err)
+		}
+		return
+	}
+
+	if *interval > 0 {
+		go httpx.Serve(ctx, cfg.MetricsAddr)
+	}
+
+	for {
+		if err := snapshot(ctx, service, *season, *top); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			// A daemon waits for the next tick rather than dying on one
+			// failed run.
+			if *interval <= 0 {
+				log.Fatalf("Error %v", err)
+			}
+			log.Printf("Error %v, retrying in %s", err, *interval)
+		}
+
+		if *interval <= 0 {
+			return
+		}
+		timer := time.NewTimer(*interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// snapshot takes a price snapshot and prints the report that follows it.
+func snapshot(ctx context.Context, service *prices.Service, season, top int) error {
+	run, err := service.Snapshot(ctx, season)
+	if err != nil {
+		return fmt.Errorf("taking price snapshot: %w", err)
+	}
+	log.Printf("GW%d snapshot: %d players refreshed, %d price changes", run.Event, len(run.Snapshots), len(run.Changes))
+	return printReport(ctx, service, season, top)
+}
+
+func printReport(ctx context.Context, service *prices.Service, season, top int) error {
+	report, err := service.Report(ctx, season, top, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		return fmt.Errorf("building price report: %w", err)
+	}
+
+	log.Printf("Price changes in the last 24h")
+	for _, c := range report.Changes {
+		direction := "fell"
+		if c.Rise() {
+			direction = "rose"
+		}
+		log.Printf("  %-15s %s %.1f -> %.1f (net %+d, selected by %.1f%%)",
+			c.Name, direction, float64(c.OldCost)/10, float64(c.NewCost)/10, c.NetTransfers, c.SelectedBy)
+	}
+
+	log.Printf("Closest to a rise")
+	for _, p := range report.Risers {
+		log.Printf("  %-15s %5.1f %6.1f%% (net %+d of %d, %+.0f/h, %s)",
+			p.Name, float64(p.NowCost)/10, 100*p.Progress, p.NetSinceChange, p.RiseThreshold, p.HourlyRate, eta(p))
+	}
+
+	log.Printf("Closest to a fall")
+	for _, p := range report.Fallers {
+		log.Printf("  %-15s %5.1f %6.1f%% (net %+d of -%d, %+.0f/h, %s)",
+			p.Name, float64(p.NowCost)/10, 100*p.Progress, p.NetSinceChange, p.FallThreshold, p.HourlyRate, eta(p))
+	}
+	return nil
+}
+
+func eta(p prices.Progress) string {
+	if p.ETAHours == nil {
+		return "no trend"
+	}
+	return "due in " + (time.Duration(*p.ETAHours * float64(time.Hour))).Round(time.Minute).String()
+}
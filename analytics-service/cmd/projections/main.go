@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	from := flag.Int("from", 0, "first gameweek to project (default: next unfinished gameweek)")
+	horizon := flag.Int("horizon", cfg.Projection.Horizon, "number of gameweeks to project")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	service := &projection.Service{
+		Repo:  projection.NewRepo(fplDb.DB()),
+		Model: projection.NewModel(),
+	}
+
+	if *from > 0 {
+		_, err = service.Project(ctx, *season, *from, *horizon, cfg.Projection.FormWindow)
+	} else {
+		_, err = service.ProjectNext(ctx, *season, *horizon, cfg.Projection.FormWindow)
+	}
+	if err != nil {
+		log.Fatalf("Error projecting expected points: %v", err)
+	}
+
+	log.Println("Projections completed successfully")
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/ratings"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	league := flag.String("league", "", "league to rate: fpl or sofascore-<league id> (empty = every league)")
+	fromSeason := flag.Int("from-season", 0, "rebuild the Elo history from this season on (0 = latest season)")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	service := &ratings.Service{
+		Repo: ratings.NewRepo(fplDb.DB()),
+		Elo: ratings.EloOptions{
+			K:             cfg.Ratings.EloK,
+			HomeAdvantage: cfg.Ratings.EloHomeAdvantage,
+			Initial:       cfg.Ratings.EloInitial,
+			NewTeam:       cfg.Ratings.EloNewTeam,
+			CarryOver:     cfg.Ratings.EloCarryOver,
+		},
+		DixonColes: ratings.DixonColesOptions{
+			HalfLifeDays: cfg.Ratings.DCHalfLifeDays,
+			Iterations:   ratings.DefaultDixonColesOptions().Iterations,
+			MaxGoals:     cfg.Ratings.DCMaxGoals,
+		},
+		HorizonDays: cfg.Ratings.HorizonDays,
+	}
+
+	if *league != string(ratings.FPLLeague) {
+		sofascoreDb, err := connection.NewRepository(
+			cfg.Postgres.Host,
+			cfg.Postgres.Port,
+			cfg.Postgres.User,
+			cfg.Postgres.Password,
+			cfg.Postgres.SofascoreDatabase,
+			cfg.Postgres.SSLMode,
+		)
+		if err != nil {
+			log.Printf("Sofascore database unavailable, rating FPL only: %v", err)
+		} else {
+			defer sofascoreDb.Close()
+			service.Sofascore = ratings.NewSofascoreRepo(sofascoreDb.DB())
+		}
+	}
+
+	leagues := []ratings.League{ratings.League(*league)}
+	if *league == "" {
+		if leagues, err = service.Leagues(ctx); err != nil {
+			log.Fatalf("Error listing leagues: %v", err)
+		}
+	}
+
+	now := time.Now()
+	for _, l := range leagues {
+		summary, err := service.Run(ctx, l, *fromSeason, now)
+		if err != nil {
+			log.Fatalf("Error rating %s: %v", l, err)
+		}
+
+		log.Printf("%s: rated %d results, rewrote seasons %v, home advantage %.2f, rho %.2f",
+			l, summary.Results, summary.Seasons, summary.Fit.Home, summary.Fit.Rho)
+		for _, p := range summary.Predictions {
+			log.Printf("%s %5d vs %5d: Elo %.2f/%.2f/%.2f, Dixon-Coles %.2f/%.2f/%.2f, xG %.2f-%.2f",
+				p.Kickoff.Format("2006-01-02"), p.Home, p.Away,
+				p.Elo.Home, p.Elo.Draw, p.Elo.Away,
+				p.DixonColes.Home, p.DixonColes.Draw, p.DixonColes.Away,
+				p.XGoalsHome, p.XGoalsAway)
+		}
+	}
+}
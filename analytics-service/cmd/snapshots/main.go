@@ -0,0 +1,54 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/snapshots"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	event := flag.Int("event", 0, "gameweek to rehydrate (0 = every gameweek with a snapshot)")
+	version := flag.Int("version", 0, "snapshot version of the gameweek (0 = latest)")
+	flag.Parse()
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	service := &snapshots.Service{Repo: snapshots.NewRepo(fplDb.DB())}
+
+	var loaded []snapshots.Snapshot
+	if *event == 0 {
+		loaded, err = service.RehydrateAll(ctx, *season)
+	} else {
+		var s snapshots.Snapshot
+		s, err = service.Rehydrate(ctx, *season, *event, *version)
+		loaded = append(loaded, s)
+	}
+	if err != nil {
+		log.Fatalf("Error rehydrating snapshots: %v", err)
+	}
+
+	for _, s := range loaded {
+		log.Printf("GW%-2d v%d: deadline %s, captured %s",
+			s.Event, s.Version, s.DeadlineTime.Format("2006-01-02 15:04"), s.CapturedAt.Format("2006-01-02 15:04"))
+	}
+}
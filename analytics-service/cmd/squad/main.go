@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	from := flag.Int("from", 0, "first gameweek to optimise for (default: next unfinished gameweek)")
+	horizon := flag.Int("horizon", cfg.Projection.Horizon, "number of gameweeks to optimise for")
+	budget := flag.Int("budget", cfg.Squad.MaxBudget, "budget in tenths of a million")
+	benchWeight := flag.Float64("bench-weight", cfg.Squad.BenchWeight, "value of a bench point relative to a starter point")
+	lock := flag.String("lock", "", "comma-separated player ids that must be in the squad")
+	ban := flag.String("ban", "", "comma-separated player ids that must not be in the squad")
+	flag.Parse()
+
+	locked, err := parseIDs(*lock)
+	if err != nil {
+		log.Fatalf("Invalid -lock: %v", err)
+	}
+	banned, err := parseIDs(*ban)
+	if err != nil {
+		log.Fatalf("Invalid -ban: %v", err)
+	}
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	service := &squad.Service{
+		Repo:         squad.NewRepo(fplDb.DB()),
+		Projections:  projection.NewRepo(fplDb.DB()),
+		ModelVersion: projection.ModelVersion,
+	}
+
+	rules := squad.DefaultRules()
+	rules.SquadSize = cfg.Squad.SquadSize
+	rules.SquadPlay = cfg.Squad.SquadPlay
+	rules.TeamLimit = cfg.Squad.TeamLimit
+	rules.Budget = *budget
+
+	result, err := service.Build(ctx, *season, *from, *horizon, rules, squad.Options{
+		Locked:      locked,
+		Banned:      banned,
+		BenchWeight: *benchWeight,
+	})
+	if err != nil {
+		log.Fatalf("Error building squad: %v", err)
+	}
+
+	log.Println("Starting XI:")
+	for _, c := range result.Starters {
+		log.Printf("  %-4d %-20s team %-3d type %d  £%.1fm  %.2f", c.PlayerID, c.Name, c.TeamID, c.ElementType, float64(c.Cost)/10, c.Points)
+	}
+	log.Println("Bench:")
+	for _, c := range result.Bench {
+		log.Printf("  %-4d %-20s team %-3d type %d  £%.1fm  %.2f", c.PlayerID, c.Name, c.TeamID, c.ElementType, float64(c.Cost)/10, c.Points)
+	}
+	log.Printf("Cost £%.1fm, projected XI points %.2f", float64(result.Cost)/10, result.Points)
+}
+
+func parseIDs(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var ids []int
+	for _, part := range strings.Split(s, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+	"github.com/imadeddine-belkat/analytics-service/internal/transfers"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	managerID := flag.Int("manager", 0, "FPL manager id")
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	horizon := flag.Int("horizon", cfg.Projection.Horizon, "number of gameweeks to plan")
+	plans := flag.Int("plans", 5, "number of plans to print")
+	flag.Parse()
+
+	if *managerID == 0 {
+		log.Fatal("-manager is required")
+	}
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	squadRules := squad.DefaultRules()
+	squadRules.SquadSize = cfg.Squad.SquadSize
+	squadRules.SquadPlay = cfg.Squad.SquadPlay
+	squadRules.TeamLimit = cfg.Squad.TeamLimit
+
+	options := transfers.DefaultOptions()
+	options.MaxPerEvent = cfg.Transfers.MaxPerEvent
+	options.BeamWidth = cfg.Transfers.BeamWidth
+	options.BenchWeight = cfg.Squad.BenchWeight
+	options.Plans = *plans
+
+	service := &transfers.Service{
+		Repo:         transfers.NewRepo(fplDb.DB()),
+		Squads:       squad.NewRepo(fplDb.DB()),
+		Projections:  projection.NewRepo(fplDb.DB()),
+		ModelVersion: projection.ModelVersion,
+		Planner: &transfers.Planner{
+			Squad: squadRules,
+			Rules: transfers.Rules{
+				MaxFreeTransfers: cfg.Transfers.MaxFreeTransfers,
+				HitCost:          cfg.Transfers.HitCost,
+				SellOnFee:        cfg.Transfers.SellOnFee,
+			},
+			Options: options,
+		},
+	}
+
+	manager, ranked, err := service.PlanManager(ctx, *managerID, *season, *horizon)
+	if err != nil {
+		log.Fatalf("Error planning transfers: %v", err)
+	}
+
+	log.Printf("Manager %d after GW%d: %d free transfers, £%.1fm in the bank",
+		manager.ManagerID, manager.Event, manager.FreeTransfers, float64(manager.Bank)/10)
+
+	for i, plan := range ranked {
+		log.Printf("Plan %d: %.2f points (%+.2f vs no transfers, -%d in hits)", i+1, plan.Points, plan.Gain, plan.HitCost)
+		for _, step := range plan.Steps {
+			if len(step.Transfers) == 0 {
+				log.Printf("  GW%d: roll (%d FT), %.2f points", step.Event, step.FreeTransfers, step.Points)
+				continue
+			}
+			for _, t := range step.Transfers {
+				log.Printf("  GW%d: %s (£%.1fm) -> %s (£%.1fm)", step.Event,
+					t.Out.Name, float64(t.SellPrice)/10, t.In.Name, float64(t.In.Cost)/10)
+			}
+			log.Printf("  GW%d: %d FT, -%d hit, £%.1fm left, %.2f points",
+				step.Event, step.FreeTransfers, step.HitCost, float64(step.Bank)/10, step.Points)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"log"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/kelseyhightower/envconfig"
+)
+
+type AnalyticsConfig struct {
+	Postgres   PostgresConfig
+	Projection ProjectionConfig
+	Backtest   BacktestConfig
+	Squad      SquadConfig
+	Transfers  TransfersConfig
+	Chips      ChipsConfig
+	Captaincy  CaptaincyConfig
+	Prices     PricesConfig
+	Difficulty DifficultyConfig
+	Ratings    RatingsConfig
+	Minutes    MinutesConfig
+
+	CurrentSeasonID int `envconfig:"FPL_CURRENT_SEASON_ID" required:"true"`
+	// MetricsAddr is where long-running commands serve /metrics.
+	MetricsAddr string `envconfig:"ANALYTICS_METRICS_ADDR" default:":9103"`
+}
+
+type PostgresConfig struct {
+	Host        string `envconfig:"DB_HOST" default:"localhost"`
+	Port        int    `envconfig:"DB_PORT" default:"5432"`
+	FplDatabase string `envconfig:"DB_FPL_NAME" default:"fpl"`
+	// SofascoreDatabase is optional: analytics only read match xG from it.
+	SofascoreDatabase string `envconfig:"DB_SOFASCORE_NAME" default:"sofascore"`
+	User              string `envconfig:"DB_USER" default:"tactify"`
+	Password          string `envconfig:"DB_PASSWORD" default:"admin"`
+	SSLMode           string `envconfig:"DB_SSLMODE" default:"disable"`
+}
+
+type ProjectionConfig struct {
+	// Horizon is the number of gameweeks projected from the next unfinished one.
+	Horizon int `envconfig:"PROJECTION_HORIZON" default:"5"`
+	// FormWindow is the number of past gameweeks used for minutes likelihood.
+	FormWindow int `envconfig:"PROJECTION_FORM_WINDOW" default:"6"`
+}
+
+type BacktestConfig struct {
+	// ExportDir is where the CSV reports of each run are written.
+	ExportDir string `envconfig:"BACKTEST_EXPORT_DIR" default:"backtests"`
+}
+
+// SquadConfig mirrors the squad fields of the FPL GameSettings.
+type SquadConfig struct {
+	SquadSize int `envconfig:"SQUAD_SQUADSIZE" default:"15"`
+	SquadPlay int `envconfig:"SQUAD_SQUADPLAY" default:"11"`
+	TeamLimit int `envconfig:"SQUAD_TEAM_LIMIT" default:"3"`
+	// MaxBudget is in tenths of a million, like now_cost.
+	MaxBudget   int     `envconfig:"SQUAD_MAX_BUDGET" default:"1000"`
+	BenchWeight float64 `envconfig:"SQUAD_BENCH_WEIGHT" default:"0.1"`
+}
+
+type TransfersConfig struct {
+	MaxFreeTransfers int     `envconfig:"TRANSFERS_MAX_FREE" default:"5"`
+	HitCost          int     `envconfig:"TRANSFERS_HIT_COST" default:"4"`
+	SellOnFee        float64 `envconfig:"TRANSFERS_SELL_ON_FEE" default:"0.5"`
+	// MaxPerEvent and BeamWidth bound the planner's search.
+	MaxPerEvent int `envconfig:"TRANSFERS_MAX_PER_EVENT" default:"2"`
+	BeamWidth   int `envconfig:"TRANSFERS_BEAM_WIDTH" default:"50"`
+}
+
+// ChipsConfig sets the chip windows: every chip is given once before
+// SecondHalfEvent and once from it to LastEvent.
+type ChipsConfig struct {
+	SecondHalfEvent int `envconfig:"CHIPS_SECOND_HALF_EVENT" default:"20"`
+	LastEvent       int `envconfig:"CHIPS_LAST_EVENT" default:"38"`
+}
+
+type CaptaincyConfig struct {
+	// RiskAversion is the number of standard deviations of the score relative
+	// to the sample taken off (safe) or added to (differential) a captain.
+	RiskAversion float64 `envconfig:"CAPTAINCY_RISK_AVERSION" default:"1"`
+	// DifferentialEO is the effective ownership, as a fraction, below which a
+	// captain counts as a differential.
+	DifferentialEO float64 `envconfig:"CAPTAINCY_DIFFERENTIAL_EO" default:"0.3"`
+}
+
+// PricesConfig parameterises the price change estimate; see prices.Options.
+type PricesConfig struct {
+	TotalManagers int     `envconfig:"PRICES_TOTAL_MANAGERS" default:"11000000"`
+	BaseThreshold float64 `envconfig:"PRICES_BASE_THRESHOLD" default:"10000"`
+	RiseFraction  float64 `envconfig:"PRICES_RISE_FRACTION" default:"0.05"`
+	FallFraction  float64 `envconfig:"PRICES_FALL_FRACTION" default:"0.04"`
+	// Interval is how often the tracker snapshots player_costs.
+	Interval time.Duration `envconfig:"PRICES_INTERVAL" default:"4h"`
+}
+
+type DifficultyConfig struct {
+	// HalfLife is in matches at a venue.
+	HalfLife     float64 `envconfig:"DIFFICULTY_HALF_LIFE" default:"6"`
+	PriorHomeXG  float64 `envconfig:"DIFFICULTY_PRIOR_HOME_XG" default:"1.5"`
+	PriorAwayXG  float64 `envconfig:"DIFFICULTY_PRIOR_AWAY_XG" default:"1.2"`
+	PriorMatches float64 `envconfig:"DIFFICULTY_PRIOR_MATCHES" default:"10"`
+	// SofascoreSeasonID is the Sofascore id of the current Premier League
+	// season; 0 rates on FPL's xG only.
+	SofascoreSeasonID int `envconfig:"DIFFICULTY_SOFASCORE_SEASON_ID" default:"0"`
+}
+
+type RatingsConfig struct {
+	EloK             float64 `envconfig:"RATINGS_ELO_K" default:"20"`
+	EloHomeAdvantage float64 `envconfig:"RATINGS_ELO_HOME_ADVANTAGE" default:"60"`
+	EloInitial       float64 `envconfig:"RATINGS_ELO_INITIAL" default:"1500"`
+	EloNewTeam       float64 `envconfig:"RATINGS_ELO_NEW_TEAM" default:"1400"`
+	EloCarryOver     float64 `envconfig:"RATINGS_ELO_CARRY_OVER" default:"0.8"`
+	DCHalfLifeDays   float64 `envconfig:"RATINGS_DC_HALF_LIFE_DAYS" default:"180"`
+	DCMaxGoals       int     `envconfig:"RATINGS_DC_MAX_GOALS" default:"6"`
+	// HorizonDays is how far ahead fixtures are predicted.
+	HorizonDays int `envconfig:"RATINGS_HORIZON_DAYS" default:"14"`
+}
+
+type MinutesConfig struct {
+	// HalfLife is in team matches.
+	HalfLife         float64 `envconfig:"MINUTES_HALF_LIFE" default:"4"`
+	ShortRestDays    float64 `envconfig:"MINUTES_SHORT_REST_DAYS" default:"4"`
+	ShortRestPenalty float64 `envconfig:"MINUTES_SHORT_REST_PENALTY" default:"0.15"`
+	MissingFactor    float64 `envconfig:"MINUTES_MISSING_FACTOR" default:"0.25"`
+	DoubtfulFactor   float64 `envconfig:"MINUTES_DOUBTFUL_FACTOR" default:"0.75"`
+	// LookbackDays is how far back Sofascore's cup matches and lineups count.
+	LookbackDays int `envconfig:"MINUTES_LOOKBACK_DAYS" default:"28"`
+}
+
+func LoadConfig() *AnalyticsConfig {
+	// Load .env file (tries multiple paths)
+	_ = godotenv.Load(".env")
+	_ = godotenv.Load("../.env")
+	_ = godotenv.Load("../../.env")
+	_ = godotenv.Load("../../../.env")
+
+	config := &AnalyticsConfig{}
+
+	if err := envconfig.Process("", config); err != nil {
+		log.Fatalf("analytics-service: Unable to load config: %s", err)
+	}
+
+	return config
+}
@@ -0,0 +1,103 @@
+package backtest
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+// ExportCSV writes <dir>/backtest_<run>_metrics.csv and
+// <dir>/backtest_<run>_calibration.csv and returns their paths.
+func ExportCSV(dir string, report *Report) ([]string, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating export dir: %w", err)
+	}
+
+	prefix := filepath.Join(dir, fmt.Sprintf("backtest_%d", report.RunID))
+	files := []struct {
+		path  string
+		write func(io.Writer, *Report) error
+	}{
+		{prefix + "_metrics.csv", WriteMetricsCSV},
+		{prefix + "_calibration.csv", WriteCalibrationCSV},
+	}
+
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		if err := writeFile(f.path, report, f.write); err != nil {
+			return nil, err
+		}
+		paths = append(paths, f.path)
+	}
+
+	return paths, nil
+}
+
+func writeFile(path string, report *Report, write func(io.Writer, *Report) error) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if err := write(file, report); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+
+	return file.Close()
+}
+
+func WriteMetricsCSV(w io.Writer, report *Report) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"run_id", "predictor", "model_version", "segment_type", "segment",
+		"n", "mae", "rmse", "bias", "mean_predicted", "mean_actual"}); err != nil {
+		return err
+	}
+
+	for _, m := range report.Metrics {
+		if err := cw.Write([]string{
+			strconv.FormatInt(report.RunID, 10), report.Predictor, report.Version, m.SegmentType, m.Segment,
+			strconv.Itoa(m.N), formatFloat(m.MAE), formatFloat(m.RMSE), formatFloat(m.Bias),
+			formatFloat(m.MeanPredicted), formatFloat(m.MeanActual),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func WriteCalibrationCSV(w io.Writer, report *Report) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"run_id", "predictor", "model_version", "segment_type", "segment",
+		"bin_lower", "bin_upper", "n", "mean_predicted", "mean_actual"}); err != nil {
+		return err
+	}
+
+	for _, b := range report.Calibration {
+		upper := ""
+		if !math.IsInf(b.Upper, 1) {
+			upper = formatFloat(b.Upper)
+		}
+		if err := cw.Write([]string{
+			strconv.FormatInt(report.RunID, 10), report.Predictor, report.Version, b.SegmentType, b.Segment,
+			formatFloat(b.Lower), upper, strconv.Itoa(b.N), formatFloat(b.MeanPredicted), formatFloat(b.MeanActual),
+		}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', 4, 64)
+}
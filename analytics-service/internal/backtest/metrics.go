@@ -0,0 +1,178 @@
+package backtest
+
+import (
+	"math"
+	"sort"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+// Sample pairs a prediction with what actually happened.
+type Sample struct {
+	Event       int
+	PlayerID    int
+	FixtureID   int
+	ElementType int
+	// Value is the player's price at the time of the fixture, in tenths of a million.
+	Value     int
+	Predicted float64
+	Actual    float64
+}
+
+// Segment types reported for every run.
+const (
+	SegmentOverall   = "overall"
+	SegmentPosition  = "position"
+	SegmentPriceBand = "price_band"
+)
+
+type Metric struct {
+	SegmentType   string
+	Segment       string
+	N             int
+	MAE           float64
+	RMSE          float64
+	Bias          float64
+	MeanPredicted float64
+	MeanActual    float64
+}
+
+// CalibrationBin compares the mean prediction with the mean outcome of all
+// samples whose prediction fell in [Lower, Upper).
+type CalibrationBin struct {
+	SegmentType   string
+	Segment       string
+	Lower         float64
+	Upper         float64
+	N             int
+	MeanPredicted float64
+	MeanActual    float64
+}
+
+// CalibrationEdges are the lower bounds of the calibration bins; the last bin is open-ended.
+var CalibrationEdges = []float64{0, 1, 2, 3, 4, 5, 6, 8}
+
+func PositionName(elementType int) string {
+	switch elementType {
+	case projection.Goalkeeper:
+		return "GKP"
+	case projection.Defender:
+		return "DEF"
+	case projection.Midfielder:
+		return "MID"
+	case projection.Forward:
+		return "FWD"
+	default:
+		return "UNK"
+	}
+}
+
+// PriceBand buckets a price in tenths of a million.
+func PriceBand(value int) string {
+	switch {
+	case value < 50:
+		return "<5.0"
+	case value < 75:
+		return "5.0-7.4"
+	case value < 100:
+		return "7.5-9.9"
+	default:
+		return "10.0+"
+	}
+}
+
+// Evaluate computes metrics and calibration overall, by position and by price band.
+func Evaluate(samples []Sample) ([]Metric, []CalibrationBin) {
+	groups := map[[2]string][]Sample{}
+	for _, s := range samples {
+		for _, key := range [][2]string{
+			{SegmentOverall, "all"},
+			{SegmentPosition, PositionName(s.ElementType)},
+			{SegmentPriceBand, PriceBand(s.Value)},
+		} {
+			groups[key] = append(groups[key], s)
+		}
+	}
+
+	keys := make([][2]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+
+	var metrics []Metric
+	var bins []CalibrationBin
+	for _, key := range keys {
+		metrics = append(metrics, metric(key[0], key[1], groups[key]))
+		bins = append(bins, calibration(key[0], key[1], groups[key])...)
+	}
+
+	return metrics, bins
+}
+
+func metric(segmentType, segment string, samples []Sample) Metric {
+	m := Metric{SegmentType: segmentType, Segment: segment, N: len(samples)}
+	if len(samples) == 0 {
+		return m
+	}
+
+	var absErr, sqErr, predicted, actual float64
+	for _, s := range samples {
+		diff := s.Predicted - s.Actual
+		absErr += math.Abs(diff)
+		sqErr += diff * diff
+		predicted += s.Predicted
+		actual += s.Actual
+	}
+
+	n := float64(len(samples))
+	m.MAE = absErr / n
+	m.RMSE = math.Sqrt(sqErr / n)
+	m.MeanPredicted = predicted / n
+	m.MeanActual = actual / n
+	m.Bias = m.MeanPredicted - m.MeanActual
+
+	return m
+}
+
+func calibration(segmentType, segment string, samples []Sample) []CalibrationBin {
+	bins := make([]CalibrationBin, len(CalibrationEdges))
+	for i, lower := range CalibrationEdges {
+		upper := math.Inf(1)
+		if i+1 < len(CalibrationEdges) {
+			upper = CalibrationEdges[i+1]
+		}
+		bins[i] = CalibrationBin{SegmentType: segmentType, Segment: segment, Lower: lower, Upper: upper}
+	}
+
+	for _, s := range samples {
+		i := sort.SearchFloat64s(CalibrationEdges, s.Predicted)
+		// SearchFloat64s returns the insertion point; step back unless it is an exact edge.
+		if i == len(CalibrationEdges) || CalibrationEdges[i] != s.Predicted {
+			i--
+		}
+		if i < 0 {
+			i = 0
+		}
+		bins[i].N++
+		bins[i].MeanPredicted += s.Predicted
+		bins[i].MeanActual += s.Actual
+	}
+
+	out := bins[:0]
+	for _, b := range bins {
+		if b.N == 0 {
+			continue
+		}
+		b.MeanPredicted /= float64(b.N)
+		b.MeanActual /= float64(b.N)
+		out = append(out, b)
+	}
+
+	return out
+}
@@ -0,0 +1,53 @@
+package backtest
+
+import (
+	"context"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+// Prediction is a forecast of the FPL points of one player in one fixture.
+type Prediction struct {
+	PlayerID  int
+	FixtureID int
+	Event     int
+	Points    float64
+}
+
+// Predictor is anything that forecasts points from a point-in-time snapshot.
+// Implementations must only use what is in the snapshot; the runner makes
+// sure the snapshot holds nothing from the gameweek being predicted or later.
+type Predictor interface {
+	Name() string
+	Version() string
+	Predict(ctx context.Context, in *projection.Inputs, fixtures []projection.Fixture) ([]Prediction, error)
+}
+
+// ProjectionPredictor adapts the expected-points model to the Predictor interface.
+type ProjectionPredictor struct {
+	Model *projection.Model
+}
+
+func (p *ProjectionPredictor) Name() string {
+	return "projection"
+}
+
+func (p *ProjectionPredictor) Version() string {
+	return p.Model.Version()
+}
+
+func (p *ProjectionPredictor) Predict(_ context.Context, in *projection.Inputs, fixtures []projection.Fixture) ([]Prediction, error) {
+	projections := p.Model.Project(in, fixtures)
+
+	predictions := make([]Prediction, 0, len(projections))
+	for _, pr := range projections {
+		predictions = append(predictions, Prediction{
+			PlayerID:  pr.PlayerID,
+			FixtureID: pr.FixtureID,
+			Event:     pr.Event,
+			Points:    pr.XPoints,
+		})
+	}
+
+	return predictions, nil
+}
@@ -0,0 +1,154 @@
+package backtest
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/db/helpers"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// Actual is what a player scored in a fixture and what he cost at the time.
+type Actual struct {
+	ElementType int
+	Value       int
+	Points      float64
+}
+
+type actualKey struct {
+	playerID, fixtureID int
+}
+
+// LoadActuals returns the recorded points of every player-fixture of a gameweek.
+func (r *Repo) LoadActuals(ctx context.Context, seasonID, event int) (map[actualKey]Actual, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT g.player_id, g.fixture_id, p.element_type_id, COALESCE(g.value, 0), COALESCE(g.total_points, 0)
+		FROM player_gameweek_stats g
+		JOIN players p ON p.player_id = g.player_id AND p.season_id = g.season_id
+		WHERE g.season_id = $1 AND g.event = $2`,
+		seasonID, event,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying actuals: %w", err)
+	}
+	defer rows.Close()
+
+	actuals := make(map[actualKey]Actual)
+	for rows.Next() {
+		var key actualKey
+		var a Actual
+		if err := rows.Scan(&key.playerID, &key.fixtureID, &a.ElementType, &a.Value, &a.Points); err != nil {
+			return nil, fmt.Errorf("scanning actuals: %w", err)
+		}
+		actuals[key] = a
+	}
+
+	return actuals, rows.Err()
+}
+
+// LastFinishedEvent returns the last gameweek of a season whose fixtures have all finished.
+func (r *Repo) LastFinishedEvent(ctx context.Context, seasonID int) (int, error) {
+	var event sql.NullInt64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT MAX(event) FROM (
+		    SELECT event FROM fixtures
+		    WHERE season_id = $1 AND event IS NOT NULL
+		    GROUP BY event
+		    HAVING BOOL_AND(COALESCE(finished, FALSE))
+		) e`,
+		seasonID,
+	).Scan(&event)
+	if err != nil {
+		return 0, fmt.Errorf("querying last finished event: %w", err)
+	}
+	if !event.Valid {
+		return 0, fmt.Errorf("season %d has no finished gameweek", seasonID)
+	}
+	return int(event.Int64), nil
+}
+
+// SaveReport stores a run with its samples, metrics and calibration and sets report.RunID.
+func (r *Repo) SaveReport(ctx context.Context, report *Report) error {
+	params, err := json.Marshal(report.Params)
+	if err != nil {
+		return fmt.Errorf("encoding params: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning backtest tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO backtest_runs (predictor, model_version, season_id, from_event, to_event, params)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING run_id`,
+		report.Predictor, report.Version, report.SeasonID, report.FromEvent, report.ToEvent, string(params),
+	).Scan(&report.RunID)
+	if err != nil {
+		return fmt.Errorf("inserting backtest_runs: %w", err)
+	}
+
+	samples := make([][]any, 0, len(report.Samples))
+	for _, s := range report.Samples {
+		samples = append(samples, []any{
+			report.RunID, s.Event, s.PlayerID, s.FixtureID, s.ElementType, s.Value, s.Predicted, s.Actual,
+		})
+	}
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table:        "backtest_samples",
+		Columns:      []string{"run_id", "event", "player_id", "fixture_id", "element_type", "value", "predicted", "actual"},
+		ConflictCols: []string{"run_id", "player_id", "fixture_id"},
+		Rows:         samples,
+	}); err != nil {
+		return fmt.Errorf("inserting backtest_samples: %w", err)
+	}
+
+	metrics := make([][]any, 0, len(report.Metrics))
+	for _, m := range report.Metrics {
+		metrics = append(metrics, []any{
+			report.RunID, m.SegmentType, m.Segment, m.N, m.MAE, m.RMSE, m.Bias, m.MeanPredicted, m.MeanActual,
+		})
+	}
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table:        "backtest_metrics",
+		Columns:      []string{"run_id", "segment_type", "segment", "n", "mae", "rmse", "bias", "mean_predicted", "mean_actual"},
+		ConflictCols: []string{"run_id", "segment_type", "segment"},
+		Rows:         metrics,
+	}); err != nil {
+		return fmt.Errorf("inserting backtest_metrics: %w", err)
+	}
+
+	bins := make([][]any, 0, len(report.Calibration))
+	for _, b := range report.Calibration {
+		// The last bin is open-ended; store its upper bound as NULL.
+		var upper any
+		if !math.IsInf(b.Upper, 1) {
+			upper = b.Upper
+		}
+		bins = append(bins, []any{
+			report.RunID, b.SegmentType, b.Segment, b.Lower, upper, b.N, b.MeanPredicted, b.MeanActual,
+		})
+	}
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table:        "backtest_calibration",
+		Columns:      []string{"run_id", "segment_type", "segment", "bin_lower", "bin_upper", "n", "mean_predicted", "mean_actual"},
+		ConflictCols: []string{"run_id", "segment_type", "segment", "bin_lower"},
+		Rows:         bins,
+	}); err != nil {
+		return fmt.Errorf("inserting backtest_calibration: %w", err)
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,123 @@
+package backtest
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+type Report struct {
+	RunID     int64
+	Predictor string
+	Version   string
+	SeasonID  int
+	FromEvent int
+	ToEvent   int
+	Params    map[string]any
+
+	Samples     []Sample
+	Metrics     []Metric
+	Calibration []CalibrationBin
+}
+
+// Runner replays past gameweeks. For each gameweek it builds the snapshot as of
+// that deadline, asks the predictor for that gameweek only and scores the
+// predictions against player_gameweek_stats.
+type Runner struct {
+	Inputs     *projection.Repo
+	Repo       *Repo
+	FormWindow int
+}
+
+// Run backtests a predictor over gameweeks [fromEvent, toEvent] of a season.
+// A toEvent of 0 means the last fully finished gameweek.
+func (r *Runner) Run(ctx context.Context, p Predictor, seasonID, fromEvent, toEvent int) (*Report, error) {
+	if fromEvent < 1 {
+		fromEvent = 1
+	}
+	if toEvent == 0 {
+		last, err := r.Repo.LastFinishedEvent(ctx, seasonID)
+		if err != nil {
+			return nil, err
+		}
+		toEvent = last
+	}
+	if toEvent < fromEvent {
+		return nil, fmt.Errorf("empty backtest range GW%d-%d", fromEvent, toEvent)
+	}
+
+	report := &Report{
+		Predictor: p.Name(),
+		Version:   p.Version(),
+		SeasonID:  seasonID,
+		FromEvent: fromEvent,
+		ToEvent:   toEvent,
+		Params:    map[string]any{"form_window": r.FormWindow},
+	}
+
+	for event := fromEvent; event <= toEvent; event++ {
+		samples, err := r.replay(ctx, p, seasonID, event)
+		if err != nil {
+			return nil, fmt.Errorf("replaying GW%d: %w", event, err)
+		}
+		report.Samples = append(report.Samples, samples...)
+	}
+
+	report.Metrics, report.Calibration = Evaluate(report.Samples)
+
+	log.Printf("Backtested %s %s on season %d GW%d-%d: %d samples",
+		report.Predictor, report.Version, seasonID, fromEvent, toEvent, len(report.Samples))
+
+	return report, nil
+}
+
+func (r *Runner) replay(ctx context.Context, p Predictor, seasonID, event int) ([]Sample, error) {
+	in, err := r.Inputs.LoadInputs(ctx, seasonID, event, r.FormWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	// players.status is today's flag, not the one at the deadline; using it
+	// would leak future injuries into the past, so everyone starts available.
+	for i := range in.Players {
+		in.Players[i].Status = "a"
+	}
+
+	fixtures, err := r.Inputs.LoadFixtures(ctx, seasonID, event, event)
+	if err != nil {
+		return nil, err
+	}
+
+	predictions, err := p.Predict(ctx, in, fixtures)
+	if err != nil {
+		return nil, fmt.Errorf("predicting: %w", err)
+	}
+
+	actuals, err := r.Repo.LoadActuals(ctx, seasonID, event)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]Sample, 0, len(predictions))
+	for _, pr := range predictions {
+		// No row means the player was not registered for the fixture (e.g. joined later).
+		a, ok := actuals[actualKey{pr.PlayerID, pr.FixtureID}]
+		if !ok {
+			continue
+		}
+
+		samples = append(samples, Sample{
+			Event:       event,
+			PlayerID:    pr.PlayerID,
+			FixtureID:   pr.FixtureID,
+			ElementType: a.ElementType,
+			Value:       a.Value,
+			Predicted:   pr.Points,
+			Actual:      a.Points,
+		})
+	}
+
+	return samples, nil
+}
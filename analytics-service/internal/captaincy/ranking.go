@@ -0,0 +1,152 @@
+package captaincy
+
+import (
+	"math"
+	"sort"
+)
+
+// Player is a player's outlook for one gameweek.
+type Player struct {
+	PlayerID int
+	Name     string
+	TeamID   int
+	Fixtures int
+	// XPoints is the projected points of the gameweek and Variance the spread
+	// around it, summed over the player's fixtures.
+	XPoints  float64
+	Variance float64
+	// SelectedBy is the overall ownership from the bootstrap, in percent.
+	SelectedBy float64
+}
+
+// Exposure is how a sample of managers holds a player: the share starting the
+// player, the share captaining the player, and the effective ownership, the
+// mean multiplier (1.5 means the sample scores 1.5 times the player's points
+// on average).
+type Exposure struct {
+	Owned     float64
+	Captained float64
+	EO        float64
+}
+
+// Field is the exposure of a sample of managers to every player they hold.
+type Field struct {
+	Managers  int
+	Event     int
+	Exposures map[int]Exposure
+}
+
+// Candidate is a captain option for a manager.
+type Candidate struct {
+	Player
+	Exposure
+	// RelativeStdDev is the spread of the manager's score minus the sample's
+	// expected score if this player is captained.
+	RelativeStdDev float64
+	// Floor and Ceiling are the projected captain points minus and plus
+	// RiskAversion relative standard deviations.
+	Floor   float64
+	Ceiling float64
+}
+
+type Options struct {
+	// RiskAversion is the number of relative standard deviations used for the
+	// floor and ceiling of every candidate.
+	RiskAversion float64
+	// DifferentialEO is the effective ownership below which a candidate counts
+	// as a differential.
+	DifferentialEO float64
+}
+
+func DefaultOptions() Options {
+	return Options{RiskAversion: 1, DifferentialEO: 0.3}
+}
+
+// Ranking is the captain candidates of a manager, best projection first. Safe
+// is the best floor; Differential the best ceiling among players the sample
+// barely owns, nil when there is none besides Safe.
+type Ranking struct {
+	Candidates   []Candidate
+	Safe         *Candidate
+	Differential *Candidate
+}
+
+// Rank scores every starter of xi as captain; players holds the outlook of
+// everyone else the field owns. Captaining a player counts their points once
+// more, so the expected gain over not captaining only depends on the
+// projection; what the field holds changes the risk.
+//
+// The manager's score relative to the sample is sum_p (m_p - EO_p) * points_p,
+// with m_p the manager's multiplier, and its variance is taken with players
+// independent. Captaining a player the field captains keeps the manager with
+// the field; a differential adds spread.
+func Rank(xi []Player, players map[int]Player, field Field, opts Options) *Ranking {
+	own := make(map[int]float64, len(xi))
+	for _, p := range xi {
+		own[p.PlayerID] = 1
+	}
+
+	// Spread of the relative score with no captain.
+	var base float64
+	for _, p := range xi {
+		d := 1 - field.Exposures[p.PlayerID].EO
+		base += d * d * p.Variance
+	}
+	for id, p := range players {
+		if own[id] == 0 {
+			d := field.Exposures[id].EO
+			base += d * d * p.Variance
+		}
+	}
+
+	ranking := &Ranking{}
+	for _, p := range xi {
+		exp := field.Exposures[p.PlayerID]
+		before := own[p.PlayerID] - exp.EO
+		after := before + 1
+		variance := max(base+(after*after-before*before)*p.Variance, 0)
+
+		c := Candidate{Player: p, Exposure: exp, RelativeStdDev: math.Sqrt(variance)}
+		c.Floor = p.XPoints - opts.RiskAversion*c.RelativeStdDev
+		c.Ceiling = p.XPoints + opts.RiskAversion*c.RelativeStdDev
+		ranking.Candidates = append(ranking.Candidates, c)
+	}
+
+	sort.SliceStable(ranking.Candidates, func(i, j int) bool {
+		return ranking.Candidates[i].XPoints > ranking.Candidates[j].XPoints
+	})
+
+	for k := range ranking.Candidates {
+		c := &ranking.Candidates[k]
+		if ranking.Safe == nil || c.Floor > ranking.Safe.Floor {
+			ranking.Safe = c
+		}
+	}
+	for k := range ranking.Candidates {
+		c := &ranking.Candidates[k]
+		if c == ranking.Safe || c.EO >= opts.DifferentialEO {
+			continue
+		}
+		if ranking.Differential == nil || c.Ceiling > ranking.Differential.Ceiling {
+			ranking.Differential = c
+		}
+	}
+
+	return ranking
+}
+
+// Stats is the history of a player's points per appearance.
+type Stats struct {
+	Appearances int
+	Mean        float64
+	Variance    float64
+}
+
+// FixtureVariance is the variance of one appearance: the player's own,
+// shrunk towards priorRatio times the projected points per fixture with the
+// weight of priorWeight appearances, since a few games say little about it.
+func FixtureVariance(s Stats, xPerFixture, priorRatio, priorWeight float64) float64 {
+	prior := priorRatio * xPerFixture
+	n := float64(s.Appearances)
+	return (n*s.Variance + priorWeight*prior) / (n + priorWeight)
+}
@@ -0,0 +1,171 @@
+package captaincy
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// LatestPicksEvent returns the last gameweek up to event for which any of
+// managerIDs has picks, or any manager when managerIDs is empty.
+func (r *Repo) LatestPicksEvent(ctx context.Context, seasonID, event int, managerIDs []int) (int, error) {
+	var latest sql.NullInt64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT MAX(event)
+		FROM manager_picks
+		WHERE season_id = $1 AND event <= $2
+		  AND (cardinality($3::int[]) = 0 OR manager_id = ANY($3))`,
+		seasonID, event, pq.Array(managerIDs),
+	).Scan(&latest)
+	if err != nil {
+		return 0, fmt.Errorf("querying latest picks event: %w", err)
+	}
+	if !latest.Valid {
+		return 0, fmt.Errorf("no picks up to GW%d in season %d", event, seasonID)
+	}
+	return int(latest.Int64), nil
+}
+
+// LoadXI returns the starters of a manager's picks in a gameweek.
+func (r *Repo) LoadXI(ctx context.Context, managerID, seasonID, event, squadPlay int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id
+		FROM manager_picks
+		WHERE manager_id = $1 AND season_id = $2 AND event = $3 AND position <= $4
+		ORDER BY position`,
+		managerID, seasonID, event, squadPlay,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying manager_picks: %w", err)
+	}
+	defer rows.Close()
+
+	var xi []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scanning manager_picks: %w", err)
+		}
+		xi = append(xi, id)
+	}
+
+	return xi, rows.Err()
+}
+
+// LoadField aggregates the picks of managerIDs in a gameweek, or of every
+// indexed manager when managerIDs is empty. Multipliers already account for
+// the bench (0), the captain (2) and Triple Captain (3).
+func (r *Repo) LoadField(ctx context.Context, seasonID, event int, managerIDs []int) (Field, error) {
+	field := Field{Event: event, Exposures: make(map[int]Exposure)}
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT manager_id)
+		FROM manager_picks
+		WHERE season_id = $1 AND event = $2
+		  AND (cardinality($3::int[]) = 0 OR manager_id = ANY($3))`,
+		seasonID, event, pq.Array(managerIDs),
+	).Scan(&field.Managers)
+	if err != nil {
+		return field, fmt.Errorf("counting sample managers: %w", err)
+	}
+	if field.Managers == 0 {
+		return field, nil
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id,
+		       COUNT(*) FILTER (WHERE COALESCE(multiplier, 0) > 0),
+		       COUNT(*) FILTER (WHERE COALESCE(is_captain, FALSE)),
+		       COALESCE(SUM(multiplier), 0)
+		FROM manager_picks
+		WHERE season_id = $1 AND event = $2
+		  AND (cardinality($3::int[]) = 0 OR manager_id = ANY($3))
+		GROUP BY player_id`,
+		seasonID, event, pq.Array(managerIDs),
+	)
+	if err != nil {
+		return field, fmt.Errorf("querying sample picks: %w", err)
+	}
+	defer rows.Close()
+
+	n := float64(field.Managers)
+	for rows.Next() {
+		var playerID, owned, captained, multiplier int
+		if err := rows.Scan(&playerID, &owned, &captained, &multiplier); err != nil {
+			return field, fmt.Errorf("scanning sample picks: %w", err)
+		}
+		field.Exposures[playerID] = Exposure{
+			Owned:     float64(owned) / n,
+			Captained: float64(captained) / n,
+			EO:        float64(multiplier) / n,
+		}
+	}
+
+	return field, rows.Err()
+}
+
+// LoadPlayers returns every player projected for a gameweek by a model version.
+func (r *Repo) LoadPlayers(ctx context.Context, seasonID int, modelVersion string, event int) (map[int]Player, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT g.player_id, COALESCE(p.web_name, ''), p.team_id, g.fixtures, g.x_points,
+		       COALESCE(s.selected_by_percent, 0)
+		FROM player_projection_gameweeks g
+		JOIN players p ON p.player_id = g.player_id AND p.season_id = g.season_id
+		LEFT JOIN player_season_stats s ON s.player_id = g.player_id AND s.season_id = g.season_id
+		WHERE g.season_id = $1 AND g.model_version = $2 AND g.event = $3`,
+		seasonID, modelVersion, event,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying player_projection_gameweeks: %w", err)
+	}
+	defer rows.Close()
+
+	players := make(map[int]Player)
+	for rows.Next() {
+		var p Player
+		if err := rows.Scan(&p.PlayerID, &p.Name, &p.TeamID, &p.Fixtures, &p.XPoints, &p.SelectedBy); err != nil {
+			return nil, fmt.Errorf("scanning player_projection_gameweeks: %w", err)
+		}
+		players[p.PlayerID] = p
+	}
+
+	return players, rows.Err()
+}
+
+// LoadStats returns the points per appearance of every player before a
+// gameweek. A fixture the player got minutes in is one appearance.
+func (r *Repo) LoadStats(ctx context.Context, seasonID, beforeEvent int) (map[int]Stats, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id, COUNT(*), AVG(total_points), COALESCE(VAR_SAMP(total_points), 0)
+		FROM player_gameweek_stats
+		WHERE season_id = $1 AND event < $2 AND minutes > 0
+		GROUP BY player_id`,
+		seasonID, beforeEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying player_gameweek_stats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make(map[int]Stats)
+	for rows.Next() {
+		var playerID int
+		var s Stats
+		if err := rows.Scan(&playerID, &s.Appearances, &s.Mean, &s.Variance); err != nil {
+			return nil, fmt.Errorf("scanning player_gameweek_stats: %w", err)
+		}
+		stats[playerID] = s
+	}
+
+	return stats, rows.Err()
+}
@@ -0,0 +1,95 @@
+package captaincy
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+const (
+	// PriorVarianceRatio is the variance of an appearance per projected point
+	// assumed for players with little history; FPL returns are over-dispersed.
+	PriorVarianceRatio = 3.0
+	// PriorWeight is the number of appearances the prior counts for.
+	PriorWeight = 4.0
+)
+
+type Service struct {
+	Repo         *Repo
+	Projections  *projection.Repo
+	ModelVersion string
+	SquadPlay    int
+	Options      Options
+}
+
+// RankManager ranks the captain options of a manager for a gameweek against a
+// sample of managers, or every indexed manager when sample is empty. An event
+// of 0 means the next unfinished gameweek. Picks for a gameweek exist only
+// after its deadline, so the manager's XI and the sample's exposure are taken
+// from their latest picks.
+func (s *Service) RankManager(ctx context.Context, managerID, seasonID, event int, sample []int) (*Ranking, Field, error) {
+	if event == 0 {
+		next, err := s.Projections.NextEvent(ctx, seasonID)
+		if err != nil {
+			return nil, Field{}, err
+		}
+		event = next
+	}
+
+	picksEvent, err := s.Repo.LatestPicksEvent(ctx, seasonID, event, []int{managerID})
+	if err != nil {
+		return nil, Field{}, err
+	}
+	ids, err := s.Repo.LoadXI(ctx, managerID, seasonID, picksEvent, s.SquadPlay)
+	if err != nil {
+		return nil, Field{}, err
+	}
+
+	fieldEvent, err := s.Repo.LatestPicksEvent(ctx, seasonID, event, sample)
+	if err != nil {
+		return nil, Field{}, err
+	}
+	field, err := s.Repo.LoadField(ctx, seasonID, fieldEvent, sample)
+	if err != nil {
+		return nil, Field{}, err
+	}
+
+	players, err := s.Repo.LoadPlayers(ctx, seasonID, s.ModelVersion, event)
+	if err != nil {
+		return nil, Field{}, err
+	}
+	if len(players) == 0 {
+		return nil, Field{}, fmt.Errorf("no %s projections for season %d GW%d", s.ModelVersion, seasonID, event)
+	}
+
+	stats, err := s.Repo.LoadStats(ctx, seasonID, event)
+	if err != nil {
+		return nil, Field{}, err
+	}
+	for id, p := range players {
+		if p.Fixtures > 0 {
+			perFixture := FixtureVariance(stats[id], p.XPoints/float64(p.Fixtures), PriorVarianceRatio, PriorWeight)
+			p.Variance = float64(p.Fixtures) * perFixture
+		}
+		players[id] = p
+	}
+
+	// Starters without a fixture stay in the XI with nothing projected.
+	xi := make([]Player, 0, len(ids))
+	for _, id := range ids {
+		p, ok := players[id]
+		if !ok {
+			p = Player{PlayerID: id}
+		}
+		xi = append(xi, p)
+	}
+
+	ranking := Rank(xi, players, field, s.Options)
+
+	log.Printf("Ranked captains for manager %d, GW%d: %d candidates against %d managers (picks of GW%d)",
+		managerID, event, len(ranking.Candidates), field.Managers, field.Event)
+
+	return ranking, field, nil
+}
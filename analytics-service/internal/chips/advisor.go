@@ -0,0 +1,200 @@
+package chips
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+	"github.com/imadeddine-belkat/analytics-service/internal/transfers"
+)
+
+// Simulation is what each chip would add in one gameweek over the manager's
+// current squad, kept without transfers for the rest of the horizon.
+type Simulation struct {
+	Event int
+	// Points is the current squad's XI and captain.
+	Points float64
+	Gains  map[string]float64
+}
+
+// Recommendation is the gameweek a remaining chip is best played in. Event is
+// 0 when no projected gameweek of the window is worth it, so the chip is held.
+type Recommendation struct {
+	Window
+	Event  int
+	Gain   float64
+	Blank  bool
+	Double bool
+}
+
+type Advice struct {
+	Calendar        []Gameweek
+	Simulations     []Simulation
+	Recommendations []Recommendation
+}
+
+// Advisor simulates every chip in every projected gameweek:
+//
+//   - Bench Boost adds the bench of the current squad;
+//   - Triple Captain adds the captain's points once more;
+//   - Free Hit swaps in the best squad for that gameweek alone;
+//   - Wildcard swaps in the best squad for the rest of the horizon.
+//
+// Free Hit and Wildcard squads are built by the squad optimiser with the
+// manager's selling value as budget.
+type Advisor struct {
+	Squad       squad.Rules
+	SellOnFee   float64
+	BenchWeight float64
+}
+
+// Advise times the remaining chip windows of a manager over market.Events.
+// Only one chip can be played per gameweek, so gameweeks are handed out to
+// chips by decreasing gain.
+func (a *Advisor) Advise(m *transfers.Manager, market transfers.Market, calendar []Gameweek, windows []Window) (*Advice, error) {
+	owned := make(map[int]squad.Candidate, len(m.Holdings))
+	budget := m.Bank
+	for _, h := range m.Holdings {
+		player, ok := market.Players[h.PlayerID]
+		if !ok {
+			return nil, fmt.Errorf("player %d of manager %d has no market data", h.PlayerID, m.ManagerID)
+		}
+		// Keeping a player costs what they would sell for.
+		player.Cost = transfers.SellingPrice(h.PurchasePrice, player.Cost, a.SellOnFee)
+		owned[h.PlayerID] = player
+		budget += player.Cost
+	}
+
+	current := make([]squad.Candidate, 0, len(owned))
+	for _, h := range m.Holdings {
+		current = append(current, owned[h.PlayerID])
+	}
+
+	rules := a.Squad
+	rules.Budget = budget
+
+	advice := &Advice{Calendar: calendar}
+	for idx, event := range market.Events {
+		xi, bench, captain := a.score(current, market, event)
+		sim := Simulation{
+			Event:  event,
+			Points: xi + captain,
+			Gains: map[string]float64{
+				transfers.ChipBenchBoost:    bench,
+				transfers.ChipTripleCaptain: captain,
+			},
+		}
+
+		freeHit, err := a.optimise(owned, market, market.Events[idx:idx+1], rules)
+		if err != nil {
+			return nil, fmt.Errorf("free hit squad for GW%d: %w", event, err)
+		}
+		xi, _, captain = a.score(freeHit, market, event)
+		sim.Gains[transfers.ChipFreeHit] = xi + captain - sim.Points
+
+		wildcard, err := a.optimise(owned, market, market.Events[idx:], rules)
+		if err != nil {
+			return nil, fmt.Errorf("wildcard squad for GW%d: %w", event, err)
+		}
+		var gain float64
+		for _, later := range market.Events[idx:] {
+			wcXI, _, wcCaptain := a.score(wildcard, market, later)
+			baseXI, _, baseCaptain := a.score(current, market, later)
+			gain += wcXI + wcCaptain - baseXI - baseCaptain
+		}
+		sim.Gains[transfers.ChipWildcard] = gain
+
+		advice.Simulations = append(advice.Simulations, sim)
+	}
+
+	advice.Recommendations = recommend(windows, advice.Simulations, calendar)
+	return advice, nil
+}
+
+type option struct {
+	window int
+	event  int
+	gain   float64
+}
+
+func recommend(windows []Window, simulations []Simulation, calendar []Gameweek) []Recommendation {
+	var options []option
+	for w, window := range windows {
+		for _, sim := range simulations {
+			if sim.Event < window.FromEvent || sim.Event > window.ToEvent {
+				continue
+			}
+			if gain := sim.Gains[window.Chip]; gain > 0 {
+				options = append(options, option{window: w, event: sim.Event, gain: gain})
+			}
+		}
+	}
+	sort.SliceStable(options, func(i, j int) bool { return options[i].gain > options[j].gain })
+
+	recommendations := make([]Recommendation, len(windows))
+	for w, window := range windows {
+		recommendations[w] = Recommendation{Window: window}
+	}
+
+	taken := make(map[int]bool)
+	for _, o := range options {
+		if recommendations[o.window].Event != 0 || taken[o.event] {
+			continue
+		}
+		taken[o.event] = true
+		recommendations[o.window].Event = o.event
+		recommendations[o.window].Gain = o.gain
+		for _, gw := range calendar {
+			if gw.Event == o.event {
+				recommendations[o.window].Blank = gw.IsBlank()
+				recommendations[o.window].Double = gw.IsDouble()
+			}
+		}
+	}
+
+	return recommendations
+}
+
+// optimise builds the best squad for the points of events. Owned players are
+// priced at their selling price.
+func (a *Advisor) optimise(owned map[int]squad.Candidate, market transfers.Market, events []int, rules squad.Rules) ([]squad.Candidate, error) {
+	candidates := make([]squad.Candidate, 0, len(market.Players))
+	for id, c := range market.Players {
+		if o, ok := owned[id]; ok {
+			c = o
+		}
+		c.Points = 0
+		for _, event := range events {
+			c.Points += market.Points[id][event]
+		}
+		candidates = append(candidates, c)
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].PlayerID < candidates[j].PlayerID })
+
+	result, err := squad.Optimise(candidates, rules, squad.Options{BenchWeight: a.BenchWeight})
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append([]squad.Candidate(nil), result.Starters...), result.Bench...), nil
+}
+
+// score returns the points of the best XI of a squad in a gameweek, of its
+// bench, and of its captain, the best starter.
+func (a *Advisor) score(players []squad.Candidate, market transfers.Market, event int) (xi, bench, captain float64) {
+	squadPoints := make([]squad.Candidate, len(players))
+	for k, c := range players {
+		c.Points = market.Points[c.PlayerID][event]
+		squadPoints[k] = c
+	}
+
+	starters, benched, xi := squad.Lineup(squadPoints, a.Squad, 0)
+	for _, c := range starters {
+		captain = max(captain, c.Points)
+	}
+	for _, c := range benched {
+		bench += c.Points
+	}
+
+	return xi, bench, captain
+}
@@ -0,0 +1,55 @@
+package chips
+
+import (
+	"sort"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+// Gameweek is the shape of one gameweek of the fixture list: the clubs with
+// no fixture (blank) and with more than one (double).
+type Gameweek struct {
+	Event    int
+	Fixtures int
+	Blank    []int
+	Double   []int
+}
+
+func (g Gameweek) IsBlank() bool  { return len(g.Blank) > 0 }
+func (g Gameweek) IsDouble() bool { return len(g.Double) > 0 }
+
+// Calendar counts the fixtures of every club in teams in each gameweek of
+// [fromEvent, toEvent], so a club with no fixture left at all is still blank.
+// Postponed fixtures without an event are expected to be left out by the
+// caller.
+func Calendar(teams []int, fixtures []projection.Fixture, fromEvent, toEvent int) []Gameweek {
+	counts := make(map[int]map[int]int)
+	for _, f := range fixtures {
+		if counts[f.Event] == nil {
+			counts[f.Event] = make(map[int]int)
+		}
+		counts[f.Event][f.TeamH]++
+		counts[f.Event][f.TeamA]++
+	}
+
+	teams = append([]int(nil), teams...)
+	sort.Ints(teams)
+
+	calendar := make([]Gameweek, 0, toEvent-fromEvent+1)
+	for event := fromEvent; event <= toEvent; event++ {
+		gw := Gameweek{Event: event}
+		for _, team := range teams {
+			switch n := counts[event][team]; {
+			case n == 0:
+				gw.Blank = append(gw.Blank, team)
+			case n > 1:
+				gw.Double = append(gw.Double, team)
+			}
+			gw.Fixtures += counts[event][team]
+		}
+		gw.Fixtures /= 2
+		calendar = append(calendar, gw)
+	}
+
+	return calendar
+}
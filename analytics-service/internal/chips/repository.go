@@ -0,0 +1,67 @@
+package chips
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// LoadUsed returns the chips a manager has played in a season, oldest first.
+func (r *Repo) LoadUsed(ctx context.Context, managerID, seasonID int) ([]Used, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT chip_name, event
+		FROM manager_chips
+		WHERE manager_id = $1 AND season_id = $2
+		ORDER BY event`,
+		managerID, seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying manager_chips: %w", err)
+	}
+	defer rows.Close()
+
+	var used []Used
+	for rows.Next() {
+		var u Used
+		if err := rows.Scan(&u.Chip, &u.Event); err != nil {
+			return nil, fmt.Errorf("scanning manager_chips: %w", err)
+		}
+		used = append(used, u)
+	}
+
+	return used, rows.Err()
+}
+
+// LoadTeams returns the ids of the clubs in a season.
+func (r *Repo) LoadTeams(ctx context.Context, seasonID int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT team_id
+		FROM teams
+		WHERE season_id = $1
+		ORDER BY team_id`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying teams: %w", err)
+	}
+	defer rows.Close()
+
+	var teams []int
+	for rows.Next() {
+		var team int
+		if err := rows.Scan(&team); err != nil {
+			return nil, fmt.Errorf("scanning teams: %w", err)
+		}
+		teams = append(teams, team)
+	}
+
+	return teams, rows.Err()
+}
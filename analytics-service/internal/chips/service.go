@@ -0,0 +1,71 @@
+package chips
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/transfers"
+)
+
+type Service struct {
+	Repo      *Repo
+	Transfers *transfers.Service
+	Rules     Rules
+	Advisor   *Advisor
+}
+
+// AdviseManager reads a manager's squad and chip history, maps blank and
+// double gameweeks for the rest of the season and times the chips left over
+// the next horizon projected gameweeks.
+func (s *Service) AdviseManager(ctx context.Context, managerID, seasonID, horizon int) (*transfers.Manager, *Advice, error) {
+	manager, err := s.Transfers.Repo.LoadManager(ctx, managerID, seasonID, s.Transfers.Planner.Rules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	used, err := s.Repo.LoadUsed(ctx, managerID, seasonID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	from, err := s.Transfers.Projections.NextEvent(ctx, seasonID)
+	if err != nil {
+		return nil, nil, err
+	}
+	to := min(from+horizon-1, s.Rules.LastEvent)
+
+	teams, err := s.Repo.LoadTeams(ctx, seasonID)
+	if err != nil {
+		return nil, nil, err
+	}
+	fixtures, err := s.Transfers.Projections.LoadFixtures(ctx, seasonID, from, s.Rules.LastEvent)
+	if err != nil {
+		return nil, nil, err
+	}
+	calendar := Calendar(teams, fixtures, from, s.Rules.LastEvent)
+
+	market, err := s.Transfers.LoadMarket(ctx, manager, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	positions, err := s.Transfers.Squads.LoadPositions(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	advisor := *s.Advisor
+	if len(positions) > 0 {
+		advisor.Squad.Positions = positions
+	}
+
+	advice, err := advisor.Advise(manager, market, calendar, Remaining(used, s.Rules, from))
+	if err != nil {
+		return nil, nil, fmt.Errorf("advising chips for manager %d: %w", managerID, err)
+	}
+
+	log.Printf("Advised chips for manager %d, GW%d-%d: %d chips played, %d windows left",
+		managerID, from, to, len(used), len(advice.Recommendations))
+
+	return manager, advice, nil
+}
@@ -0,0 +1,66 @@
+package chips
+
+import "github.com/imadeddine-belkat/analytics-service/internal/transfers"
+
+// All is every chip the advisor times, in display order.
+var All = []string{
+	transfers.ChipWildcard,
+	transfers.ChipFreeHit,
+	transfers.ChipBenchBoost,
+	transfers.ChipTripleCaptain,
+}
+
+// Rules say when chips can be played. Since 2025/26 every chip is given once
+// per half of the season; a SecondHalfEvent of 0 gives one of each for the
+// whole season.
+type Rules struct {
+	SecondHalfEvent int
+	LastEvent       int
+}
+
+func DefaultRules() Rules {
+	return Rules{SecondHalfEvent: 20, LastEvent: 38}
+}
+
+// Used is a row of manager_chips.
+type Used struct {
+	Chip  string
+	Event int
+}
+
+// Window is a chip still available and the gameweeks it can be played in.
+type Window struct {
+	Chip      string
+	FromEvent int
+	ToEvent   int
+}
+
+// Remaining returns the chip windows a manager has not used up, cut to start
+// at fromEvent. Windows that have already closed are dropped.
+func Remaining(used []Used, rules Rules, fromEvent int) []Window {
+	halves := [][2]int{{1, rules.LastEvent}}
+	if rules.SecondHalfEvent > 1 && rules.SecondHalfEvent <= rules.LastEvent {
+		halves = [][2]int{{1, rules.SecondHalfEvent - 1}, {rules.SecondHalfEvent, rules.LastEvent}}
+	}
+
+	var windows []Window
+	for _, chip := range All {
+		for _, half := range halves {
+			if half[1] < fromEvent || playedIn(used, chip, half) {
+				continue
+			}
+			windows = append(windows, Window{Chip: chip, FromEvent: max(half[0], fromEvent), ToEvent: half[1]})
+		}
+	}
+
+	return windows
+}
+
+func playedIn(used []Used, chip string, half [2]int) bool {
+	for _, u := range used {
+		if u.Chip == chip && u.Event >= half[0] && u.Event <= half[1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package difficulty
+
+import (
+	"math"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+// ModelVersion is stored with every rating and difficulty. Bump it whenever
+// the maths below changes.
+const ModelVersion = "fdr-xg-v1"
+
+// Match is a finished fixture with the expected goals of each side. Source
+// says where they come from: "sofascore", "fpl" (the players' xG summed) or
+// "goals" when neither has xG.
+type Match struct {
+	FixtureID int
+	Event     int
+	Kickoff   time.Time
+	TeamH     int
+	TeamA     int
+	XGH       float64
+	XGA       float64
+	Source    string
+}
+
+// Fixture is a fixture to rate, with FPL's own difficulty for comparison.
+type Fixture struct {
+	FixtureID       int
+	Event           int
+	TeamH           int
+	TeamA           int
+	TeamHDifficulty int
+	TeamADifficulty int
+}
+
+// Rating is a team's attack and defence relative to the league average at
+// each venue: an attack of 1.2 creates 20% more xG than average, a defence of
+// 1.2 concedes 20% more.
+type Rating struct {
+	TeamID      int
+	AttackHome  float64
+	AttackAway  float64
+	DefenceHome float64
+	DefenceAway float64
+	Matches     int
+}
+
+func (r Rating) Attack(home bool) float64 {
+	if home {
+		return r.AttackHome
+	}
+	return r.AttackAway
+}
+
+func (r Rating) Defence(home bool) float64 {
+	if home {
+		return r.DefenceHome
+	}
+	return r.DefenceAway
+}
+
+// Ratings are every team's ratings as of the deadline of Event.
+type Ratings struct {
+	Event int
+	Teams map[int]Rating
+	// HomeXG and AwayXG are the league's average xG per match at each venue.
+	HomeXG float64
+	AwayXG float64
+}
+
+type Options struct {
+	// HalfLife is the number of a team's matches at a venue after which a
+	// match weighs half as much as the latest one.
+	HalfLife float64
+	// PriorHomeXG and PriorAwayXG seed the league averages, counting for
+	// PriorMatches matches.
+	PriorHomeXG  float64
+	PriorAwayXG  float64
+	PriorMatches float64
+}
+
+func DefaultOptions() Options {
+	return Options{HalfLife: 6, PriorHomeXG: 1.5, PriorAwayXG: 1.2, PriorMatches: 10}
+}
+
+// Priors turns FPL's strength ratings into starting ratings, each relative to
+// the mean of the league. FPL rates a strong defence higher, so defence is
+// inverted.
+func Priors(strengths map[int]projection.TeamStrength) map[int]Rating {
+	var attackH, attackA, defenceH, defenceA float64
+	for _, s := range strengths {
+		attackH += float64(s.AttackHome)
+		attackA += float64(s.AttackAway)
+		defenceH += float64(s.DefenceHome)
+		defenceA += float64(s.DefenceAway)
+	}
+	n := float64(len(strengths))
+
+	ratio := func(v, sum float64) float64 {
+		if v <= 0 || sum <= 0 {
+			return 1
+		}
+		return v / (sum / n)
+	}
+
+	priors := make(map[int]Rating, len(strengths))
+	for id, s := range strengths {
+		priors[id] = Rating{
+			TeamID:      id,
+			AttackHome:  ratio(float64(s.AttackHome), attackH),
+			AttackAway:  ratio(float64(s.AttackAway), attackA),
+			DefenceHome: 1 / ratio(float64(s.DefenceHome), defenceH),
+			DefenceAway: 1 / ratio(float64(s.DefenceAway), defenceA),
+		}
+	}
+	return priors
+}
+
+// Difficulty is how hard a fixture is for one side, separately for its
+// attackers (scoring) and its defenders (keeping goals out), on FPL's 1 to 5
+// scale: 3 is an average fixture and every doubling or halving of the
+// expected goals moves it by 2.
+type Difficulty struct {
+	SeasonID          int
+	Event             int
+	FixtureID         int
+	TeamID            int
+	OpponentTeamID    int
+	WasHome           bool
+	XGoalsFor         float64
+	XGoalsAgainst     float64
+	AttackDifficulty  float64
+	DefenceDifficulty float64
+	FPLDifficulty     int
+	ModelVersion      string
+}
+
+// Scale maps the ratio of expected goals to the league average onto 1 to 5,
+// where a higher ratio is easier.
+func Scale(ratio float64) float64 {
+	if ratio <= 0 {
+		return 5
+	}
+	return min(max(3-2*math.Log2(ratio), 1), 5)
+}
@@ -0,0 +1,114 @@
+package difficulty
+
+import (
+	"math"
+	"sort"
+)
+
+// Rate replays the matches played before event in kickoff order. Each match
+// moves the venue's ratings of both sides towards the xG it produced, relative
+// to the league average at the time, with exponentially decaying weights.
+// Teams start from their priors, or average when they have none.
+func Rate(matches []Match, priors map[int]Rating, event int, opts Options) *Ratings {
+	ratings := &Ratings{Event: event, Teams: make(map[int]Rating, len(priors))}
+	for id, p := range priors {
+		p.TeamID, p.Matches = id, 0
+		ratings.Teams[id] = p
+	}
+
+	played := make([]Match, 0, len(matches))
+	for _, m := range matches {
+		if m.Event < event {
+			played = append(played, m)
+		}
+	}
+	sort.SliceStable(played, func(i, j int) bool { return played[i].Kickoff.Before(played[j].Kickoff) })
+
+	alpha := 1.0
+	if opts.HalfLife > 0 {
+		alpha = 1 - math.Pow(0.5, 1/opts.HalfLife)
+	}
+
+	team := func(id int) Rating {
+		if r, ok := ratings.Teams[id]; ok {
+			return r
+		}
+		return Rating{TeamID: id, AttackHome: 1, AttackAway: 1, DefenceHome: 1, DefenceAway: 1}
+	}
+
+	sumH, sumA, n := opts.PriorHomeXG*opts.PriorMatches, opts.PriorAwayXG*opts.PriorMatches, opts.PriorMatches
+	for _, m := range played {
+		homeAvg, awayAvg := opts.PriorHomeXG, opts.PriorAwayXG
+		if n > 0 {
+			homeAvg, awayAvg = sumH/n, sumA/n
+		}
+
+		h, a := team(m.TeamH), team(m.TeamA)
+		if homeAvg > 0 {
+			h.AttackHome += alpha * (m.XGH/homeAvg - h.AttackHome)
+			a.DefenceAway += alpha * (m.XGH/homeAvg - a.DefenceAway)
+		}
+		if awayAvg > 0 {
+			a.AttackAway += alpha * (m.XGA/awayAvg - a.AttackAway)
+			h.DefenceHome += alpha * (m.XGA/awayAvg - h.DefenceHome)
+		}
+		h.Matches++
+		a.Matches++
+		ratings.Teams[m.TeamH], ratings.Teams[m.TeamA] = h, a
+
+		sumH, sumA, n = sumH+m.XGH, sumA+m.XGA, n+1
+	}
+
+	ratings.HomeXG, ratings.AwayXG = opts.PriorHomeXG, opts.PriorAwayXG
+	if n > 0 {
+		ratings.HomeXG, ratings.AwayXG = sumH/n, sumA/n
+	}
+	return ratings
+}
+
+// ExpectedGoals is the xG of each side of a fixture: the league average at the
+// venue scaled by the side's attack and the opponent's defence.
+func (r *Ratings) ExpectedGoals(teamH, teamA int) (home, away float64) {
+	h, okH := r.Teams[teamH]
+	a, okA := r.Teams[teamA]
+	if !okH {
+		h = Rating{AttackHome: 1, DefenceHome: 1}
+	}
+	if !okA {
+		a = Rating{AttackAway: 1, DefenceAway: 1}
+	}
+	return r.HomeXG * h.AttackHome * a.DefenceAway, r.AwayXG * a.AttackAway * h.DefenceHome
+}
+
+// Fixture rates a fixture for both sides, home first.
+func (r *Ratings) Fixture(seasonID int, f Fixture) [2]Difficulty {
+	xgH, xgA := r.ExpectedGoals(f.TeamH, f.TeamA)
+
+	side := func(team, opponent int, home bool, xgFor, xgAgainst, avgFor, avgAgainst float64, fdr int) Difficulty {
+		d := Difficulty{
+			SeasonID:       seasonID,
+			Event:          f.Event,
+			FixtureID:      f.FixtureID,
+			TeamID:         team,
+			OpponentTeamID: opponent,
+			WasHome:        home,
+			XGoalsFor:      xgFor,
+			XGoalsAgainst:  xgAgainst,
+			FPLDifficulty:  fdr,
+			ModelVersion:   ModelVersion,
+		}
+		d.AttackDifficulty, d.DefenceDifficulty = 3, 3
+		if avgFor > 0 {
+			d.AttackDifficulty = Scale(xgFor / avgFor)
+		}
+		if xgAgainst > 0 {
+			d.DefenceDifficulty = Scale(avgAgainst / xgAgainst)
+		}
+		return d
+	}
+
+	return [2]Difficulty{
+		side(f.TeamH, f.TeamA, true, xgH, xgA, r.HomeXG, r.AwayXG, f.TeamHDifficulty),
+		side(f.TeamA, f.TeamH, false, xgA, xgH, r.AwayXG, r.HomeXG, f.TeamADifficulty),
+	}
+}
@@ -0,0 +1,238 @@
+package difficulty
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/db/helpers"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// LoadMatches returns the finished fixtures of a season with each side's xG
+// summed over its players, or its goals when FPL has no xG for the fixture.
+func (r *Repo) LoadMatches(ctx context.Context, seasonID int) ([]Match, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH xg AS (
+		    SELECT fixture_id, was_home, SUM(expected_goals) AS xg
+		    FROM player_gameweek_stats
+		    WHERE season_id = $1
+		    GROUP BY fixture_id, was_home
+		)
+		SELECT f.fixture_id, f.event, f.kickoff_time, f.team_h, f.team_a,
+		       COALESCE(f.team_h_score, 0), COALESCE(f.team_a_score, 0), h.xg, a.xg
+		FROM fixtures f
+		LEFT JOIN xg h ON h.fixture_id = f.fixture_id AND h.was_home
+		LEFT JOIN xg a ON a.fixture_id = f.fixture_id AND NOT a.was_home
+		WHERE f.season_id = $1 AND f.finished AND f.event IS NOT NULL AND f.kickoff_time IS NOT NULL
+		ORDER BY f.kickoff_time, f.fixture_id`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying fixtures: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []Match
+	for rows.Next() {
+		var m Match
+		var goalsH, goalsA int
+		var xgH, xgA sql.NullFloat64
+		if err := rows.Scan(&m.FixtureID, &m.Event, &m.Kickoff, &m.TeamH, &m.TeamA, &goalsH, &goalsA, &xgH, &xgA); err != nil {
+			return nil, fmt.Errorf("scanning fixtures: %w", err)
+		}
+		if xgH.Valid && xgA.Valid {
+			m.XGH, m.XGA, m.Source = xgH.Float64, xgA.Float64, "fpl"
+		} else {
+			m.XGH, m.XGA, m.Source = float64(goalsH), float64(goalsA), "goals"
+		}
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
+
+// LoadStrengths returns FPL's strength ratings of every team of a season.
+func (r *Repo) LoadStrengths(ctx context.Context, seasonID int) (map[int]projection.TeamStrength, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT team_id,
+		       COALESCE(strength_attack_home, 0), COALESCE(strength_attack_away, 0),
+		       COALESCE(strength_defence_home, 0), COALESCE(strength_defence_away, 0)
+		FROM teams
+		WHERE season_id = $1`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying teams: %w", err)
+	}
+	defer rows.Close()
+
+	teams := make(map[int]projection.TeamStrength)
+	for rows.Next() {
+		var t projection.TeamStrength
+		if err := rows.Scan(&t.TeamID, &t.AttackHome, &t.AttackAway, &t.DefenceHome, &t.DefenceAway); err != nil {
+			return nil, fmt.Errorf("scanning teams: %w", err)
+		}
+		teams[t.TeamID] = t
+	}
+
+	return teams, rows.Err()
+}
+
+// LoadFixtures returns the fixtures of gameweeks [fromEvent, toEvent] with
+// FPL's difficulty.
+func (r *Repo) LoadFixtures(ctx context.Context, seasonID, fromEvent, toEvent int) ([]Fixture, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT fixture_id, event, team_h, team_a,
+		       COALESCE(team_h_difficulty, 0), COALESCE(team_a_difficulty, 0)
+		FROM fixtures
+		WHERE season_id = $1 AND event BETWEEN $2 AND $3
+		ORDER BY event, kickoff_time, fixture_id`,
+		seasonID, fromEvent, toEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying fixtures: %w", err)
+	}
+	defer rows.Close()
+
+	var fixtures []Fixture
+	for rows.Next() {
+		var f Fixture
+		if err := rows.Scan(&f.FixtureID, &f.Event, &f.TeamH, &f.TeamA, &f.TeamHDifficulty, &f.TeamADifficulty); err != nil {
+			return nil, fmt.Errorf("scanning fixtures: %w", err)
+		}
+		fixtures = append(fixtures, f)
+	}
+
+	return fixtures, rows.Err()
+}
+
+// LoadDifficulties returns the stored difficulty of every fixture of
+// gameweeks [fromEvent, toEvent] for a model version.
+func (r *Repo) LoadDifficulties(ctx context.Context, seasonID int, modelVersion string, fromEvent, toEvent int) ([]Difficulty, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT event, fixture_id, team_id, opponent_team_id, was_home,
+		       x_goals_for, x_goals_against, attack_difficulty, defence_difficulty, COALESCE(fpl_difficulty, 0)
+		FROM fixture_difficulty
+		WHERE season_id = $1 AND model_version = $2 AND event BETWEEN $3 AND $4
+		ORDER BY event, fixture_id, was_home DESC`,
+		seasonID, modelVersion, fromEvent, toEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying fixture_difficulty: %w", err)
+	}
+	defer rows.Close()
+
+	var difficulties []Difficulty
+	for rows.Next() {
+		d := Difficulty{SeasonID: seasonID, ModelVersion: modelVersion}
+		if err := rows.Scan(
+			&d.Event, &d.FixtureID, &d.TeamID, &d.OpponentTeamID, &d.WasHome,
+			&d.XGoalsFor, &d.XGoalsAgainst, &d.AttackDifficulty, &d.DefenceDifficulty, &d.FPLDifficulty,
+		); err != nil {
+			return nil, fmt.Errorf("scanning fixture_difficulty: %w", err)
+		}
+		difficulties = append(difficulties, d)
+	}
+
+	return difficulties, rows.Err()
+}
+
+// SaveRatings upserts the ratings of every team as of a gameweek.
+func (r *Repo) SaveRatings(ctx context.Context, seasonID int, ratings []*Ratings) error {
+	now := time.Now()
+
+	var rows [][]any
+	for _, rs := range ratings {
+		for _, t := range rs.Teams {
+			rows = append(rows, []any{
+				seasonID, rs.Event, t.TeamID, ModelVersion,
+				t.AttackHome, t.AttackAway, t.DefenceHome, t.DefenceAway, t.Matches,
+				rs.HomeXG, rs.AwayXG, now,
+			})
+		}
+	}
+
+	return helpers.BatchUpsert(ctx, r.db, helpers.UpsertOpts{
+		Table: "team_ratings",
+		Columns: []string{
+			"season_id", "event", "team_id", "model_version",
+			"attack_home", "attack_away", "defence_home", "defence_away", "matches",
+			"league_home_xg", "league_away_xg", "created_at",
+		},
+		ConflictCols: []string{"season_id", "event", "team_id", "model_version"},
+		Rows:         rows,
+	})
+}
+
+// SaveDifficulties upserts difficulties keyed by (season, fixture, team, model
+// version).
+func (r *Repo) SaveDifficulties(ctx context.Context, difficulties []Difficulty) error {
+	now := time.Now()
+
+	rows := make([][]any, 0, len(difficulties))
+	for _, d := range difficulties {
+		rows = append(rows, []any{
+			d.SeasonID, d.Event, d.FixtureID, d.TeamID, d.ModelVersion,
+			d.OpponentTeamID, d.WasHome, d.XGoalsFor, d.XGoalsAgainst,
+			d.AttackDifficulty, d.DefenceDifficulty, d.FPLDifficulty, now,
+		})
+	}
+
+	return helpers.BatchUpsert(ctx, r.db, helpers.UpsertOpts{
+		Table: "fixture_difficulty",
+		Columns: []string{
+			"season_id", "event", "fixture_id", "team_id", "model_version",
+			"opponent_team_id", "was_home", "x_goals_for", "x_goals_against",
+			"attack_difficulty", "defence_difficulty", "fpl_difficulty", "created_at",
+		},
+		ConflictCols: []string{"season_id", "fixture_id", "team_id", "model_version"},
+		Rows:         rows,
+	})
+}
+
+// SofascoreRepo reads match xG from the sofascore database.
+type SofascoreRepo struct {
+	db *sql.DB
+}
+
+func NewSofascoreRepo(db *sql.DB) *SofascoreRepo {
+	return &SofascoreRepo{db: db}
+}
+
+// LoadMatches returns the matches of a Sofascore season with full-time xG.
+func (r *SofascoreRepo) LoadMatches(ctx context.Context, seasonID int) ([]SofascoreMatch, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT m.match_id, m.start_time, m.home_team_id, m.away_team_id,
+		       o.expected_goals_home, o.expected_goals_away
+		FROM matches m
+		JOIN match_overview o ON o.match_id = m.match_id AND o.period = 'ALL'
+		WHERE m.season_id = $1 AND m.start_time IS NOT NULL
+		  AND o.expected_goals_home IS NOT NULL AND o.expected_goals_away IS NOT NULL`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []SofascoreMatch
+	for rows.Next() {
+		var m SofascoreMatch
+		if err := rows.Scan(&m.MatchID, &m.Kickoff, &m.HomeTeamID, &m.AwayTeamID, &m.XGH, &m.XGA); err != nil {
+			return nil, fmt.Errorf("scanning matches: %w", err)
+		}
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
@@ -0,0 +1,105 @@
+package difficulty
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+type Service struct {
+	Repo        *Repo
+	Projections *projection.Repo
+	// Sofascore is optional; without it the ratings use FPL's xG.
+	Sofascore *SofascoreRepo
+	Options   Options
+	// Horizon is the number of gameweeks rated when no last one is given.
+	Horizon int
+}
+
+// Result is the ratings as of each gameweek rated and the difficulty of its
+// fixtures.
+type Result struct {
+	FromEvent    int
+	ToEvent      int
+	Ratings      []*Ratings
+	Difficulties []Difficulty
+	// Sources counts the matches rated by where their xG came from.
+	Sources map[string]int
+}
+
+// Run rates the fixtures of gameweeks [fromEvent, toEvent] and stores the
+// ratings and difficulties. A fromEvent of 0 means the next unfinished
+// gameweek and a toEvent of 0 the end of the horizon. Each gameweek only uses
+// matches played before it, so past gameweeks can be rebuilt as they would
+// have been rated at the time.
+func (s *Service) Run(ctx context.Context, seasonID, sofascoreSeasonID, fromEvent, toEvent int) (*Result, error) {
+	if fromEvent == 0 {
+		next, err := s.Projections.NextEvent(ctx, seasonID)
+		if err != nil {
+			return nil, err
+		}
+		fromEvent = next
+	}
+	if toEvent == 0 {
+		toEvent = fromEvent + max(s.Horizon, 1) - 1
+	}
+	if toEvent < fromEvent {
+		return nil, fmt.Errorf("no gameweeks between GW%d and GW%d", fromEvent, toEvent)
+	}
+
+	matches, err := s.Repo.LoadMatches(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	if s.Sofascore != nil && sofascoreSeasonID != 0 {
+		sofascore, err := s.Sofascore.LoadMatches(ctx, sofascoreSeasonID)
+		if err != nil {
+			// FPL's xG is a fine fallback; don't fail the run.
+			log.Printf("Error loading Sofascore xG, using FPL's: %v", err)
+		} else {
+			matches = MergeXG(matches, sofascore, MapTeams(matches, sofascore))
+		}
+	}
+
+	strengths, err := s.Repo.LoadStrengths(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	priors := Priors(strengths)
+
+	fixtures, err := s.Repo.LoadFixtures(ctx, seasonID, fromEvent, toEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{FromEvent: fromEvent, ToEvent: toEvent, Sources: make(map[string]int)}
+	for _, m := range matches {
+		if m.Event < toEvent {
+			result.Sources[m.Source]++
+		}
+	}
+
+	byEvent := make(map[int][]Fixture)
+	for _, f := range fixtures {
+		byEvent[f.Event] = append(byEvent[f.Event], f)
+	}
+	for event := fromEvent; event <= toEvent; event++ {
+		ratings := Rate(matches, priors, event, s.Options)
+		result.Ratings = append(result.Ratings, ratings)
+		for _, f := range byEvent[event] {
+			sides := ratings.Fixture(seasonID, f)
+			result.Difficulties = append(result.Difficulties, sides[0], sides[1])
+		}
+	}
+
+	if err := s.Repo.SaveRatings(ctx, seasonID, result.Ratings); err != nil {
+		return nil, fmt.Errorf("saving team ratings: %w", err)
+	}
+	if err := s.Repo.SaveDifficulties(ctx, result.Difficulties); err != nil {
+		return nil, fmt.Errorf("saving fixture difficulty: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,82 @@
+package difficulty
+
+import "time"
+
+// SofascoreMatch is a Sofascore match with its full-time expected goals.
+type SofascoreMatch struct {
+	MatchID    int
+	Kickoff    time.Time
+	HomeTeamID int
+	AwayTeamID int
+	XGH        float64
+	XGA        float64
+}
+
+func matchDay(t time.Time) string {
+	return t.UTC().Format(time.DateOnly)
+}
+
+// MapTeams maps Sofascore team ids to FPL team ids. The two sources name teams
+// differently, but a team plays at most once a day and its match days over a
+// season are close to unique, so every Sofascore team goes to the FPL team it
+// most often plays on the same day at the same venue.
+func MapTeams(fixtures []Match, matches []SofascoreMatch) map[int]int {
+	byDay := make(map[string][]Match)
+	for _, f := range fixtures {
+		day := matchDay(f.Kickoff)
+		byDay[day] = append(byDay[day], f)
+	}
+
+	votes := make(map[int]map[int]int)
+	vote := func(sofascore, fpl int) {
+		if votes[sofascore] == nil {
+			votes[sofascore] = make(map[int]int)
+		}
+		votes[sofascore][fpl]++
+	}
+	for _, m := range matches {
+		for _, f := range byDay[matchDay(m.Kickoff)] {
+			vote(m.HomeTeamID, f.TeamH)
+			vote(m.AwayTeamID, f.TeamA)
+		}
+	}
+
+	teams := make(map[int]int, len(votes))
+	for sofascore, counts := range votes {
+		best, bestVotes := 0, 0
+		for fpl, n := range counts {
+			if n > bestVotes || (n == bestVotes && fpl < best) {
+				best, bestVotes = fpl, n
+			}
+		}
+		teams[sofascore] = best
+	}
+	return teams
+}
+
+// MergeXG takes the expected goals of every match Sofascore covers from
+// Sofascore, whose model includes every shot, and keeps the rest as they are.
+func MergeXG(matches []Match, sofascore []SofascoreMatch, teams map[int]int) []Match {
+	type key struct {
+		day   string
+		teamH int
+		teamA int
+	}
+	xg := make(map[key]SofascoreMatch, len(sofascore))
+	for _, m := range sofascore {
+		h, okH := teams[m.HomeTeamID]
+		a, okA := teams[m.AwayTeamID]
+		if okH && okA {
+			xg[key{matchDay(m.Kickoff), h, a}] = m
+		}
+	}
+
+	merged := make([]Match, len(matches))
+	for k, m := range matches {
+		if s, ok := xg[key{matchDay(m.Kickoff), m.TeamH, m.TeamA}]; ok {
+			m.XGH, m.XGA, m.Source = s.XGH, s.XGA, "sofascore"
+		}
+		merged[k] = m
+	}
+	return merged
+}
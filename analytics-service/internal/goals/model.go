@@ -0,0 +1,90 @@
+package goals
+
+import (
+	"github.com/imadeddine-belkat/analytics-service/internal/difficulty"
+	"github.com/imadeddine-belkat/analytics-service/internal/ratings"
+)
+
+// ModelVersion is stored and published with every fixture's probabilities.
+// Bump it whenever the maths changes.
+const ModelVersion = "goals-poisson-v1"
+
+// MaxGoals is the last goal count with its own probability; Goals[MaxGoals]
+// is that many or more.
+const MaxGoals = 3
+
+// Side is one team's goal distribution in a fixture. CleanSheet is the
+// probability of the opponent not scoring.
+type Side struct {
+	TeamID        int
+	ExpectedGoals float64
+	CleanSheet    float64
+	Goals         [MaxGoals + 1]float64
+}
+
+type Probabilities struct {
+	SeasonID     int
+	Event        int
+	FixtureID    int
+	Home         Side
+	Away         Side
+	ModelVersion string
+}
+
+// Compute turns both sides' expected goals from the difficulty ratings into
+// goal probabilities. Dixon-Coles' low-score correction leaves each side's
+// own distribution unchanged, so the grid is plain Poisson.
+func Compute(home, away difficulty.Difficulty) Probabilities {
+	grid := ratings.ScorelineGrid(home.XGoalsFor, away.XGoalsFor, 0, MaxGoals)
+
+	p := Probabilities{
+		SeasonID:     home.SeasonID,
+		Event:        home.Event,
+		FixtureID:    home.FixtureID,
+		Home:         Side{TeamID: home.TeamID, ExpectedGoals: home.XGoalsFor},
+		Away:         Side{TeamID: away.TeamID, ExpectedGoals: away.XGoalsFor},
+		ModelVersion: ModelVersion,
+	}
+	for h := range grid {
+		for a := range grid[h] {
+			p.Home.Goals[h] += grid[h][a]
+			p.Away.Goals[a] += grid[h][a]
+		}
+	}
+	p.Home.CleanSheet = p.Away.Goals[0]
+	p.Away.CleanSheet = p.Home.Goals[0]
+	return p
+}
+
+// Pair matches up the two sides of every fixture, home first, skipping
+// fixtures with a side missing.
+func Pair(difficulties []difficulty.Difficulty) [][2]difficulty.Difficulty {
+	type key struct{ season, fixture int }
+	sides := make(map[key]*[2]difficulty.Difficulty)
+	seen := make(map[key][2]bool)
+	var order []key
+
+	for _, d := range difficulties {
+		k := key{d.SeasonID, d.FixtureID}
+		if sides[k] == nil {
+			sides[k] = &[2]difficulty.Difficulty{}
+			order = append(order, k)
+		}
+		i := 1
+		if d.WasHome {
+			i = 0
+		}
+		sides[k][i] = d
+		s := seen[k]
+		s[i] = true
+		seen[k] = s
+	}
+
+	var pairs [][2]difficulty.Difficulty
+	for _, k := range order {
+		if seen[k][0] && seen[k][1] {
+			pairs = append(pairs, *sides[k])
+		}
+	}
+	return pairs
+}
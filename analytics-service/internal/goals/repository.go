@@ -0,0 +1,48 @@
+package goals
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/db/helpers"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// Save upserts both sides of every fixture keyed by (season, fixture, team,
+// model version).
+func (r *Repo) Save(ctx context.Context, probabilities []Probabilities) error {
+	now := time.Now()
+
+	rows := make([][]any, 0, 2*len(probabilities))
+	for _, p := range probabilities {
+		for _, side := range []struct {
+			team, opponent Side
+			home           bool
+		}{{p.Home, p.Away, true}, {p.Away, p.Home, false}} {
+			rows = append(rows, []any{
+				p.SeasonID, p.Event, p.FixtureID, side.team.TeamID, p.ModelVersion,
+				side.opponent.TeamID, side.home, side.team.ExpectedGoals, side.team.CleanSheet,
+				side.team.Goals[0], side.team.Goals[1], side.team.Goals[2], side.team.Goals[3], now,
+			})
+		}
+	}
+
+	return helpers.BatchUpsert(ctx, r.db, helpers.UpsertOpts{
+		Table: "fixture_goal_probabilities",
+		Columns: []string{
+			"season_id", "event", "fixture_id", "team_id", "model_version",
+			"opponent_team_id", "was_home", "expected_goals", "clean_sheet",
+			"goals_0", "goals_1", "goals_2", "goals_3_plus", "created_at",
+		},
+		ConflictCols: []string{"season_id", "fixture_id", "team_id", "model_version"},
+		Rows:         rows,
+	})
+}
@@ -0,0 +1,68 @@
+package goals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/difficulty"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+)
+
+type Service struct {
+	Repo       *Repo
+	Difficulty *difficulty.Service
+	// Producer is optional; without it probabilities are only stored.
+	Producer *kafka.Producer
+	Topic    string
+}
+
+// Run rates the fixtures of gameweeks [fromEvent, toEvent] with the
+// difficulty engine, which also refreshes the stored difficulties, then
+// stores and publishes each fixture's goal probabilities. Zero events
+// default as in difficulty.Service.Run.
+func (s *Service) Run(ctx context.Context, seasonID, sofascoreSeasonID, fromEvent, toEvent int) ([]Probabilities, error) {
+	rated, err := s.Difficulty.Run(ctx, seasonID, sofascoreSeasonID, fromEvent, toEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	var probabilities []Probabilities
+	for _, sides := range Pair(rated.Difficulties) {
+		probabilities = append(probabilities, Compute(sides[0], sides[1]))
+	}
+
+	if err := s.Repo.Save(ctx, probabilities); err != nil {
+		return nil, fmt.Errorf("saving goal probabilities: %w", err)
+	}
+
+	if s.Producer != nil {
+		for _, p := range probabilities {
+			key := []byte(fmt.Sprintf("%d-%d", p.SeasonID, p.FixtureID))
+			if err := s.Producer.PublishWithProcess(ctx, Message(p), s.Topic, key); err != nil {
+				return nil, fmt.Errorf("publishing fixture %d: %w", p.FixtureID, err)
+			}
+		}
+	}
+
+	return probabilities, nil
+}
+
+func Message(p Probabilities) *fpl.FixtureGoalProbabilitiesMessage {
+	side := func(s Side) *fpl.TeamGoalProbabilities {
+		return &fpl.TeamGoalProbabilities{
+			TeamId:        int32(s.TeamID),
+			ExpectedGoals: s.ExpectedGoals,
+			CleanSheet:    s.CleanSheet,
+			Goals:         s.Goals[:],
+		}
+	}
+	return &fpl.FixtureGoalProbabilitiesMessage{
+		SeasonId:     int32(p.SeasonID),
+		FixtureId:    int32(p.FixtureID),
+		Event:        int32(p.Event),
+		ModelVersion: p.ModelVersion,
+		Home:         side(p.Home),
+		Away:         side(p.Away),
+	}
+}
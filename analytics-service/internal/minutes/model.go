@@ -0,0 +1,183 @@
+package minutes
+
+import (
+	"math"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+// ModelVersion is stored with every prediction. Bump it whenever the maths
+// changes.
+const ModelVersion = "mins-v1"
+
+// Appearance is a player's part in one of their team's league matches, played
+// or not.
+type Appearance struct {
+	Kickoff time.Time
+	Minutes int
+	Started bool
+}
+
+// Player is what the model knows about a player before a deadline.
+type Player struct {
+	PlayerID    int
+	TeamID      int
+	ElementType int
+	FirstName   string
+	SecondName  string
+	WebName     string
+	Status      string
+	// History is the player's league matches in kickoff order.
+	History []Appearance
+	// LastStart is their latest start in any competition, when known.
+	LastStart time.Time
+	// Missing is "missing" or "doubtful" when Sofascore listed them so for their
+	// team's latest match.
+	Missing string
+}
+
+// Fixture is one side of a fixture to predict.
+type Fixture struct {
+	FixtureID int
+	Event     int
+	Kickoff   time.Time
+	TeamID    int
+}
+
+type Options struct {
+	// HalfLife is the number of team matches after which a match weighs half
+	// as much as the latest one.
+	HalfLife float64
+	// The priors count for PriorMatches matches.
+	PriorMatches      float64
+	PriorStartRate    float64
+	PriorSubRate      float64
+	PriorStartMinutes float64
+	PriorSubMinutes   float64
+	// Starting again within ShortRestDays of a start costs ShortRestPenalty
+	// of the start probability.
+	ShortRestDays    float64
+	ShortRestPenalty float64
+	// MissingFactor and DoubtfulFactor scale availability by Sofascore's
+	// latest missing players list.
+	MissingFactor  float64
+	DoubtfulFactor float64
+}
+
+func DefaultOptions() Options {
+	return Options{
+		HalfLife:          4,
+		PriorMatches:      0.5,
+		PriorStartRate:    0.25,
+		PriorSubRate:      0.3,
+		PriorStartMinutes: 80,
+		PriorSubMinutes:   20,
+		ShortRestDays:     4,
+		ShortRestPenalty:  0.15,
+		MissingFactor:     0.25,
+		DoubtfulFactor:    0.75,
+	}
+}
+
+// Prediction is a player's chance of starting and coming off the bench in a
+// fixture, and their expected minutes. RestDays is nil when the team's previous
+// match is unknown.
+type Prediction struct {
+	SeasonID         int
+	Event            int
+	FixtureID        int
+	PlayerID         int
+	TeamID           int
+	Kickoff          time.Time
+	StartProbability float64
+	SubProbability   float64
+	ExpectedMinutes  float64
+	Availability     float64
+	RestDays         *float64
+	ModelVersion     string
+}
+
+// Usage is a player's recency-weighted record: how often they start, how often
+// they come on when they don't, and their minutes either way, each shrunk
+// towards its prior.
+type Usage struct {
+	StartRate    float64
+	SubRate      float64
+	StartMinutes float64
+	SubMinutes   float64
+}
+
+func UsageOf(history []Appearance, opts Options) Usage {
+	var w, starts, benched, subbedOn, startMinutes, subMinutes float64
+	n := len(history)
+	for i, a := range history {
+		weight := 1.0
+		if opts.HalfLife > 0 {
+			weight = math.Pow(0.5, float64(n-1-i)/opts.HalfLife)
+		}
+		w += weight
+		switch {
+		case a.Started:
+			starts += weight
+			startMinutes += weight * float64(a.Minutes)
+		default:
+			benched += weight
+			if a.Minutes > 0 {
+				subbedOn += weight
+				subMinutes += weight * float64(a.Minutes)
+			}
+		}
+	}
+
+	k := opts.PriorMatches
+	return Usage{
+		StartRate:    (starts + k*opts.PriorStartRate) / (w + k),
+		SubRate:      (subbedOn + k*opts.PriorSubRate) / (benched + k),
+		StartMinutes: (startMinutes + k*opts.PriorStartMinutes) / (starts + k),
+		SubMinutes:   (subMinutes + k*opts.PriorSubMinutes) / (subbedOn + k),
+	}
+}
+
+// Predict predicts a player's part in a fixture. previous is their team's
+// previous match in any competition, zero when unknown.
+func Predict(seasonID int, p Player, f Fixture, previous time.Time, opts Options) Prediction {
+	usage := UsageOf(p.History, opts)
+
+	availability := projection.Availability(p.Status)
+	switch p.Missing {
+	case "missing":
+		availability *= opts.MissingFactor
+	case "doubtful":
+		availability *= opts.DoubtfulFactor
+	}
+
+	pred := Prediction{
+		SeasonID:     seasonID,
+		Event:        f.Event,
+		FixtureID:    f.FixtureID,
+		PlayerID:     p.PlayerID,
+		TeamID:       f.TeamID,
+		Kickoff:      f.Kickoff,
+		Availability: availability,
+		ModelVersion: ModelVersion,
+	}
+
+	start := usage.StartRate
+	if !previous.IsZero() {
+		rest := f.Kickoff.Sub(previous).Hours() / 24
+		pred.RestDays = &rest
+		if rest < opts.ShortRestDays && sameDay(p.LastStart, previous) {
+			start *= 1 - opts.ShortRestPenalty
+		}
+	}
+
+	pred.StartProbability = availability * start
+	pred.SubProbability = availability * (1 - start) * usage.SubRate
+	pred.ExpectedMinutes = pred.StartProbability*usage.StartMinutes + pred.SubProbability*usage.SubMinutes
+	return pred
+}
+
+func sameDay(a, b time.Time) bool {
+	return !a.IsZero() && a.UTC().Format(time.DateOnly) == b.UTC().Format(time.DateOnly)
+}
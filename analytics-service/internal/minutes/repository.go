@@ -0,0 +1,233 @@
+package minutes
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/db/helpers"
+	"github.com/imadeddine-belkat/analytics-service/internal/difficulty"
+	"github.com/lib/pq"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// LoadPlayers returns a season's outfielders and goalkeepers with their
+// league history before beforeEvent. Status is the latest known one.
+func (r *Repo) LoadPlayers(ctx context.Context, seasonID, beforeEvent int) ([]Player, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id, team_id, element_type_id, COALESCE(first_name, ''), COALESCE(second_name, ''),
+		       COALESCE(web_name, ''), COALESCE(status, 'a')
+		FROM players
+		WHERE season_id = $1 AND NOT COALESCE(removed, FALSE) AND element_type_id BETWEEN 1 AND 4
+		ORDER BY player_id`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying players: %w", err)
+	}
+	defer rows.Close()
+
+	var players []Player
+	index := make(map[int]int)
+	for rows.Next() {
+		var p Player
+		if err := rows.Scan(&p.PlayerID, &p.TeamID, &p.ElementType, &p.FirstName, &p.SecondName, &p.WebName, &p.Status); err != nil {
+			return nil, fmt.Errorf("scanning players: %w", err)
+		}
+		index[p.PlayerID] = len(players)
+		players = append(players, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = r.db.QueryContext(ctx, `
+		SELECT player_id, kickoff_time, COALESCE(minutes, 0), COALESCE(starts, 0) > 0
+		FROM player_gameweek_stats
+		WHERE season_id = $1 AND event < $2 AND kickoff_time IS NOT NULL
+		ORDER BY kickoff_time, fixture_id`,
+		seasonID, beforeEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying player_gameweek_stats: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var a Appearance
+		if err := rows.Scan(&id, &a.Kickoff, &a.Minutes, &a.Started); err != nil {
+			return nil, fmt.Errorf("scanning player_gameweek_stats: %w", err)
+		}
+		i, ok := index[id]
+		if !ok {
+			continue
+		}
+		players[i].History = append(players[i].History, a)
+		if a.Started {
+			players[i].LastStart = a.Kickoff
+		}
+	}
+
+	return players, rows.Err()
+}
+
+// LoadSeasonFixtures returns every fixture of a season with a kickoff.
+func (r *Repo) LoadSeasonFixtures(ctx context.Context, seasonID int) ([]difficulty.Match, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT fixture_id, COALESCE(event, 0), kickoff_time, team_h, team_a
+		FROM fixtures
+		WHERE season_id = $1 AND kickoff_time IS NOT NULL
+		ORDER BY kickoff_time, fixture_id`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying fixtures: %w", err)
+	}
+	defer rows.Close()
+
+	var fixtures []difficulty.Match
+	for rows.Next() {
+		var f difficulty.Match
+		if err := rows.Scan(&f.FixtureID, &f.Event, &f.Kickoff, &f.TeamH, &f.TeamA); err != nil {
+			return nil, fmt.Errorf("scanning fixtures: %w", err)
+		}
+		fixtures = append(fixtures, f)
+	}
+
+	return fixtures, rows.Err()
+}
+
+// Save upserts predictions keyed by (season, fixture, player, model version).
+func (r *Repo) Save(ctx context.Context, predictions []Prediction) error {
+	now := time.Now()
+
+	rows := make([][]any, 0, len(predictions))
+	for _, p := range predictions {
+		rows = append(rows, []any{
+			p.SeasonID, p.Event, p.FixtureID, p.PlayerID, p.ModelVersion,
+			p.TeamID, p.Kickoff, p.StartProbability, p.SubProbability, p.ExpectedMinutes,
+			p.Availability, p.RestDays, now,
+		})
+	}
+
+	return helpers.BatchUpsert(ctx, r.db, helpers.UpsertOpts{
+		Table: "player_minutes",
+		Columns: []string{
+			"season_id", "event", "fixture_id", "player_id", "model_version",
+			"team_id", "kickoff", "start_probability", "sub_probability", "expected_minutes",
+			"availability", "rest_days", "created_at",
+		},
+		ConflictCols: []string{"season_id", "fixture_id", "player_id", "model_version"},
+		Rows:         rows,
+	})
+}
+
+// SofascoreRepo reads matches and lineups of every competition from the
+// sofascore database.
+type SofascoreRepo struct {
+	db *sql.DB
+}
+
+func NewSofascoreRepo(db *sql.DB) *SofascoreRepo {
+	return &SofascoreRepo{db: db}
+}
+
+// LoadSeasonMatches returns the matches of a season, without xG, to map
+// teams by.
+func (r *SofascoreRepo) LoadSeasonMatches(ctx context.Context, seasonID int) ([]difficulty.SofascoreMatch, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT match_id, start_time, home_team_id, away_team_id
+		FROM matches
+		WHERE season_id = $1 AND start_time IS NOT NULL
+		  AND home_team_id IS NOT NULL AND away_team_id IS NOT NULL`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []difficulty.SofascoreMatch
+	for rows.Next() {
+		var m difficulty.SofascoreMatch
+		if err := rows.Scan(&m.MatchID, &m.Kickoff, &m.HomeTeamID, &m.AwayTeamID); err != nil {
+			return nil, fmt.Errorf("scanning matches: %w", err)
+		}
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
+
+// LoadTeamMatches returns the matches of some teams kicking off in [from, to),
+// one per team.
+func (r *SofascoreRepo) LoadTeamMatches(ctx context.Context, teamIDs []int, from, to time.Time) ([]TeamMatch, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT match_id, team_id, start_time
+		FROM (
+		    SELECT match_id, home_team_id AS team_id, start_time FROM matches
+		    UNION ALL
+		    SELECT match_id, away_team_id, start_time FROM matches
+		) m
+		WHERE team_id = ANY($1) AND start_time >= $2 AND start_time < $3
+		ORDER BY start_time, match_id`,
+		pq.Array(teamIDs), from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []TeamMatch
+	for rows.Next() {
+		var m TeamMatch
+		if err := rows.Scan(&m.MatchID, &m.TeamID, &m.Kickoff); err != nil {
+			return nil, fmt.Errorf("scanning matches: %w", err)
+		}
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
+
+// LoadLineups returns the lineups and missing players of some teams' matches
+// kicking off in [from, to).
+func (r *SofascoreRepo) LoadLineups(ctx context.Context, teamIDs []int, from, to time.Time) ([]LineupPlayer, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.match_id, l.team_id, l.player_id, COALESCE(l.player_name, ''), m.start_time, l.substitute, ''
+		FROM match_lineup_players l
+		JOIN matches m ON m.match_id = l.match_id
+		WHERE l.team_id = ANY($1) AND m.start_time >= $2 AND m.start_time < $3
+		UNION ALL
+		SELECT x.match_id, x.team_id, x.player_id, COALESCE(x.player_name, ''), m.start_time, FALSE, COALESCE(x.type, 'missing')
+		FROM match_missing_players x
+		JOIN matches m ON m.match_id = x.match_id
+		WHERE x.team_id = ANY($1) AND m.start_time >= $2 AND m.start_time < $3
+		ORDER BY 5, 1`,
+		pq.Array(teamIDs), from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying lineups: %w", err)
+	}
+	defer rows.Close()
+
+	var lineups []LineupPlayer
+	for rows.Next() {
+		var l LineupPlayer
+		if err := rows.Scan(&l.MatchID, &l.TeamID, &l.PlayerID, &l.Name, &l.Kickoff, &l.Substitute, &l.Missing); err != nil {
+			return nil, fmt.Errorf("scanning lineups: %w", err)
+		}
+		lineups = append(lineups, l)
+	}
+
+	return lineups, rows.Err()
+}
@@ -0,0 +1,133 @@
+package minutes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/difficulty"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+type Service struct {
+	Repo        *Repo
+	Projections *projection.Repo
+	// Sofascore is optional; without it congestion only counts league
+	// fixtures and nothing is known of cup lineups or missing players.
+	Sofascore *SofascoreRepo
+	Options   Options
+	// LookbackDays is how far before the deadline Sofascore's matches count.
+	LookbackDays int
+}
+
+// Run predicts every player's part in each of their team's fixtures of a
+// gameweek, 0 meaning the next one, and stores the predictions.
+func (s *Service) Run(ctx context.Context, seasonID, sofascoreSeasonID, event int) ([]Prediction, error) {
+	if event == 0 {
+		next, err := s.Projections.NextEvent(ctx, seasonID)
+		if err != nil {
+			return nil, err
+		}
+		event = next
+	}
+
+	players, err := s.Repo.LoadPlayers(ctx, seasonID, event)
+	if err != nil {
+		return nil, err
+	}
+	season, err := s.Repo.LoadSeasonFixtures(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := make(Schedule)
+	var fixtures []Fixture
+	for _, f := range season {
+		schedule.Add(f.TeamH, f.Kickoff)
+		schedule.Add(f.TeamA, f.Kickoff)
+		if f.Event == event {
+			fixtures = append(fixtures,
+				Fixture{FixtureID: f.FixtureID, Event: event, Kickoff: f.Kickoff, TeamID: f.TeamH},
+				Fixture{FixtureID: f.FixtureID, Event: event, Kickoff: f.Kickoff, TeamID: f.TeamA})
+		}
+	}
+	if len(fixtures) == 0 {
+		return nil, fmt.Errorf("gameweek %d has no fixtures", event)
+	}
+
+	deadline, last := fixtures[0].Kickoff, fixtures[0].Kickoff
+	for _, f := range fixtures {
+		deadline = minTime(deadline, f.Kickoff)
+		last = maxTime(last, f.Kickoff)
+	}
+
+	if s.Sofascore != nil && sofascoreSeasonID != 0 {
+		// Sofascore is a bonus; predict from FPL alone when it fails.
+		if err := s.addSofascore(ctx, sofascoreSeasonID, season, players, schedule, deadline, last); err != nil {
+			log.Printf("Error reading Sofascore, predicting from FPL only: %v", err)
+		}
+	}
+
+	byTeam := make(map[int][]Player)
+	for _, p := range players {
+		byTeam[p.TeamID] = append(byTeam[p.TeamID], p)
+	}
+
+	var predictions []Prediction
+	for _, f := range fixtures {
+		previous := schedule.Previous(f.TeamID, f.Kickoff)
+		for _, p := range byTeam[f.TeamID] {
+			predictions = append(predictions, Predict(seasonID, p, f, previous, s.Options))
+		}
+	}
+
+	if err := s.Repo.Save(ctx, predictions); err != nil {
+		return nil, fmt.Errorf("saving player minutes: %w", err)
+	}
+	return predictions, nil
+}
+
+// addSofascore adds the teams' matches in every competition to schedule and
+// their lineups to players.
+func (s *Service) addSofascore(ctx context.Context, seasonID int, season []difficulty.Match, players []Player, schedule Schedule, deadline, last time.Time) error {
+	league, err := s.Sofascore.LoadSeasonMatches(ctx, seasonID)
+	if err != nil {
+		return err
+	}
+	teams := difficulty.MapTeams(season, league)
+	ids := make([]int, 0, len(teams))
+	for id := range teams {
+		ids = append(ids, id)
+	}
+
+	from := deadline.AddDate(0, 0, -s.LookbackDays)
+	matches, err := s.Sofascore.LoadTeamMatches(ctx, ids, from, last)
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		schedule.Add(teams[m.TeamID], m.Kickoff)
+	}
+
+	lineups, err := s.Sofascore.LoadLineups(ctx, ids, from, deadline)
+	if err != nil {
+		return err
+	}
+	Apply(players, lineups, MapPlayers(players, lineups, teams), teams, deadline)
+	return nil
+}
+
+func minTime(a, b time.Time) time.Time {
+	if b.Before(a) {
+		return b
+	}
+	return a
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,160 @@
+package minutes
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// TeamMatch is a match of a team in any competition.
+type TeamMatch struct {
+	MatchID int
+	TeamID  int
+	Kickoff time.Time
+}
+
+// LineupPlayer is a Sofascore lineup entry. Missing is "missing" or
+// "doubtful" for players listed as unavailable, and empty otherwise.
+type LineupPlayer struct {
+	MatchID    int
+	TeamID     int
+	PlayerID   int
+	Name       string
+	Kickoff    time.Time
+	Substitute bool
+	Missing    string
+}
+
+// Schedule is every team's kickoffs, FPL team ids throughout.
+type Schedule map[int][]time.Time
+
+func (s Schedule) Add(team int, kickoff time.Time) {
+	for _, k := range s[team] {
+		if sameDay(k, kickoff) {
+			return
+		}
+	}
+	s[team] = append(s[team], kickoff)
+	sort.Slice(s[team], func(i, j int) bool { return s[team][i].Before(s[team][j]) })
+}
+
+// Previous is a team's last kickoff before t, or zero.
+func (s Schedule) Previous(team int, t time.Time) time.Time {
+	var previous time.Time
+	for _, k := range s[team] {
+		if !k.Before(t) || sameDay(k, t) {
+			break
+		}
+		previous = k
+	}
+	return previous
+}
+
+var folds = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ä", "a", "ã", "a", "å", "a", "ą", "a",
+	"ç", "c", "ć", "c", "č", "c",
+	"é", "e", "è", "e", "ê", "e", "ë", "e", "ę", "e", "ě", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ł", "l", "ñ", "n", "ń", "n",
+	"ó", "o", "ò", "o", "ô", "o", "ö", "o", "õ", "o", "ø", "o", "ő", "o",
+	"ř", "r", "ś", "s", "š", "s", "ş", "s", "ß", "ss",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u", "ů", "u", "ű", "u",
+	"ý", "y", "ÿ", "y", "ž", "z", "ź", "z", "ż", "z",
+	"-", " ", "'", "", ".", " ",
+)
+
+// fold lowercases a name and drops its accents and punctuation.
+func fold(name string) string {
+	return strings.Join(strings.Fields(folds.Replace(strings.ToLower(name))), " ")
+}
+
+// MapPlayers maps Sofascore player ids to FPL player ids within each team,
+// teams mapped by teams (Sofascore to FPL). A Sofascore name matches an FPL
+// player's full name, or ends with the last word of their surname or web
+// name; players matching several FPL players are left out.
+func MapPlayers(players []Player, lineups []LineupPlayer, teams map[int]int) map[int]int {
+	byTeam := make(map[int][]Player)
+	for _, p := range players {
+		byTeam[p.TeamID] = append(byTeam[p.TeamID], p)
+	}
+
+	mapped := make(map[int]int)
+	done := make(map[int]bool)
+	for _, l := range lineups {
+		if done[l.PlayerID] {
+			continue
+		}
+		team, ok := teams[l.TeamID]
+		if !ok {
+			continue
+		}
+		done[l.PlayerID] = true
+
+		name := fold(l.Name)
+		var full, partial []int
+		for _, p := range byTeam[team] {
+			switch {
+			case name == fold(p.FirstName+" "+p.SecondName):
+				full = append(full, p.PlayerID)
+			case endsWithName(name, lastWord(p.SecondName)) || endsWithName(name, lastWord(p.WebName)):
+				partial = append(partial, p.PlayerID)
+			}
+		}
+		switch {
+		case len(full) == 1:
+			mapped[l.PlayerID] = full[0]
+		case len(full) == 0 && len(partial) == 1:
+			mapped[l.PlayerID] = partial[0]
+		}
+	}
+	return mapped
+}
+
+func lastWord(name string) string {
+	words := strings.Fields(fold(name))
+	if len(words) == 0 {
+		return ""
+	}
+	return words[len(words)-1]
+}
+
+func endsWithName(name, suffix string) bool {
+	return suffix != "" && (name == suffix || strings.HasSuffix(name, " "+suffix))
+}
+
+// Apply records each mapped player's latest start and whether Sofascore listed
+// them as missing for their team's latest match before deadline.
+func Apply(players []Player, lineups []LineupPlayer, ids map[int]int, teams map[int]int, deadline time.Time) {
+	index := make(map[int]int, len(players))
+	for i, p := range players {
+		index[p.PlayerID] = i
+	}
+
+	latest := make(map[int]LineupPlayer)
+	for _, l := range lineups {
+		if !l.Kickoff.Before(deadline) {
+			continue
+		}
+		if last, ok := latest[l.TeamID]; !ok || l.Kickoff.After(last.Kickoff) {
+			latest[l.TeamID] = l
+		}
+	}
+
+	for _, l := range lineups {
+		if !l.Kickoff.Before(deadline) {
+			continue
+		}
+		id, mapped := ids[l.PlayerID]
+		i, ok := index[id]
+		if !mapped || !ok {
+			continue
+		}
+		p := &players[i]
+		if l.Missing == "" && !l.Substitute && l.Kickoff.After(p.LastStart) {
+			p.LastStart = l.Kickoff
+		}
+		if l.MatchID == latest[l.TeamID].MatchID && teams[l.TeamID] == p.TeamID {
+			p.Missing = l.Missing
+		}
+	}
+}
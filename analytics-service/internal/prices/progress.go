@@ -0,0 +1,156 @@
+package prices
+
+import (
+	"math"
+	"time"
+)
+
+// Snapshot is a player's price and transfers at one refresh of player_costs.
+// The transfer counts are the gameweek's, which FPL restarts at every deadline.
+type Snapshot struct {
+	PlayerID     int
+	Event        int
+	TakenAt      time.Time
+	NowCost      int
+	TransfersIn  int
+	TransfersOut int
+	// SelectedBy is a percentage, like selected_by_percent.
+	SelectedBy float64
+}
+
+func (s Snapshot) Net() int {
+	return s.TransfersIn - s.TransfersOut
+}
+
+// Change is a price rise or fall seen between two snapshots. NetTransfers is
+// the net transfers counted since the player's previous change.
+type Change struct {
+	PlayerID     int
+	Name         string
+	Event        int
+	DetectedAt   time.Time
+	OldCost      int
+	NewCost      int
+	NetTransfers int
+	SelectedBy   float64
+}
+
+func (c Change) Rise() bool {
+	return c.NewCost > c.OldCost
+}
+
+// Progress estimates how close a player is to the next price change.
+// Progress is NetSinceChange over the threshold in its direction: 1 or more
+// means a rise is due, -1 or less a fall.
+type Progress struct {
+	PlayerID       int
+	Name           string
+	Event          int
+	NowCost        int
+	SelectedBy     float64
+	NetSinceChange int
+	RiseThreshold  int
+	FallThreshold  int
+	Progress       float64
+	// HourlyRate is the net transfers per hour between the last two snapshots.
+	HourlyRate float64
+	// ETAHours is nil when the transfers head towards neither threshold.
+	ETAHours     *float64
+	LastChangeAt *time.Time
+	UpdatedAt    time.Time
+}
+
+// Options model FPL's undisclosed price algorithm: a player moves once the
+// net transfers since the last change pass a base amount plus a share of the
+// managers owning them. Wildcard and Free Hit transfers count in the feed but
+// not towards changes, so estimates run ahead around those chips.
+type Options struct {
+	// TotalManagers turns selected_by_percent into a number of owners.
+	TotalManagers int
+	BaseThreshold float64
+	RiseFraction  float64
+	FallFraction  float64
+}
+
+func DefaultOptions() Options {
+	return Options{TotalManagers: 11000000, BaseThreshold: 10000, RiseFraction: 0.05, FallFraction: 0.04}
+}
+
+// Thresholds returns the net transfers in and out a player needs for a rise
+// and a fall.
+func (o Options) Thresholds(selectedBy float64) (rise, fall int) {
+	owners := selectedBy / 100 * float64(o.TotalManagers)
+	rise = int(math.Round(o.BaseThreshold + o.RiseFraction*owners))
+	fall = int(math.Round(o.BaseThreshold + o.FallFraction*owners))
+	return max(rise, 1), max(fall, 1)
+}
+
+// Step moves a player's progress from the previous snapshot, nil for the
+// first one, to the current one and returns the price change between them.
+// Without a previous snapshot the gameweek's net transfers are the best guess
+// of the progress.
+func Step(prev *Snapshot, curr Snapshot, state Progress, opts Options) (Progress, *Change) {
+	p := Progress{
+		PlayerID:       curr.PlayerID,
+		Name:           state.Name,
+		Event:          curr.Event,
+		NowCost:        curr.NowCost,
+		SelectedBy:     curr.SelectedBy,
+		NetSinceChange: state.NetSinceChange,
+		LastChangeAt:   state.LastChangeAt,
+		UpdatedAt:      curr.TakenAt,
+	}
+
+	var change *Change
+	if prev == nil {
+		p.NetSinceChange = curr.Net()
+	} else {
+		delta := curr.Net() - prev.Net()
+		if curr.TransfersIn < prev.TransfersIn || curr.TransfersOut < prev.TransfersOut {
+			// A deadline passed: the counters restarted from zero.
+			delta = curr.Net()
+		}
+		if hours := curr.TakenAt.Sub(prev.TakenAt).Hours(); hours > 0 {
+			p.HourlyRate = float64(delta) / hours
+		}
+		p.NetSinceChange += delta
+
+		if curr.NowCost != prev.NowCost {
+			change = &Change{
+				PlayerID:     curr.PlayerID,
+				Name:         state.Name,
+				Event:        curr.Event,
+				DetectedAt:   curr.TakenAt,
+				OldCost:      prev.NowCost,
+				NewCost:      curr.NowCost,
+				NetTransfers: p.NetSinceChange,
+				SelectedBy:   curr.SelectedBy,
+			}
+			// Transfers made between the change and the snapshot are lost.
+			p.NetSinceChange = 0
+			at := curr.TakenAt
+			p.LastChangeAt = &at
+		}
+	}
+
+	p.RiseThreshold, p.FallThreshold = opts.Thresholds(curr.SelectedBy)
+	if p.NetSinceChange >= 0 {
+		p.Progress = float64(p.NetSinceChange) / float64(p.RiseThreshold)
+	} else {
+		p.Progress = float64(p.NetSinceChange) / float64(p.FallThreshold)
+	}
+
+	var remaining float64
+	switch {
+	case p.HourlyRate > 0:
+		remaining = float64(p.RiseThreshold - p.NetSinceChange)
+	case p.HourlyRate < 0:
+		remaining = float64(p.NetSinceChange + p.FallThreshold)
+	}
+	if p.HourlyRate != 0 {
+		eta := max(remaining, 0) / math.Abs(p.HourlyRate)
+		p.ETAHours = &eta
+	}
+
+	return p, change
+}
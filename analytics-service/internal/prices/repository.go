@@ -0,0 +1,213 @@
+package prices
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/db/helpers"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// LoadCurrent reads every player's price and gameweek transfers as last
+// indexed. A snapshot is taken at the time player_costs was refreshed, so
+// reading it twice between two refreshes gives the same snapshot.
+func (r *Repo) LoadCurrent(ctx context.Context, seasonID int) ([]Snapshot, map[int]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.player_id, COALESCE(p.web_name, ''), c.updated_at, c.now_cost,
+		       COALESCE(c.transfers_in_event, 0), COALESCE(c.transfers_out_event, 0),
+		       COALESCE(s.selected_by_percent, 0)
+		FROM player_costs c
+		LEFT JOIN players p ON p.player_id = c.player_id AND p.season_id = c.season_id
+		LEFT JOIN player_season_stats s ON s.player_id = c.player_id AND s.season_id = c.season_id
+		WHERE c.season_id = $1 AND c.now_cost IS NOT NULL`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("querying player_costs: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []Snapshot
+	names := make(map[int]string)
+	for rows.Next() {
+		var s Snapshot
+		var name string
+		if err := rows.Scan(&s.PlayerID, &name, &s.TakenAt, &s.NowCost, &s.TransfersIn, &s.TransfersOut, &s.SelectedBy); err != nil {
+			return nil, nil, fmt.Errorf("scanning player_costs: %w", err)
+		}
+		snapshots = append(snapshots, s)
+		names[s.PlayerID] = name
+	}
+
+	return snapshots, names, rows.Err()
+}
+
+// LoadLatestSnapshots returns each player's most recent stored snapshot.
+func (r *Repo) LoadLatestSnapshots(ctx context.Context, seasonID int) (map[int]Snapshot, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT DISTINCT ON (player_id)
+		       player_id, COALESCE(event, 0), taken_at, now_cost,
+		       transfers_in_event, transfers_out_event, selected_by_percent
+		FROM player_price_snapshots
+		WHERE season_id = $1
+		ORDER BY player_id, taken_at DESC`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying player_price_snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	snapshots := make(map[int]Snapshot)
+	for rows.Next() {
+		var s Snapshot
+		if err := rows.Scan(&s.PlayerID, &s.Event, &s.TakenAt, &s.NowCost, &s.TransfersIn, &s.TransfersOut, &s.SelectedBy); err != nil {
+			return nil, fmt.Errorf("scanning player_price_snapshots: %w", err)
+		}
+		snapshots[s.PlayerID] = s
+	}
+
+	return snapshots, rows.Err()
+}
+
+// LoadProgress returns the stored progress of every player of a season.
+func (r *Repo) LoadProgress(ctx context.Context, seasonID int) (map[int]Progress, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT g.player_id, COALESCE(p.web_name, ''), COALESCE(g.event, 0), g.now_cost, g.selected_by_percent,
+		       g.net_since_change, g.rise_threshold, g.fall_threshold, g.progress,
+		       g.hourly_rate, g.eta_hours, g.last_change_at, g.updated_at
+		FROM player_price_progress g
+		LEFT JOIN players p ON p.player_id = g.player_id AND p.season_id = g.season_id
+		WHERE g.season_id = $1`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying player_price_progress: %w", err)
+	}
+	defer rows.Close()
+
+	progress := make(map[int]Progress)
+	for rows.Next() {
+		var p Progress
+		var eta sql.NullFloat64
+		var lastChange sql.NullTime
+		if err := rows.Scan(
+			&p.PlayerID, &p.Name, &p.Event, &p.NowCost, &p.SelectedBy,
+			&p.NetSinceChange, &p.RiseThreshold, &p.FallThreshold, &p.Progress,
+			&p.HourlyRate, &eta, &lastChange, &p.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning player_price_progress: %w", err)
+		}
+		if eta.Valid {
+			p.ETAHours = &eta.Float64
+		}
+		if lastChange.Valid {
+			p.LastChangeAt = &lastChange.Time
+		}
+		progress[p.PlayerID] = p
+	}
+
+	return progress, rows.Err()
+}
+
+// LoadChanges returns the price changes detected since a time, latest first.
+func (r *Repo) LoadChanges(ctx context.Context, seasonID int, since time.Time) ([]Change, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.player_id, COALESCE(p.web_name, ''), COALESCE(c.event, 0), c.detected_at,
+		       c.old_cost, c.new_cost, c.net_transfers, c.selected_by_percent
+		FROM player_price_changes c
+		LEFT JOIN players p ON p.player_id = c.player_id AND p.season_id = c.season_id
+		WHERE c.season_id = $1 AND c.detected_at >= $2
+		ORDER BY c.detected_at DESC, c.player_id`,
+		seasonID, since,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying player_price_changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []Change
+	for rows.Next() {
+		var c Change
+		if err := rows.Scan(&c.PlayerID, &c.Name, &c.Event, &c.DetectedAt, &c.OldCost, &c.NewCost, &c.NetTransfers, &c.SelectedBy); err != nil {
+			return nil, fmt.Errorf("scanning player_price_changes: %w", err)
+		}
+		changes = append(changes, c)
+	}
+
+	return changes, rows.Err()
+}
+
+// SaveRun stores a run's snapshots, changes and progress together.
+func (r *Repo) SaveRun(ctx context.Context, seasonID int, run *Run) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning prices tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	snapshots := make([][]any, 0, len(run.Snapshots))
+	for _, s := range run.Snapshots {
+		snapshots = append(snapshots, []any{
+			seasonID, s.PlayerID, s.TakenAt, s.Event, s.NowCost, s.TransfersIn, s.TransfersOut, s.SelectedBy,
+		})
+	}
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table:        "player_price_snapshots",
+		Columns:      []string{"season_id", "player_id", "taken_at", "event", "now_cost", "transfers_in_event", "transfers_out_event", "selected_by_percent"},
+		ConflictCols: []string{"season_id", "player_id", "taken_at"},
+		Rows:         snapshots,
+	}); err != nil {
+		return fmt.Errorf("inserting player_price_snapshots: %w", err)
+	}
+
+	changes := make([][]any, 0, len(run.Changes))
+	for _, c := range run.Changes {
+		changes = append(changes, []any{
+			seasonID, c.PlayerID, c.DetectedAt, c.Event, c.OldCost, c.NewCost, c.NetTransfers, c.SelectedBy,
+		})
+	}
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table:        "player_price_changes",
+		Columns:      []string{"season_id", "player_id", "detected_at", "event", "old_cost", "new_cost", "net_transfers", "selected_by_percent"},
+		ConflictCols: []string{"season_id", "player_id", "detected_at"},
+		Rows:         changes,
+	}); err != nil {
+		return fmt.Errorf("inserting player_price_changes: %w", err)
+	}
+
+	progress := make([][]any, 0, len(run.Progress))
+	for _, p := range run.Progress {
+		progress = append(progress, []any{
+			seasonID, p.PlayerID, p.Event, p.NowCost, p.SelectedBy,
+			p.NetSinceChange, p.RiseThreshold, p.FallThreshold, p.Progress,
+			p.HourlyRate, p.ETAHours, p.LastChangeAt, p.UpdatedAt,
+		})
+	}
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table: "player_price_progress",
+		Columns: []string{
+			"season_id", "player_id", "event", "now_cost", "selected_by_percent",
+			"net_since_change", "rise_threshold", "fall_threshold", "progress",
+			"hourly_rate", "eta_hours", "last_change_at", "updated_at",
+		},
+		ConflictCols: []string{"season_id", "player_id"},
+		Rows:         progress,
+	}); err != nil {
+		return fmt.Errorf("upserting player_price_progress: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("committing prices tx: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package prices
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+type Service struct {
+	Repo        *Repo
+	Projections *projection.Repo
+	Options     Options
+}
+
+// Run is the outcome of one snapshot: the players whose costs were refreshed
+// since the previous one, the price changes between the two and the updated
+// progress of those players.
+type Run struct {
+	Event     int
+	Snapshots []Snapshot
+	Changes   []Change
+	Progress  []Progress
+}
+
+// Snapshot records the indexed prices and transfers, detects the rises and
+// falls since the last snapshot and updates every player's progress towards
+// the next change. Players whose costs have not been refreshed are left out.
+func (s *Service) Snapshot(ctx context.Context, seasonID int) (*Run, error) {
+	// Transfers made now count towards the next gameweek with fixtures to play.
+	event, err := s.Projections.NextEvent(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, names, err := s.Repo.LoadCurrent(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := s.Repo.LoadLatestSnapshots(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	states, err := s.Repo.LoadProgress(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	run := &Run{Event: event}
+	for _, curr := range current {
+		curr.Event = event
+		var prev *Snapshot
+		if p, ok := latest[curr.PlayerID]; ok {
+			if !curr.TakenAt.After(p.TakenAt) {
+				continue
+			}
+			prev = &p
+		}
+
+		state := states[curr.PlayerID]
+		state.Name = names[curr.PlayerID]
+		progress, change := Step(prev, curr, state, s.Options)
+
+		run.Snapshots = append(run.Snapshots, curr)
+		run.Progress = append(run.Progress, progress)
+		if change != nil {
+			run.Changes = append(run.Changes, *change)
+		}
+	}
+
+	if err := s.Repo.SaveRun(ctx, seasonID, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// Report is the players closest to a rise and to a fall, and the changes
+// detected recently.
+type Report struct {
+	Risers  []Progress
+	Fallers []Progress
+	Changes []Change
+}
+
+// Report reads the stored progress of a season and keeps the top players in
+// each direction along with the changes detected since a time.
+func (s *Service) Report(ctx context.Context, seasonID, top int, since time.Time) (*Report, error) {
+	progress, err := s.Repo.LoadProgress(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	changes, err := s.Repo.LoadChanges(ctx, seasonID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{Changes: changes}
+	for _, p := range progress {
+		switch {
+		case p.Progress > 0:
+			report.Risers = append(report.Risers, p)
+		case p.Progress < 0:
+			report.Fallers = append(report.Fallers, p)
+		}
+	}
+	sort.Slice(report.Risers, func(i, j int) bool { return report.Risers[i].Progress > report.Risers[j].Progress })
+	sort.Slice(report.Fallers, func(i, j int) bool { return report.Fallers[i].Progress < report.Fallers[j].Progress })
+	if len(report.Risers) > top {
+		report.Risers = report.Risers[:top]
+	}
+	if len(report.Fallers) > top {
+		report.Fallers = report.Fallers[:top]
+	}
+
+	return report, nil
+}
@@ -0,0 +1,360 @@
+package projection
+
+import (
+	"math"
+)
+
+// ModelVersion is stored with every projection so backtests can tell runs of
+// different model revisions apart. Bump it whenever the maths below changes.
+const ModelVersion = "xp-v1"
+
+// PlayerInput is everything the model knows about a player before a deadline.
+type PlayerInput struct {
+	PlayerID    int    `json:"player_id"`
+	TeamID      int    `json:"team_id"`
+	ElementType int    `json:"element_type"`
+	Status      string `json:"status"`
+	NowCost     int    `json:"now_cost"`
+
+	// Form window: the team's finished fixtures in the last N gameweeks and
+	// how many of them the player appeared in / played 60+ minutes of.
+	TeamMatches int `json:"team_matches"`
+	Appearances int `json:"appearances"`
+	Starts60    int `json:"starts_60"`
+	Minutes     int `json:"minutes"`
+
+	// Season to date totals, used for per-90 rates.
+	SeasonMinutes int     `json:"season_minutes"`
+	XG            float64 `json:"xg"`
+	XA            float64 `json:"xa"`
+	Bonus         float64 `json:"bonus"`
+	Saves         float64 `json:"saves"`
+	YellowCards   float64 `json:"yellow_cards"`
+	RedCards      float64 `json:"red_cards"`
+}
+
+// TeamStrength holds the FPL strength ratings of a team (roughly 1000-1400).
+type TeamStrength struct {
+	TeamID      int `json:"team_id"`
+	AttackHome  int `json:"attack_home"`
+	AttackAway  int `json:"attack_away"`
+	DefenceHome int `json:"defence_home"`
+	DefenceAway int `json:"defence_away"`
+}
+
+func (t TeamStrength) Attack(home bool) float64 {
+	if home {
+		return float64(t.AttackHome)
+	}
+	return float64(t.AttackAway)
+}
+
+func (t TeamStrength) Defence(home bool) float64 {
+	if home {
+		return float64(t.DefenceHome)
+	}
+	return float64(t.DefenceAway)
+}
+
+type Fixture struct {
+	FixtureID int `json:"fixture_id"`
+	Event     int `json:"event"`
+	TeamH     int `json:"team_h"`
+	TeamA     int `json:"team_a"`
+}
+
+// Inputs is a point-in-time snapshot the model projects from.
+type Inputs struct {
+	SeasonID    int
+	BeforeEvent int
+	Players     []PlayerInput
+	Teams       map[int]TeamStrength
+	Rules       ScoringRules
+	// GoalsPerTeam is the league average goals scored per team per match.
+	GoalsPerTeam float64
+}
+
+// Projection is the expected outcome of one player in one fixture.
+type Projection struct {
+	SeasonID       int     `json:"season_id"`
+	Event          int     `json:"event"`
+	PlayerID       int     `json:"player_id"`
+	FixtureID      int     `json:"fixture_id"`
+	OpponentTeamID int     `json:"opponent_team_id"`
+	WasHome        bool    `json:"was_home"`
+	ModelVersion   string  `json:"model_version"`
+	XMinutes       float64 `json:"x_minutes"`
+	PPlay          float64 `json:"p_play"`
+	P60            float64 `json:"p_60"`
+	XG             float64 `json:"xg"`
+	XA             float64 `json:"xa"`
+	PCleanSheet    float64 `json:"p_clean_sheet"`
+	XConceded      float64 `json:"x_conceded"`
+	XPoints        float64 `json:"x_points"`
+
+	Inputs ProjectionInputs `json:"inputs"`
+}
+
+// ProjectionInputs records the derived quantities a projection was computed
+// from, so a stored row can be explained and re-scored later.
+type ProjectionInputs struct {
+	Player        PlayerInput `json:"player"`
+	AttackMult    float64     `json:"attack_mult"`
+	DefenceMult   float64     `json:"defence_mult"`
+	TeamXG        float64     `json:"team_xg"`
+	XG90          float64     `json:"xg_90"`
+	XA90          float64     `json:"xa_90"`
+	Bonus90       float64     `json:"bonus_90"`
+	Saves90       float64     `json:"saves_90"`
+	Availability  float64     `json:"availability"`
+	AvgMinutes    float64     `json:"avg_minutes"`
+	GoalsPerTeam  float64     `json:"goals_per_team"`
+	LeagueAttack  float64     `json:"league_attack"`
+	LeagueDefence float64     `json:"league_defence"`
+}
+
+// Model turns Inputs into per-fixture expected points. It is deterministic:
+// the same Inputs always produce the same projections.
+type Model struct {
+	// PriorMinutes is how many minutes of position-average output are blended
+	// into each player's per-90 rates, so low-minute players are not extrapolated.
+	PriorMinutes float64
+	// StrengthElasticity scales how strongly FPL strength ratios move goal
+	// expectations. FPL ratings are compressed, so values above 1 are needed.
+	StrengthElasticity float64
+}
+
+func NewModel() *Model {
+	return &Model{
+		PriorMinutes:       270,
+		StrengthElasticity: 2,
+	}
+}
+
+func (m *Model) Version() string {
+	return ModelVersion
+}
+
+// positionPrior is the per-90 output of an average regular at each position.
+var positionPrior = map[int]struct{ xg, xa, bonus, saves, yellow, red float64 }{
+	Goalkeeper: {0, 0.01, 0.25, 2.8, 0.04, 0.003},
+	Defender:   {0.05, 0.07, 0.25, 0, 0.12, 0.005},
+	Midfielder: {0.15, 0.15, 0.25, 0, 0.12, 0.004},
+	Forward:    {0.35, 0.12, 0.35, 0, 0.10, 0.003},
+}
+
+// Project returns one projection per player per fixture of their team.
+// Players without a fixture in a gameweek (blanks) get no row for it.
+func (m *Model) Project(in *Inputs, fixtures []Fixture) []Projection {
+	rules := in.Rules
+	if len(rules) == 0 {
+		rules = DefaultScoringRules()
+	}
+
+	leagueAttack, leagueDefence := leagueAverages(in.Teams)
+
+	byTeam := make(map[int][]Fixture)
+	for _, f := range fixtures {
+		byTeam[f.TeamH] = append(byTeam[f.TeamH], f)
+		byTeam[f.TeamA] = append(byTeam[f.TeamA], f)
+	}
+
+	var out []Projection
+	for _, p := range in.Players {
+		for _, f := range byTeam[p.TeamID] {
+			out = append(out, m.projectFixture(in, rules, p, f, leagueAttack, leagueDefence))
+		}
+	}
+
+	return out
+}
+
+func (m *Model) projectFixture(in *Inputs, rules ScoringRules, p PlayerInput, f Fixture, leagueAttack, leagueDefence float64) Projection {
+	home := f.TeamH == p.TeamID
+	opponent := f.TeamA
+	if !home {
+		opponent = f.TeamH
+	}
+
+	team, opp := in.Teams[p.TeamID], in.Teams[opponent]
+	attackMult := m.strengthMult(team.Attack(home)/leagueAttack, opp.Defence(!home)/leagueDefence)
+	defenceMult := m.strengthMult(opp.Attack(!home)/leagueAttack, team.Defence(home)/leagueDefence)
+
+	goalsPerTeam := in.GoalsPerTeam
+	if goalsPerTeam <= 0 {
+		goalsPerTeam = 1.4
+	}
+	teamXG := goalsPerTeam * attackMult
+	xConceded := goalsPerTeam * defenceMult
+
+	pPlay, p60, avgMinutes, availability := MinutesLikelihood(p)
+	xMinutes := pPlay * avgMinutes
+
+	prior := positionPrior[p.ElementType]
+	xg90 := m.rate(p.XG, prior.xg, p.SeasonMinutes)
+	xa90 := m.rate(p.XA, prior.xa, p.SeasonMinutes)
+	bonus90 := m.rate(p.Bonus, prior.bonus, p.SeasonMinutes)
+	saves90 := m.rate(p.Saves, prior.saves, p.SeasonMinutes)
+	yellow90 := m.rate(p.YellowCards, prior.yellow, p.SeasonMinutes)
+	red90 := m.rate(p.RedCards, prior.red, p.SeasonMinutes)
+
+	share := xMinutes / 90
+	xg := xg90 * share * attackMult
+	xa := xa90 * share * attackMult
+	pCS := math.Exp(-xConceded)
+
+	pos := p.ElementType
+	points := p60*rules.Points(StatLongPlay, pos) + (pPlay-p60)*rules.Points(StatShortPlay, pos)
+	points += xg * rules.Points(StatGoalsScored, pos)
+	points += xa * rules.Points(StatAssists, pos)
+	// Clean sheet and conceded points need 60 minutes; assume such a player is on for the whole match.
+	points += p60 * pCS * rules.Points(StatCleanSheets, pos)
+	points += p60 * PoissonFloorMean(xConceded, GoalsConcededPerPoint) * rules.Points(StatGoalsConceded, pos)
+	// Weaker attacks concede more shots, so keepers facing them make more saves.
+	points += PoissonFloorMean(saves90*share*defenceMult, SavesPerPoint) * rules.Points(StatSaves, pos)
+	points += bonus90 * share * rules.Points(StatBonus, pos)
+	points += yellow90 * share * rules.Points(StatYellowCards, pos)
+	points += red90 * share * rules.Points(StatRedCards, pos)
+
+	return Projection{
+		SeasonID:       in.SeasonID,
+		Event:          f.Event,
+		PlayerID:       p.PlayerID,
+		FixtureID:      f.FixtureID,
+		OpponentTeamID: opponent,
+		WasHome:        home,
+		ModelVersion:   ModelVersion,
+		XMinutes:       xMinutes,
+		PPlay:          pPlay,
+		P60:            p60,
+		XG:             xg,
+		XA:             xa,
+		PCleanSheet:    pCS,
+		XConceded:      xConceded,
+		XPoints:        points,
+		Inputs: ProjectionInputs{
+			Player:        p,
+			AttackMult:    attackMult,
+			DefenceMult:   defenceMult,
+			TeamXG:        teamXG,
+			XG90:          xg90,
+			XA90:          xa90,
+			Bonus90:       bonus90,
+			Saves90:       saves90,
+			Availability:  availability,
+			AvgMinutes:    avgMinutes,
+			GoalsPerTeam:  goalsPerTeam,
+			LeagueAttack:  leagueAttack,
+			LeagueDefence: leagueDefence,
+		},
+	}
+}
+
+// strengthMult is (attack / defence)^elasticity, clamped to keep one bad
+// rating from producing absurd expectations.
+func (m *Model) strengthMult(attack, defence float64) float64 {
+	if attack <= 0 || defence <= 0 {
+		return 1
+	}
+	return clamp(math.Pow(attack/defence, m.StrengthElasticity), 0.4, 2.5)
+}
+
+// rate is a per-90 rate shrunk towards the position prior.
+func (m *Model) rate(total, prior float64, minutes int) float64 {
+	priorNineties := m.PriorMinutes / 90
+	return (total + prior*priorNineties) / (float64(minutes)/90 + priorNineties)
+}
+
+// MinutesLikelihood estimates the probability of appearing, of playing 60+
+// minutes, the average minutes when appearing, and the availability factor
+// derived from the FPL status flag.
+func MinutesLikelihood(p PlayerInput) (pPlay, p60, avgMinutes, availability float64) {
+	availability = Availability(p.Status)
+
+	if p.TeamMatches == 0 {
+		// Nothing to go on yet (start of season, new signing): assume a rotation player.
+		pPlay, p60, avgMinutes = 0.5, 0.35, 60
+	} else {
+		pPlay = float64(p.Appearances) / float64(p.TeamMatches)
+		p60 = float64(p.Starts60) / float64(p.TeamMatches)
+		avgMinutes = 60
+		if p.Appearances > 0 {
+			avgMinutes = float64(p.Minutes) / float64(p.Appearances)
+		}
+	}
+
+	pPlay = clamp(pPlay, 0, 1) * availability
+	p60 = clamp(p60, 0, 1) * availability
+	if p60 > pPlay {
+		p60 = pPlay
+	}
+
+	return pPlay, p60, avgMinutes, availability
+}
+
+// Availability maps the FPL status flag to a playing chance: a = available,
+// d = doubtful, i = injured, s = suspended, u = unavailable, n = not in squad.
+func Availability(status string) float64 {
+	switch status {
+	case "", "a":
+		return 1
+	case "d":
+		return 0.5
+	default:
+		return 0
+	}
+}
+
+// PoissonFloorMean returns E[floor(X / block)] for X ~ Poisson(lambda).
+func PoissonFloorMean(lambda float64, block int) float64 {
+	if lambda <= 0 || block <= 0 {
+		return 0
+	}
+
+	// Sum P(X >= k*block) over k; the tail beyond lambda + 10 sd is negligible.
+	limit := int(lambda+10*math.Sqrt(lambda)) + block
+	var mean, cdf float64
+	pmf := math.Exp(-lambda)
+	for x := 0; x <= limit; x++ {
+		if x > 0 {
+			pmf *= lambda / float64(x)
+		}
+		if x > 0 && x%block == 0 {
+			mean += 1 - cdf
+		}
+		cdf += pmf
+	}
+
+	return mean
+}
+
+// leagueAverages is the mean attack and defence rating over the teams whose
+// ratings are known; a missing rating is 0 and would drag the mean down.
+func leagueAverages(teams map[int]TeamStrength) (attack, defence float64) {
+	var attackSum, defenceSum, nAttack, nDefence float64
+	for _, t := range teams {
+		for _, home := range []bool{true, false} {
+			if a := t.Attack(home); a > 0 {
+				attackSum += a
+				nAttack++
+			}
+			if d := t.Defence(home); d > 0 {
+				defenceSum += d
+				nDefence++
+			}
+		}
+	}
+
+	attack, defence = 1, 1
+	if nAttack > 0 {
+		attack = attackSum / nAttack
+	}
+	if nDefence > 0 {
+		defence = defenceSum / nDefence
+	}
+	return attack, defence
+}
+
+func clamp(v, lo, hi float64) float64 {
+	return math.Max(lo, math.Min(hi, v))
+}
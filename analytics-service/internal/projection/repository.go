@@ -0,0 +1,264 @@
+package projection
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/db/helpers"
+)
+
+// Repo reads model inputs from and writes projections to the fpl database.
+// Every history query is bounded by beforeEvent so a snapshot only contains
+// what was known at that gameweek's deadline.
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// NextEvent returns the first gameweek of a season with an unfinished fixture.
+func (r *Repo) NextEvent(ctx context.Context, seasonID int) (int, error) {
+	var event sql.NullInt64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT MIN(event) FROM fixtures WHERE season_id = $1 AND NOT COALESCE(finished, FALSE) AND event IS NOT NULL`,
+		seasonID,
+	).Scan(&event)
+	if err != nil {
+		return 0, fmt.Errorf("querying next event: %w", err)
+	}
+	if !event.Valid {
+		return 0, fmt.Errorf("season %d has no unfinished fixtures", seasonID)
+	}
+	return int(event.Int64), nil
+}
+
+// LoadInputs builds the snapshot of a season as of the deadline of beforeEvent.
+// Status and price are the latest known values; the fpl tables keep no history of them.
+func (r *Repo) LoadInputs(ctx context.Context, seasonID, beforeEvent, formWindow int) (*Inputs, error) {
+	in := &Inputs{
+		SeasonID:    seasonID,
+		BeforeEvent: beforeEvent,
+	}
+
+	var err error
+	if in.Rules, err = r.LoadScoringRules(ctx); err != nil {
+		return nil, err
+	}
+	if in.Teams, err = r.loadTeams(ctx, seasonID); err != nil {
+		return nil, err
+	}
+	if in.GoalsPerTeam, err = r.loadGoalsPerTeam(ctx, seasonID, beforeEvent); err != nil {
+		return nil, err
+	}
+	if in.Players, err = r.loadPlayers(ctx, seasonID, beforeEvent, formWindow); err != nil {
+		return nil, err
+	}
+
+	return in, nil
+}
+
+// LoadScoringRules falls back to DefaultScoringRules when the table is empty.
+func (r *Repo) LoadScoringRules(ctx context.Context) (ScoringRules, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT stat, element_type_id, points FROM scoring_rules`)
+	if err != nil {
+		return nil, fmt.Errorf("querying scoring_rules: %w", err)
+	}
+	defer rows.Close()
+
+	rules := ScoringRules{}
+	for rows.Next() {
+		var stat string
+		var elementType, points int
+		if err := rows.Scan(&stat, &elementType, &points); err != nil {
+			return nil, fmt.Errorf("scanning scoring_rules: %w", err)
+		}
+		rules.Set(stat, elementType, points)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(rules) == 0 {
+		return DefaultScoringRules(), nil
+	}
+	return rules, nil
+}
+
+// loadTeams reads missing strengths as 0, which the model treats as unknown.
+func (r *Repo) loadTeams(ctx context.Context, seasonID int) (map[int]TeamStrength, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT team_id,
+		       COALESCE(strength_attack_home, 0), COALESCE(strength_attack_away, 0),
+		       COALESCE(strength_defence_home, 0), COALESCE(strength_defence_away, 0)
+		FROM teams
+		WHERE season_id = $1`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying teams: %w", err)
+	}
+	defer rows.Close()
+
+	teams := make(map[int]TeamStrength)
+	for rows.Next() {
+		var t TeamStrength
+		if err := rows.Scan(&t.TeamID, &t.AttackHome, &t.AttackAway, &t.DefenceHome, &t.DefenceAway); err != nil {
+			return nil, fmt.Errorf("scanning teams: %w", err)
+		}
+		teams[t.TeamID] = t
+	}
+
+	return teams, rows.Err()
+}
+
+func (r *Repo) loadGoalsPerTeam(ctx context.Context, seasonID, beforeEvent int) (float64, error) {
+	var avg sql.NullFloat64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT AVG(team_h_score + team_a_score) / 2.0
+		FROM fixtures
+		WHERE season_id = $1 AND finished AND event < $2`,
+		seasonID, beforeEvent,
+	).Scan(&avg)
+	if err != nil {
+		return 0, fmt.Errorf("querying league scoring rate: %w", err)
+	}
+	if !avg.Valid {
+		return 0, nil
+	}
+	return avg.Float64, nil
+}
+
+func (r *Repo) loadPlayers(ctx context.Context, seasonID, beforeEvent, formWindow int) ([]PlayerInput, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH team_matches AS (
+		    SELECT team, COUNT(*) AS matches
+		    FROM (
+		        SELECT team_h AS team FROM fixtures
+		        WHERE season_id = $1 AND finished AND event >= $3 AND event < $2
+		        UNION ALL
+		        SELECT team_a FROM fixtures
+		        WHERE season_id = $1 AND finished AND event >= $3 AND event < $2
+		    ) t
+		    GROUP BY team
+		),
+		form AS (
+		    SELECT player_id,
+		           COUNT(*) FILTER (WHERE minutes > 0)  AS appearances,
+		           COUNT(*) FILTER (WHERE minutes >= 60) AS starts_60,
+		           COALESCE(SUM(minutes), 0)            AS minutes
+		    FROM player_gameweek_stats
+		    WHERE season_id = $1 AND event >= $3 AND event < $2
+		    GROUP BY player_id
+		),
+		season AS (
+		    SELECT player_id,
+		           COALESCE(SUM(minutes), 0)          AS minutes,
+		           COALESCE(SUM(expected_goals), 0)   AS xg,
+		           COALESCE(SUM(expected_assists), 0) AS xa,
+		           COALESCE(SUM(bonus), 0)            AS bonus,
+		           COALESCE(SUM(saves), 0)            AS saves,
+		           COALESCE(SUM(yellow_cards), 0)     AS yellow_cards,
+		           COALESCE(SUM(red_cards), 0)        AS red_cards
+		    FROM player_gameweek_stats
+		    WHERE season_id = $1 AND event < $2
+		    GROUP BY player_id
+		)
+		SELECT p.player_id, p.team_id, p.element_type_id, COALESCE(p.status, 'a'), COALESCE(c.now_cost, 0),
+		       COALESCE(tm.matches, 0), COALESCE(f.appearances, 0), COALESCE(f.starts_60, 0), COALESCE(f.minutes, 0),
+		       COALESCE(s.minutes, 0), COALESCE(s.xg, 0), COALESCE(s.xa, 0), COALESCE(s.bonus, 0),
+		       COALESCE(s.saves, 0), COALESCE(s.yellow_cards, 0), COALESCE(s.red_cards, 0)
+		FROM players p
+		LEFT JOIN player_costs c ON c.player_id = p.player_id AND c.season_id = p.season_id
+		LEFT JOIN team_matches tm ON tm.team = p.team_id
+		LEFT JOIN form f ON f.player_id = p.player_id
+		LEFT JOIN season s ON s.player_id = p.player_id
+		WHERE p.season_id = $1 AND NOT COALESCE(p.removed, FALSE) AND p.element_type_id BETWEEN 1 AND 4`,
+		seasonID, beforeEvent, beforeEvent-formWindow,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying players: %w", err)
+	}
+	defer rows.Close()
+
+	var players []PlayerInput
+	for rows.Next() {
+		var p PlayerInput
+		if err := rows.Scan(
+			&p.PlayerID, &p.TeamID, &p.ElementType, &p.Status, &p.NowCost,
+			&p.TeamMatches, &p.Appearances, &p.Starts60, &p.Minutes,
+			&p.SeasonMinutes, &p.XG, &p.XA, &p.Bonus,
+			&p.Saves, &p.YellowCards, &p.RedCards,
+		); err != nil {
+			return nil, fmt.Errorf("scanning players: %w", err)
+		}
+		players = append(players, p)
+	}
+
+	return players, rows.Err()
+}
+
+// LoadFixtures returns the fixtures of gameweeks [fromEvent, toEvent].
+func (r *Repo) LoadFixtures(ctx context.Context, seasonID, fromEvent, toEvent int) ([]Fixture, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT fixture_id, event, team_h, team_a
+		FROM fixtures
+		WHERE season_id = $1 AND event BETWEEN $2 AND $3
+		ORDER BY event, kickoff_time, fixture_id`,
+		seasonID, fromEvent, toEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying fixtures: %w", err)
+	}
+	defer rows.Close()
+
+	var fixtures []Fixture
+	for rows.Next() {
+		var f Fixture
+		if err := rows.Scan(&f.FixtureID, &f.Event, &f.TeamH, &f.TeamA); err != nil {
+			return nil, fmt.Errorf("scanning fixtures: %w", err)
+		}
+		fixtures = append(fixtures, f)
+	}
+
+	return fixtures, rows.Err()
+}
+
+var projectionColumns = []string{
+	"season_id", "event", "player_id", "fixture_id", "model_version",
+	"opponent_team_id", "was_home", "x_minutes", "p_play", "p_60",
+	"xg", "xa", "p_clean_sheet", "x_conceded", "x_points",
+	"inputs", "created_at",
+}
+
+// SaveProjections upserts projections keyed by (season, event, player, fixture, model version),
+// so re-running a model version replaces its previous numbers and other versions are kept.
+func (r *Repo) SaveProjections(ctx context.Context, projections []Projection) error {
+	now := time.Now()
+
+	rows := make([][]any, 0, len(projections))
+	for _, p := range projections {
+		inputs, err := json.Marshal(p.Inputs)
+		if err != nil {
+			return fmt.Errorf("encoding inputs for player %d: %w", p.PlayerID, err)
+		}
+
+		rows = append(rows, []any{
+			p.SeasonID, p.Event, p.PlayerID, p.FixtureID, p.ModelVersion,
+			p.OpponentTeamID, p.WasHome, p.XMinutes, p.PPlay, p.P60,
+			p.XG, p.XA, p.PCleanSheet, p.XConceded, p.XPoints,
+			string(inputs), now,
+		})
+	}
+
+	return helpers.BatchUpsert(ctx, r.db, helpers.UpsertOpts{
+		Table:        "player_projections",
+		Columns:      projectionColumns,
+		ConflictCols: []string{"season_id", "event", "player_id", "fixture_id", "model_version"},
+		Rows:         rows,
+	})
+}
@@ -0,0 +1,76 @@
+package projection
+
+// Element types as seeded in element_types.
+const (
+	Goalkeeper = 1
+	Defender   = 2
+	Midfielder = 3
+	Forward    = 4
+)
+
+// Stat names used in scoring_rules.
+const (
+	StatLongPlay        = "long_play"
+	StatShortPlay       = "short_play"
+	StatGoalsScored     = "goals_scored"
+	StatAssists         = "assists"
+	StatCleanSheets     = "clean_sheets"
+	StatGoalsConceded   = "goals_conceded"
+	StatSaves           = "saves"
+	StatPenaltiesSaved  = "penalties_saved"
+	StatPenaltiesMissed = "penalties_missed"
+	StatYellowCards     = "yellow_cards"
+	StatRedCards        = "red_cards"
+	StatOwnGoals        = "own_goals"
+	StatBonus           = "bonus"
+)
+
+// Unit sizes of the stats FPL scores per block rather than per occurrence.
+const (
+	SavesPerPoint         = 3
+	GoalsConcededPerPoint = 2
+)
+
+// ScoringRules maps stat -> element type -> points, as stored in scoring_rules.
+type ScoringRules map[string]map[int]int
+
+// Points returns the points for one unit of stat for the element type, or 0.
+func (r ScoringRules) Points(stat string, elementType int) float64 {
+	return float64(r[stat][elementType])
+}
+
+func (r ScoringRules) Set(stat string, elementType, points int) {
+	if r[stat] == nil {
+		r[stat] = make(map[int]int)
+	}
+	r[stat][elementType] = points
+}
+
+// DefaultScoringRules mirrors the scoring_rules seed and is used when the table is empty.
+func DefaultScoringRules() ScoringRules {
+	rules := ScoringRules{}
+
+	perPosition := map[string][4]int{
+		StatLongPlay:        {2, 2, 2, 2},
+		StatShortPlay:       {1, 1, 1, 1},
+		StatGoalsScored:     {6, 6, 5, 4},
+		StatAssists:         {3, 3, 3, 3},
+		StatCleanSheets:     {4, 4, 1, 0},
+		StatGoalsConceded:   {-1, -1, 0, 0},
+		StatSaves:           {1, 0, 0, 0},
+		StatPenaltiesSaved:  {5, 5, 5, 5},
+		StatPenaltiesMissed: {-2, -2, -2, -2},
+		StatYellowCards:     {-1, -1, -1, -1},
+		StatRedCards:        {-3, -3, -3, -3},
+		StatOwnGoals:        {-2, -2, -2, -2},
+		StatBonus:           {1, 1, 1, 1},
+	}
+
+	for stat, points := range perPosition {
+		for i, p := range points {
+			rules.Set(stat, i+1, p)
+		}
+	}
+
+	return rules
+}
@@ -0,0 +1,61 @@
+package projection
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+type Service struct {
+	Repo  *Repo
+	Model *Model
+}
+
+// ProjectNext projects the next horizon gameweeks of a season from its first
+// unfinished gameweek and stores the result.
+func (s *Service) ProjectNext(ctx context.Context, seasonID, horizon, formWindow int) ([]Projection, error) {
+	from, err := s.Repo.NextEvent(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Project(ctx, seasonID, from, horizon, formWindow)
+}
+
+// Project projects gameweeks [fromEvent, fromEvent+horizon) using only data
+// from before fromEvent, and stores the result.
+func (s *Service) Project(ctx context.Context, seasonID, fromEvent, horizon, formWindow int) ([]Projection, error) {
+	in, err := s.Repo.LoadInputs(ctx, seasonID, fromEvent, formWindow)
+	if err != nil {
+		return nil, fmt.Errorf("loading inputs for season %d before GW%d: %w", seasonID, fromEvent, err)
+	}
+
+	fixtures, err := s.Repo.LoadFixtures(ctx, seasonID, fromEvent, fromEvent+horizon-1)
+	if err != nil {
+		return nil, err
+	}
+
+	projections := s.Model.Project(in, fixtures)
+
+	if err := s.Repo.SaveProjections(ctx, projections); err != nil {
+		return nil, fmt.Errorf("saving projections: %w", err)
+	}
+
+	log.Printf("Projected %d player-fixtures for season %d, GW%d-%d (%s)",
+		len(projections), seasonID, fromEvent, fromEvent+horizon-1, s.Model.Version())
+
+	return projections, nil
+}
+
+// ByGameweek sums fixture projections into expected points per player per
+// gameweek; double gameweeks add up and blanks are absent.
+func ByGameweek(projections []Projection) map[int]map[int]float64 {
+	out := make(map[int]map[int]float64)
+	for _, p := range projections {
+		if out[p.PlayerID] == nil {
+			out[p.PlayerID] = make(map[int]float64)
+		}
+		out[p.PlayerID][p.Event] += p.XPoints
+	}
+	return out
+}
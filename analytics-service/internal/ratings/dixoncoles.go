@@ -0,0 +1,231 @@
+package ratings
+
+import (
+	"math"
+	"time"
+)
+
+type DixonColesOptions struct {
+	// HalfLifeDays is the age at which a result weighs half as much as one
+	// played on the fit date.
+	HalfLifeDays float64
+	// Iterations bounds the fixed-point fit of the team strengths.
+	Iterations int
+	// MaxGoals is the last row and column of the scoreline grid.
+	MaxGoals int
+}
+
+func DefaultDixonColesOptions() DixonColesOptions {
+	return DixonColesOptions{HalfLifeDays: 180, Iterations: 100, MaxGoals: 6}
+}
+
+// DixonColes is a fitted Poisson model: the home side scores at a rate of
+// Attack[home]·Defence[away]·Home and the away side at Attack[away]·Defence[home],
+// with Rho correcting the odds of 0-0, 1-0, 0-1 and 1-1. A Defence above 1
+// concedes more than average.
+type DixonColes struct {
+	League  League
+	FitAt   time.Time
+	Attack  map[int]float64
+	Defence map[int]float64
+	Home    float64
+	Rho     float64
+	Matches int
+}
+
+// FitDixonColes fits the model on the results played before at, each weighted
+// by its age. Strengths come from the Poisson likelihood's fixed point, in
+// the manner of Maher; Rho is then the best of a grid given those rates.
+func FitDixonColes(league League, matches []Match, at time.Time, opts DixonColesOptions) *DixonColes {
+	dc := &DixonColes{League: league, FitAt: at, Attack: make(map[int]float64), Defence: make(map[int]float64), Home: 1}
+
+	type result struct {
+		Match
+		weight float64
+	}
+	var results []result
+	for _, m := range matches {
+		if !m.Finished || !m.Kickoff.Before(at) {
+			continue
+		}
+		w := 1.0
+		if opts.HalfLifeDays > 0 {
+			w = math.Pow(0.5, at.Sub(m.Kickoff).Hours()/24/opts.HalfLifeDays)
+		}
+		results = append(results, result{Match: m, weight: w})
+		dc.Attack[m.Home], dc.Attack[m.Away] = 1, 1
+		dc.Defence[m.Home], dc.Defence[m.Away] = 1, 1
+	}
+	dc.Matches = len(results)
+	if len(results) == 0 {
+		return dc
+	}
+
+	// Each block is solved given the others: attack, then defence, then the
+	// home advantage. Updating them all at once oscillates.
+	for it := 0; it < opts.Iterations; it++ {
+		scored := make(map[int]float64, len(dc.Attack))
+		exposure := make(map[int]float64, len(dc.Attack))
+		for _, r := range results {
+			h, a, w := r.Home, r.Away, r.weight
+			scored[h] += w * float64(r.HomeGoals)
+			scored[a] += w * float64(r.AwayGoals)
+			exposure[h] += w * dc.Defence[a] * dc.Home
+			exposure[a] += w * dc.Defence[h]
+		}
+		var mean float64
+		for team := range dc.Attack {
+			if exposure[team] > 0 {
+				dc.Attack[team] = max(scored[team], 0.01) / exposure[team]
+			}
+			mean += dc.Attack[team]
+		}
+		// Only the products are identified: scale attack to a mean of 1.
+		mean /= float64(len(dc.Attack))
+		for team := range dc.Attack {
+			dc.Attack[team] /= mean
+		}
+
+		conceded := make(map[int]float64, len(dc.Attack))
+		exposure = make(map[int]float64, len(dc.Attack))
+		for _, r := range results {
+			h, a, w := r.Home, r.Away, r.weight
+			conceded[h] += w * float64(r.AwayGoals)
+			conceded[a] += w * float64(r.HomeGoals)
+			exposure[h] += w * dc.Attack[a]
+			exposure[a] += w * dc.Attack[h] * dc.Home
+		}
+		for team := range dc.Defence {
+			if exposure[team] > 0 {
+				dc.Defence[team] = max(conceded[team], 0.01) / exposure[team]
+			}
+		}
+
+		var homeGoals, homeExposure float64
+		for _, r := range results {
+			homeGoals += r.weight * float64(r.HomeGoals)
+			homeExposure += r.weight * dc.Attack[r.Home] * dc.Defence[r.Away]
+		}
+		if homeExposure > 0 {
+			dc.Home = max(homeGoals, 0.01) / homeExposure
+		}
+	}
+
+	best := math.Inf(-1)
+	for rho := -0.3; rho <= 0.3+1e-9; rho += 0.01 {
+		var ll float64
+		for _, r := range results {
+			lh, la := dc.Rates(r.Home, r.Away)
+			t := tau(r.HomeGoals, r.AwayGoals, lh, la, rho)
+			if t <= 0 {
+				ll = math.Inf(-1)
+				break
+			}
+			ll += r.weight * math.Log(t)
+		}
+		if ll > best {
+			best, dc.Rho = ll, math.Round(rho*100)/100
+		}
+	}
+
+	return dc
+}
+
+// Rates are the expected goals of each side; teams the model has not seen
+// count as average.
+func (d *DixonColes) Rates(home, away int) (float64, float64) {
+	strength := func(m map[int]float64, team int) float64 {
+		if v, ok := m[team]; ok {
+			return v
+		}
+		return 1
+	}
+	return strength(d.Attack, home) * strength(d.Defence, away) * d.Home,
+		strength(d.Attack, away) * strength(d.Defence, home)
+}
+
+func tau(x, y int, lh, la, rho float64) float64 {
+	switch {
+	case x == 0 && y == 0:
+		return 1 - lh*la*rho
+	case x == 0 && y == 1:
+		return 1 + lh*rho
+	case x == 1 && y == 0:
+		return 1 + la*rho
+	case x == 1 && y == 1:
+		return 1 - rho
+	}
+	return 1
+}
+
+func poisson(k int, lambda float64) float64 {
+	if lambda <= 0 {
+		if k == 0 {
+			return 1
+		}
+		return 0
+	}
+	lg, _ := math.Lgamma(float64(k + 1))
+	return math.Exp(float64(k)*math.Log(lambda) - lambda - lg)
+}
+
+// Scorelines is the grid of scoreline probabilities up to maxGoals, the last
+// row and column gathering every higher score.
+func (d *DixonColes) Scorelines(home, away, maxGoals int) [][]float64 {
+	lh, la := d.Rates(home, away)
+	return ScorelineGrid(lh, la, d.Rho, maxGoals)
+}
+
+// ScorelineGrid is Scorelines for given scoring rates.
+func ScorelineGrid(lh, la, rho float64, maxGoals int) [][]float64 {
+	ph := tail(lh, maxGoals)
+	pa := tail(la, maxGoals)
+
+	grid := make([][]float64, maxGoals+1)
+	var total float64
+	for x := range grid {
+		grid[x] = make([]float64, maxGoals+1)
+		for y := range grid[x] {
+			grid[x][y] = ph[x] * pa[y] * max(tau(x, y, lh, la, rho), 0)
+			total += grid[x][y]
+		}
+	}
+	for x := range grid {
+		for y := range grid[x] {
+			grid[x][y] /= total
+		}
+	}
+	return grid
+}
+
+// tail is the Poisson distribution up to n, with n standing for n or more.
+func tail(lambda float64, n int) []float64 {
+	p := make([]float64, n+1)
+	rest := 1.0
+	for k := 0; k < n; k++ {
+		p[k] = poisson(k, lambda)
+		rest -= p[k]
+	}
+	p[n] = max(rest, 0)
+	return p
+}
+
+// GridOutcome sums a scoreline grid into a win, a draw and a loss. Scores in
+// the open-ended last row and column count by their lower bound, which only
+// matters when both sides score maxGoals or more.
+func GridOutcome(grid [][]float64) Outcome {
+	var o Outcome
+	for x := range grid {
+		for y := range grid[x] {
+			switch {
+			case x > y:
+				o.Home += grid[x][y]
+			case x < y:
+				o.Away += grid[x][y]
+			default:
+				o.Draw += grid[x][y]
+			}
+		}
+	}
+	return o
+}
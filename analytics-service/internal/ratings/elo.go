@@ -0,0 +1,148 @@
+package ratings
+
+import (
+	"math"
+	"time"
+)
+
+type EloOptions struct {
+	// K is the largest rating change of a one-goal result.
+	K float64
+	// HomeAdvantage is added to the home side's rating.
+	HomeAdvantage float64
+	// Initial is the rating of the teams of a league's first season and
+	// NewTeam the rating of every team joining it later, usually promoted.
+	Initial float64
+	NewTeam float64
+	// CarryOver is the share of a team's distance from the league mean kept
+	// from one season to the next.
+	CarryOver float64
+}
+
+func DefaultEloOptions() EloOptions {
+	return EloOptions{K: 20, HomeAdvantage: 60, Initial: 1500, NewTeam: 1400, CarryOver: 0.8}
+}
+
+// EloUpdate is one team's rating before and after a match.
+type EloUpdate struct {
+	League   League
+	SeasonID int
+	MatchID  int
+	Kickoff  time.Time
+	TeamID   int
+	Opponent int
+	WasHome  bool
+	Before   float64
+	After    float64
+}
+
+// Elo rates the teams of one league from their results in kickoff order.
+type Elo struct {
+	Options EloOptions
+	Ratings map[int]float64
+
+	seen    map[int]bool
+	joined  bool
+	draws   float64
+	matches float64
+}
+
+func NewElo(opts EloOptions) *Elo {
+	return &Elo{Options: opts, Ratings: make(map[int]float64), seen: make(map[int]bool)}
+}
+
+func (e *Elo) Rating(team int) float64 {
+	if r, ok := e.Ratings[team]; ok {
+		return r
+	}
+	if e.joined {
+		return e.Options.NewTeam
+	}
+	return e.Options.Initial
+}
+
+// StartSeason pulls every rating towards the league mean the first time a
+// season is seen. From then on unknown teams join at NewTeam. A match of an
+// earlier season played late, once postponed, changes nothing.
+func (e *Elo) StartSeason(season int) {
+	if e.seen[season] {
+		return
+	}
+	e.seen[season] = true
+	if len(e.Ratings) == 0 {
+		return
+	}
+
+	var mean float64
+	for _, r := range e.Ratings {
+		mean += r
+	}
+	mean /= float64(len(e.Ratings))
+	for team, r := range e.Ratings {
+		e.Ratings[team] = mean + e.Options.CarryOver*(r-mean)
+	}
+	e.joined = true
+}
+
+// Expected is the home side's expected score, a win counting 1 and a draw ½.
+func (e *Elo) Expected(home, away int) float64 {
+	diff := e.Rating(home) + e.Options.HomeAdvantage - e.Rating(away)
+	return 1 / (1 + math.Pow(10, -diff/400))
+}
+
+// Update rates a finished match and returns both sides' changes, home first.
+// The change grows with the goal difference as in the World Football Elo.
+func (e *Elo) Update(m Match) [2]EloUpdate {
+	e.StartSeason(m.SeasonID)
+
+	before := [2]float64{e.Rating(m.Home), e.Rating(m.Away)}
+	expected := e.Expected(m.Home, m.Away)
+
+	score := 0.5
+	switch {
+	case m.HomeGoals > m.AwayGoals:
+		score = 1
+	case m.HomeGoals < m.AwayGoals:
+		score = 0
+	default:
+		e.draws++
+	}
+	e.matches++
+
+	margin := 1.0
+	switch gd := math.Abs(float64(m.HomeGoals - m.AwayGoals)); {
+	case gd == 2:
+		margin = 1.5
+	case gd > 2:
+		margin = (11 + gd) / 8
+	}
+
+	delta := e.Options.K * margin * (score - expected)
+	e.Ratings[m.Home] = before[0] + delta
+	e.Ratings[m.Away] = before[1] - delta
+
+	return [2]EloUpdate{
+		{League: m.League, SeasonID: m.SeasonID, MatchID: m.MatchID, Kickoff: m.Kickoff,
+			TeamID: m.Home, Opponent: m.Away, WasHome: true, Before: before[0], After: e.Ratings[m.Home]},
+		{League: m.League, SeasonID: m.SeasonID, MatchID: m.MatchID, Kickoff: m.Kickoff,
+			TeamID: m.Away, Opponent: m.Home, WasHome: false, Before: before[1], After: e.Ratings[m.Away]},
+	}
+}
+
+// DrawRate is the share of draws among the matches rated so far, or the
+// long-run average of top leagues before any.
+func (e *Elo) DrawRate() float64 {
+	if e.matches == 0 {
+		return 0.25
+	}
+	return e.draws / e.matches
+}
+
+// Outcome splits the expected score into a win, a draw and a loss. Draws are
+// likeliest between even sides: the league's draw rate is scaled by
+// 4·E·(1−E), which is 1 when E is ½.
+func (e *Elo) Outcome(home, away int) Outcome {
+	expected := e.Expected(home, away)
+	draw := min(e.DrawRate(), 0.5) * 4 * expected * (1 - expected)
+	return Outcome{Home: expected - draw/2, Draw: draw, Away: 1 - expected - draw/2}
+}
@@ -0,0 +1,60 @@
+package ratings
+
+import (
+	"fmt"
+	"time"
+)
+
+// ModelVersion is stored with every rating and prediction. Bump it whenever
+// the maths of either model changes.
+const ModelVersion = "elo-dc-v1"
+
+// League identifies a competition and the team ids used in it. Sofascore
+// leagues keep Sofascore's team ids; FPL fixtures use FPL team codes, which
+// unlike team ids stay the same across seasons.
+type League string
+
+const FPLLeague League = "fpl"
+
+func SofascoreLeague(leagueID int) League {
+	return League(fmt.Sprintf("sofascore-%d", leagueID))
+}
+
+// Match is a result, or a fixture still to play when Finished is false.
+// MatchID is unique within a season of its league.
+type Match struct {
+	League    League
+	SeasonID  int
+	MatchID   int
+	Kickoff   time.Time
+	Home      int
+	Away      int
+	HomeGoals int
+	AwayGoals int
+	Finished  bool
+}
+
+// Outcome is the probability of each result, from the home side's view.
+type Outcome struct {
+	Home float64
+	Draw float64
+	Away float64
+}
+
+// Prediction is both models' view of a fixture to play. Scorelines[h][a] is
+// the Dixon-Coles probability of h-a; the last row and column hold every
+// score of that many goals or more.
+type Prediction struct {
+	League       League
+	SeasonID     int
+	MatchID      int
+	Kickoff      time.Time
+	Home         int
+	Away         int
+	Elo          Outcome
+	DixonColes   Outcome
+	XGoalsHome   float64
+	XGoalsAway   float64
+	Scorelines   [][]float64
+	ModelVersion string
+}
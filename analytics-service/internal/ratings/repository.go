@@ -0,0 +1,225 @@
+package ratings
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/db/helpers"
+	"github.com/lib/pq"
+)
+
+// Repo reads FPL results from and writes every league's ratings to the fpl
+// database.
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// LoadFPLMatches returns the fixtures of every indexed FPL season with teams
+// keyed by team code.
+func (r *Repo) LoadFPLMatches(ctx context.Context) ([]Match, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT f.season_id, f.fixture_id, f.kickoff_time, th.team_code, ta.team_code,
+		       COALESCE(f.team_h_score, 0), COALESCE(f.team_a_score, 0),
+		       COALESCE(f.finished, FALSE) AND f.team_h_score IS NOT NULL AND f.team_a_score IS NOT NULL
+		FROM fixtures f
+		JOIN teams th ON th.team_id = f.team_h AND th.season_id = f.season_id
+		JOIN teams ta ON ta.team_id = f.team_a AND ta.season_id = f.season_id
+		WHERE f.kickoff_time IS NOT NULL AND th.team_code IS NOT NULL AND ta.team_code IS NOT NULL
+		ORDER BY f.kickoff_time, f.fixture_id`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying fixtures: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []Match
+	for rows.Next() {
+		m := Match{League: FPLLeague}
+		if err := rows.Scan(&m.SeasonID, &m.MatchID, &m.Kickoff, &m.Home, &m.Away, &m.HomeGoals, &m.AwayGoals, &m.Finished); err != nil {
+			return nil, fmt.Errorf("scanning fixtures: %w", err)
+		}
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
+
+// ReplaceEloHistory swaps the stored Elo history of some seasons of a league
+// for updates.
+func (r *Repo) ReplaceEloHistory(ctx context.Context, league League, seasons []int, updates []EloUpdate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning elo tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx,
+		`DELETE FROM elo_history WHERE league = $1 AND model_version = $2 AND season_id = ANY($3)`,
+		string(league), ModelVersion, pq.Array(seasons),
+	); err != nil {
+		return fmt.Errorf("deleting elo_history: %w", err)
+	}
+
+	rows := make([][]any, 0, len(updates))
+	for _, u := range updates {
+		rows = append(rows, []any{
+			string(u.League), u.SeasonID, u.MatchID, u.TeamID, ModelVersion,
+			u.Kickoff, u.Opponent, u.WasHome, u.Before, u.After,
+		})
+	}
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table: "elo_history",
+		Columns: []string{
+			"league", "season_id", "match_id", "team_id", "model_version",
+			"kickoff", "opponent_team_id", "was_home", "rating_before", "rating_after",
+		},
+		ConflictCols: []string{"league", "season_id", "match_id", "team_id", "model_version"},
+		Rows:         rows,
+	}); err != nil {
+		return fmt.Errorf("inserting elo_history: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("committing elo tx: %w", err)
+	}
+	return nil
+}
+
+// SaveFit stores a Dixon-Coles fit with its team strengths. Fits are kept
+// by date, so they form a history.
+func (r *Repo) SaveFit(ctx context.Context, dc *DixonColes) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning dixon-coles tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table:        "dixon_coles_fits",
+		Columns:      []string{"league", "fit_at", "model_version", "home_advantage", "rho", "matches"},
+		ConflictCols: []string{"league", "fit_at", "model_version"},
+		Rows:         [][]any{{string(dc.League), dc.FitAt, ModelVersion, dc.Home, dc.Rho, dc.Matches}},
+	}); err != nil {
+		return fmt.Errorf("inserting dixon_coles_fits: %w", err)
+	}
+
+	rows := make([][]any, 0, len(dc.Attack))
+	for team, attack := range dc.Attack {
+		rows = append(rows, []any{string(dc.League), dc.FitAt, ModelVersion, team, attack, dc.Defence[team]})
+	}
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table:        "dixon_coles_teams",
+		Columns:      []string{"league", "fit_at", "model_version", "team_id", "attack", "defence"},
+		ConflictCols: []string{"league", "fit_at", "model_version", "team_id"},
+		Rows:         rows,
+	}); err != nil {
+		return fmt.Errorf("inserting dixon_coles_teams: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("committing dixon-coles tx: %w", err)
+	}
+	return nil
+}
+
+// SavePredictions upserts predictions keyed by (league, season, match, model
+// version).
+func (r *Repo) SavePredictions(ctx context.Context, predictions []Prediction) error {
+	now := time.Now()
+
+	rows := make([][]any, 0, len(predictions))
+	for _, p := range predictions {
+		scorelines, err := json.Marshal(p.Scorelines)
+		if err != nil {
+			return fmt.Errorf("encoding scorelines of match %d: %w", p.MatchID, err)
+		}
+		rows = append(rows, []any{
+			string(p.League), p.SeasonID, p.MatchID, p.ModelVersion, p.Kickoff, p.Home, p.Away,
+			p.Elo.Home, p.Elo.Draw, p.Elo.Away,
+			p.DixonColes.Home, p.DixonColes.Draw, p.DixonColes.Away,
+			p.XGoalsHome, p.XGoalsAway, string(scorelines), now,
+		})
+	}
+
+	return helpers.BatchUpsert(ctx, r.db, helpers.UpsertOpts{
+		Table: "match_predictions",
+		Columns: []string{
+			"league", "season_id", "match_id", "model_version", "kickoff", "home_team_id", "away_team_id",
+			"elo_home_win", "elo_draw", "elo_away_win",
+			"dc_home_win", "dc_draw", "dc_away_win",
+			"x_goals_home", "x_goals_away", "scorelines", "created_at",
+		},
+		ConflictCols: []string{"league", "season_id", "match_id", "model_version"},
+		Rows:         rows,
+	})
+}
+
+// SofascoreRepo reads results from the sofascore database.
+type SofascoreRepo struct {
+	db *sql.DB
+}
+
+func NewSofascoreRepo(db *sql.DB) *SofascoreRepo {
+	return &SofascoreRepo{db: db}
+}
+
+// LoadLeagues returns every league with at least one result.
+func (r *SofascoreRepo) LoadLeagues(ctx context.Context) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT DISTINCT league_id FROM matches WHERE home_score IS NOT NULL ORDER BY league_id`)
+	if err != nil {
+		return nil, fmt.Errorf("querying leagues: %w", err)
+	}
+	defer rows.Close()
+
+	var leagues []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scanning leagues: %w", err)
+		}
+		leagues = append(leagues, id)
+	}
+
+	return leagues, rows.Err()
+}
+
+// LoadMatches returns the results and the fixtures still to play of a league.
+// Sofascore's status codes 100, 110 and 120 are ended, after extra time and
+// after penalties; 0 is not started.
+func (r *SofascoreRepo) LoadMatches(ctx context.Context, leagueID int) ([]Match, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT season_id, match_id, start_time, home_team_id, away_team_id,
+		       COALESCE(home_score, 0), COALESCE(away_score, 0),
+		       status IN ('100', '110', '120') AND home_score IS NOT NULL AND away_score IS NOT NULL
+		FROM matches
+		WHERE league_id = $1 AND start_time IS NOT NULL
+		  AND home_team_id IS NOT NULL AND away_team_id IS NOT NULL
+		  AND (status IN ('100', '110', '120') OR status = '0')
+		ORDER BY start_time, match_id`,
+		leagueID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying matches: %w", err)
+	}
+	defer rows.Close()
+
+	league := SofascoreLeague(leagueID)
+	var matches []Match
+	for rows.Next() {
+		m := Match{League: league}
+		if err := rows.Scan(&m.SeasonID, &m.MatchID, &m.Kickoff, &m.Home, &m.Away, &m.HomeGoals, &m.AwayGoals, &m.Finished); err != nil {
+			return nil, fmt.Errorf("scanning matches: %w", err)
+		}
+		matches = append(matches, m)
+	}
+
+	return matches, rows.Err()
+}
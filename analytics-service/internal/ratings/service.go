@@ -0,0 +1,157 @@
+package ratings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Service struct {
+	Repo *Repo
+	// Sofascore is optional; without it only FPL's fixtures are rated.
+	Sofascore   *SofascoreRepo
+	Elo         EloOptions
+	DixonColes  DixonColesOptions
+	HorizonDays int
+}
+
+// Leagues lists FPL and, when Sofascore is available, every Sofascore league
+// with results.
+func (s *Service) Leagues(ctx context.Context) ([]League, error) {
+	leagues := []League{FPLLeague}
+	if s.Sofascore == nil {
+		return leagues, nil
+	}
+	ids, err := s.Sofascore.LoadLeagues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		leagues = append(leagues, SofascoreLeague(id))
+	}
+	return leagues, nil
+}
+
+func (s *Service) loadMatches(ctx context.Context, league League) ([]Match, error) {
+	if league == FPLLeague {
+		return s.Repo.LoadFPLMatches(ctx)
+	}
+	raw, ok := strings.CutPrefix(string(league), "sofascore-")
+	id, err := strconv.Atoi(raw)
+	if !ok || err != nil {
+		return nil, fmt.Errorf("unknown league %q", league)
+	}
+	if s.Sofascore == nil {
+		return nil, fmt.Errorf("league %s needs the sofascore database", league)
+	}
+	return s.Sofascore.LoadMatches(ctx, id)
+}
+
+// Summary is the outcome of rating one league.
+type Summary struct {
+	League      League
+	Results     int
+	Seasons     []int
+	Ratings     map[int]float64
+	Fit         *DixonColes
+	Predictions []Prediction
+}
+
+// Run replays a league's whole history through Elo and stores the rating
+// history of fromSeason and every later season, replacing what was there, so
+// a season can be re-run from scratch; a fromSeason of 0 means the latest.
+// It then fits Dixon-Coles on the results before now and predicts the
+// fixtures of the next HorizonDays.
+func (s *Service) Run(ctx context.Context, league League, fromSeason int, now time.Time) (*Summary, error) {
+	matches, err := s.loadMatches(ctx, league)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Kickoff.Before(matches[j].Kickoff) })
+
+	// Seasons in the order they started.
+	var seasons []int
+	seen := make(map[int]bool)
+	for _, m := range matches {
+		if !seen[m.SeasonID] {
+			seen[m.SeasonID] = true
+			seasons = append(seasons, m.SeasonID)
+		}
+	}
+	if len(seasons) == 0 {
+		return nil, fmt.Errorf("league %s has no matches", league)
+	}
+	if fromSeason == 0 {
+		fromSeason = seasons[len(seasons)-1]
+	}
+	from := -1
+	for k, season := range seasons {
+		if season == fromSeason {
+			from = k
+		}
+	}
+	if from < 0 {
+		return nil, fmt.Errorf("league %s has no season %d", league, fromSeason)
+	}
+	rewrite := make(map[int]bool)
+	for _, season := range seasons[from:] {
+		rewrite[season] = true
+	}
+
+	summary := &Summary{League: league, Seasons: seasons[from:]}
+	elo := NewElo(s.Elo)
+	var updates []EloUpdate
+	for _, m := range matches {
+		if !m.Finished || !m.Kickoff.Before(now) {
+			continue
+		}
+		summary.Results++
+		sides := elo.Update(m)
+		if rewrite[m.SeasonID] {
+			updates = append(updates, sides[0], sides[1])
+		}
+	}
+	if err := s.Repo.ReplaceEloHistory(ctx, league, summary.Seasons, updates); err != nil {
+		return nil, err
+	}
+
+	summary.Fit = FitDixonColes(league, matches, now, s.DixonColes)
+	if err := s.Repo.SaveFit(ctx, summary.Fit); err != nil {
+		return nil, err
+	}
+
+	until := now.AddDate(0, 0, s.HorizonDays)
+	for _, m := range matches {
+		if m.Finished || m.Kickoff.Before(now) || m.Kickoff.After(until) {
+			continue
+		}
+		elo.StartSeason(m.SeasonID)
+		summary.Predictions = append(summary.Predictions, s.predict(elo, summary.Fit, m))
+	}
+	summary.Ratings = elo.Ratings
+
+	if err := s.Repo.SavePredictions(ctx, summary.Predictions); err != nil {
+		return nil, fmt.Errorf("saving predictions: %w", err)
+	}
+	return summary, nil
+}
+
+func (s *Service) predict(elo *Elo, dc *DixonColes, m Match) Prediction {
+	p := Prediction{
+		League:       m.League,
+		SeasonID:     m.SeasonID,
+		MatchID:      m.MatchID,
+		Kickoff:      m.Kickoff,
+		Home:         m.Home,
+		Away:         m.Away,
+		Elo:          elo.Outcome(m.Home, m.Away),
+		Scorelines:   dc.Scorelines(m.Home, m.Away, s.DixonColes.MaxGoals),
+		ModelVersion: ModelVersion,
+	}
+	p.XGoalsHome, p.XGoalsAway = dc.Rates(m.Home, m.Away)
+	p.DixonColes = GridOutcome(p.Scorelines)
+	return p
+}
@@ -0,0 +1,147 @@
+package snapshots
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+)
+
+// FormatVersion is the payload encoding this package reads: the
+// bootstrap-static response as FPL sent it, gzipped.
+const FormatVersion = 1
+
+// Snapshot is bootstrap-static as captured before a gameweek's deadline.
+// Version numbers the captures of a gameweek that differ, from 1.
+type Snapshot struct {
+	SeasonID      int
+	Event         int
+	Version       int
+	DeadlineTime  time.Time
+	CapturedAt    time.Time
+	FormatVersion int
+	SHA256        string
+	Payload       []byte
+}
+
+// Decode unzips and parses a snapshot's payload, checking it against its
+// hash.
+func Decode(s Snapshot) (*fpl.BootstrapResponse, error) {
+	if s.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("snapshot format %d is not supported", s.FormatVersion)
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(s.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("opening snapshot payload: %w", err)
+	}
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("reading snapshot payload: %w", err)
+	}
+
+	sum := sha256.Sum256(raw)
+	if s.SHA256 != "" && hex.EncodeToString(sum[:]) != s.SHA256 {
+		return nil, fmt.Errorf("snapshot payload does not match its sha256")
+	}
+
+	var bootstrap fpl.BootstrapResponse
+	if err := json.Unmarshal(raw, &bootstrap); err != nil {
+		return nil, fmt.Errorf("parsing snapshot payload: %w", err)
+	}
+	return &bootstrap, nil
+}
+
+// PlayerState is a player as the deadline found them.
+type PlayerState struct {
+	PlayerID          int
+	TeamID            int
+	ElementType       int
+	WebName           string
+	Status            string
+	News              string
+	ChanceNextRound   *int
+	NowCost           int
+	SelectedByPercent float64
+	SelectedRank      int
+	Form              float64
+	TotalPoints       int
+	TransfersInEvent  int
+	TransfersOutEvent int
+}
+
+// TeamState is a team as the deadline found it.
+type TeamState struct {
+	TeamID              int
+	Name                string
+	ShortName           string
+	Position            int
+	Strength            int
+	StrengthOverallHome int
+	StrengthOverallAway int
+	StrengthAttackHome  int
+	StrengthAttackAway  int
+	StrengthDefenceHome int
+	StrengthDefenceAway int
+}
+
+// Players flattens a bootstrap's players.
+func Players(b *fpl.BootstrapResponse) []PlayerState {
+	players := make([]PlayerState, 0, len(b.GetElements()))
+	for _, e := range b.GetElements() {
+		p := PlayerState{
+			PlayerID:          int(e.GetId()),
+			TeamID:            int(e.GetTeam()),
+			ElementType:       int(e.GetElementType()),
+			WebName:           e.GetWebName(),
+			Status:            e.GetStatus(),
+			News:              e.GetNews(),
+			NowCost:           int(e.GetNowCost()),
+			SelectedByPercent: parseFloat(e.GetSelectedByPercent()),
+			SelectedRank:      int(e.GetSelectedRank()),
+			Form:              parseFloat(e.GetForm()),
+			TotalPoints:       int(e.GetTotalPoints()),
+			TransfersInEvent:  int(e.GetTransfersInEvent()),
+			TransfersOutEvent: int(e.GetTransfersOutEvent()),
+		}
+		if e.ChanceOfPlayingNextRound != nil {
+			chance := int(e.GetChanceOfPlayingNextRound())
+			p.ChanceNextRound = &chance
+		}
+		players = append(players, p)
+	}
+	return players
+}
+
+// Teams flattens a bootstrap's teams.
+func Teams(b *fpl.BootstrapResponse) []TeamState {
+	teams := make([]TeamState, 0, len(b.GetTeams()))
+	for _, t := range b.GetTeams() {
+		teams = append(teams, TeamState{
+			TeamID:              int(t.GetId()),
+			Name:                t.GetName(),
+			ShortName:           t.GetShortName(),
+			Position:            int(t.GetPosition()),
+			Strength:            int(t.GetStrength()),
+			StrengthOverallHome: int(t.GetStrengthOverallHome()),
+			StrengthOverallAway: int(t.GetStrengthOverallAway()),
+			StrengthAttackHome:  int(t.GetStrengthAttackHome()),
+			StrengthAttackAway:  int(t.GetStrengthAttackAway()),
+			StrengthDefenceHome: int(t.GetStrengthDefenceHome()),
+			StrengthDefenceAway: int(t.GetStrengthDefenceAway()),
+		})
+	}
+	return teams
+}
+
+func parseFloat(s string) float64 {
+	f, _ := strconv.ParseFloat(s, 64)
+	return f
+}
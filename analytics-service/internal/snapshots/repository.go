@@ -0,0 +1,127 @@
+package snapshots
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/db/helpers"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// Events returns the gameweeks of a season with a snapshot.
+func (r *Repo) Events(ctx context.Context, seasonID int) ([]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT DISTINCT event FROM bootstrap_snapshots WHERE season_id = $1 ORDER BY event`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying bootstrap_snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var events []int
+	for rows.Next() {
+		var event int
+		if err := rows.Scan(&event); err != nil {
+			return nil, fmt.Errorf("scanning bootstrap_snapshots: %w", err)
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// Load reads a gameweek's snapshot, version 0 meaning the latest.
+func (r *Repo) Load(ctx context.Context, seasonID, event, version int) (Snapshot, error) {
+	s := Snapshot{SeasonID: seasonID, Event: event}
+	var deadline, captured sql.NullTime
+	err := r.db.QueryRowContext(ctx, `
+		SELECT version, deadline_time, captured_at, format_version, sha256, payload
+		FROM bootstrap_snapshots
+		WHERE season_id = $1 AND event = $2 AND ($3 = 0 OR version = $3)
+		ORDER BY version DESC
+		LIMIT 1`,
+		seasonID, event, version,
+	).Scan(&s.Version, &deadline, &captured, &s.FormatVersion, &s.SHA256, &s.Payload)
+	if err == sql.ErrNoRows {
+		return s, fmt.Errorf("no snapshot of GW%d in season %d", event, seasonID)
+	}
+	if err != nil {
+		return s, fmt.Errorf("querying bootstrap_snapshots: %w", err)
+	}
+	s.DeadlineTime, s.CapturedAt = deadline.Time, captured.Time
+	return s, nil
+}
+
+// Rehydrate replaces a gameweek's rows of snapshot_players and
+// snapshot_teams with a snapshot's.
+func (r *Repo) Rehydrate(ctx context.Context, s Snapshot, players []PlayerState, teams []TeamState) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning snapshot tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, table := range []string{"snapshot_players", "snapshot_teams"} {
+		if _, err := tx.ExecContext(ctx,
+			fmt.Sprintf(`DELETE FROM %s WHERE season_id = $1 AND event = $2`, table),
+			s.SeasonID, s.Event,
+		); err != nil {
+			return fmt.Errorf("deleting %s: %w", table, err)
+		}
+	}
+
+	now := time.Now()
+
+	playerRows := make([][]any, 0, len(players))
+	for _, p := range players {
+		playerRows = append(playerRows, []any{
+			s.SeasonID, s.Event, p.PlayerID, s.Version, p.TeamID, p.ElementType, p.WebName,
+			p.Status, p.News, p.ChanceNextRound, p.NowCost, p.SelectedByPercent, p.SelectedRank,
+			p.Form, p.TotalPoints, p.TransfersInEvent, p.TransfersOutEvent, now,
+		})
+	}
+	if err := helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table: "snapshot_players",
+		Columns: []string{
+			"season_id", "event", "player_id", "snapshot_version", "team_id", "element_type_id", "web_name",
+			"status", "news", "chance_of_playing_next_round", "now_cost", "selected_by_percent", "selected_rank",
+			"form", "total_points", "transfers_in_event", "transfers_out_event", "created_at",
+		},
+		ConflictCols: []string{"season_id", "event", "player_id"},
+		Rows:         playerRows,
+	}); err != nil {
+		return fmt.Errorf("inserting snapshot_players: %w", err)
+	}
+
+	teamRows := make([][]any, 0, len(teams))
+	for _, t := range teams {
+		teamRows = append(teamRows, []any{
+			s.SeasonID, s.Event, t.TeamID, s.Version, t.Name, t.ShortName, t.Position, t.Strength,
+			t.StrengthOverallHome, t.StrengthOverallAway, t.StrengthAttackHome, t.StrengthAttackAway,
+			t.StrengthDefenceHome, t.StrengthDefenceAway, now,
+		})
+	}
+	if err := helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table: "snapshot_teams",
+		Columns: []string{
+			"season_id", "event", "team_id", "snapshot_version", "name", "short_name", "position", "strength",
+			"strength_overall_home", "strength_overall_away", "strength_attack_home", "strength_attack_away",
+			"strength_defence_home", "strength_defence_away", "created_at",
+		},
+		ConflictCols: []string{"season_id", "event", "team_id"},
+		Rows:         teamRows,
+	}); err != nil {
+		return fmt.Errorf("inserting snapshot_teams: %w", err)
+	}
+
+	return tx.Commit()
+}
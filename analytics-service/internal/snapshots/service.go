@@ -0,0 +1,47 @@
+package snapshots
+
+import (
+	"context"
+	"fmt"
+)
+
+type Service struct {
+	Repo *Repo
+}
+
+// Rehydrate loads a gameweek's snapshot, version 0 meaning the latest, into
+// snapshot_players and snapshot_teams and returns it.
+func (s *Service) Rehydrate(ctx context.Context, seasonID, event, version int) (Snapshot, error) {
+	snapshot, err := s.Repo.Load(ctx, seasonID, event, version)
+	if err != nil {
+		return snapshot, err
+	}
+
+	bootstrap, err := Decode(snapshot)
+	if err != nil {
+		return snapshot, fmt.Errorf("decoding GW%d snapshot v%d: %w", event, snapshot.Version, err)
+	}
+
+	if err := s.Repo.Rehydrate(ctx, snapshot, Players(bootstrap), Teams(bootstrap)); err != nil {
+		return snapshot, err
+	}
+	return snapshot, nil
+}
+
+// RehydrateAll rehydrates the latest snapshot of every gameweek of a season.
+func (s *Service) RehydrateAll(ctx context.Context, seasonID int) ([]Snapshot, error) {
+	events, err := s.Repo.Events(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]Snapshot, 0, len(events))
+	for _, event := range events {
+		snapshot, err := s.Rehydrate(ctx, seasonID, event, 0)
+		if err != nil {
+			return snapshots, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
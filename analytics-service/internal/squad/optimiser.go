@@ -0,0 +1,723 @@
+package squad
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Candidate is a selectable player with its projected points over the
+// planning horizon.
+type Candidate struct {
+	PlayerID    int
+	Name        string
+	TeamID      int
+	ElementType int
+	// Cost is in tenths of a million, like now_cost.
+	Cost   int
+	Status string
+	Points float64
+}
+
+type Options struct {
+	// Locked players must be in the squad, Banned players must not.
+	Locked []int
+	Banned []int
+	// BenchWeight is what a bench point is worth relative to a starter point.
+	BenchWeight float64
+	// MaxNodes caps the search; the best squad found so far is returned when
+	// it is hit and Result.Optimal is false. Zero means DefaultMaxNodes.
+	MaxNodes int
+}
+
+const (
+	DefaultBenchWeight = 0.1
+	DefaultMaxNodes    = 5_000_000
+
+	// warmUpNodes is the search budget per formation of the first, inexact
+	// pass that finds a strong incumbent before the exact pass.
+	warmUpNodes = 20_000
+)
+
+type Result struct {
+	// Starters are ordered by element type then points; Bench lists the
+	// goalkeeper first, then outfielders by points, like the FPL bench.
+	Starters []Candidate
+	Bench    []Candidate
+	Cost     int
+	// Points is the projected points of the starters, Value the objective
+	// (starters plus weighted bench).
+	Points  float64
+	Value   float64
+	Optimal bool
+	Nodes   int
+}
+
+const (
+	roleNone = iota
+	roleStart
+	roleBench
+)
+
+const epsilon = 1e-9
+
+// Optimise picks the squad and starting XI that maximise projected points
+// under the budget, position quotas and club limit.
+//
+// The search is a branch-and-bound per formation, where every candidate either
+// starts, sits on the bench or is left out. Nodes are bounded by the
+// Lagrangian relaxation of the budget, and the same bound discards, per
+// formation, every candidate that cannot be part of a better squad than the
+// incumbent. The incumbent comes from a swap-based local search and a short
+// first pass over all formations; the second pass is exact unless MaxNodes
+// is hit.
+func Optimise(candidates []Candidate, rules Rules, opts Options) (*Result, error) {
+	if err := rules.Validate(); err != nil {
+		return nil, err
+	}
+	if opts.BenchWeight < 0 || opts.BenchWeight > 1 {
+		return nil, fmt.Errorf("bench weight %v outside [0, 1]", opts.BenchWeight)
+	}
+	if opts.MaxNodes == 0 {
+		opts.MaxNodes = DefaultMaxNodes
+	}
+
+	s, err := newSolver(candidates, rules, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	s.localSearch()
+	for _, formation := range s.formations() {
+		s.limit = s.nodes + warmUpNodes
+		s.solve(formation)
+	}
+	s.limit, s.truncated = opts.MaxNodes, false
+	for _, formation := range s.formations() {
+		s.solve(formation)
+	}
+
+	if !s.found {
+		if s.truncated {
+			return nil, fmt.Errorf("no legal squad found within %d nodes", opts.MaxNodes)
+		}
+		return nil, fmt.Errorf("no legal squad within a budget of %d", rules.Budget)
+	}
+
+	return s.result(), nil
+}
+
+type solver struct {
+	rules Rules
+	opts  Options
+
+	// types are the element types in ascending order; every per-position
+	// slice is indexed by the place of the type in types.
+	types []int
+	pos   map[int]int
+	quota []Position
+
+	locked  []Candidate
+	pool    []Candidate
+	cheapTo [][]int // cheapTo[p][k]: cost of the k cheapest candidates of p
+
+	// Per formation: cands holds the locked players first, then the free
+	// candidates that can still improve on the incumbent; see order.
+	cands     []Candidate
+	posOf     []int
+	remain    [][]int // remain[p][i]: candidates of p at index >= i
+	startNeed []int
+	benchNeed []int
+	lambda    float64
+	startKey  [][]int // candidates of p by points - lambda*cost
+	benchKey  [][]int // candidates of p by w*points - lambda*cost
+
+	role    []int
+	placed  [][]int // assigned candidates per position
+	starts  []int
+	benches []int
+	clubs   map[int]int
+	cost    int
+	value   float64
+
+	nodes     int
+	limit     int
+	truncated bool
+
+	found     bool
+	bestStart []Candidate
+	bestBench []Candidate
+	bestCost  int
+	bestVal   float64
+}
+
+func newSolver(candidates []Candidate, rules Rules, opts Options) (*solver, error) {
+	s := &solver{
+		rules: rules,
+		opts:  opts,
+		pos:   make(map[int]int),
+		clubs: make(map[int]int),
+	}
+
+	for t := range rules.Positions {
+		s.types = append(s.types, t)
+	}
+	sort.Ints(s.types)
+	for i, t := range s.types {
+		s.pos[t] = i
+		s.quota = append(s.quota, rules.Positions[t])
+	}
+
+	banned := make(map[int]bool, len(opts.Banned))
+	for _, id := range opts.Banned {
+		banned[id] = true
+	}
+	isLocked := make(map[int]bool, len(opts.Locked))
+	for _, id := range opts.Locked {
+		if banned[id] {
+			return nil, fmt.Errorf("player %d is both locked and banned", id)
+		}
+		isLocked[id] = true
+	}
+
+	var free []Candidate
+	seen := make(map[int]bool, len(candidates))
+	for _, c := range candidates {
+		if _, ok := s.pos[c.ElementType]; !ok || seen[c.PlayerID] || banned[c.PlayerID] {
+			continue
+		}
+		seen[c.PlayerID] = true
+
+		if isLocked[c.PlayerID] {
+			s.locked = append(s.locked, c)
+		} else {
+			free = append(free, c)
+		}
+	}
+	for id := range isLocked {
+		if !seen[id] {
+			return nil, fmt.Errorf("locked player %d is not a candidate", id)
+		}
+	}
+	if err := s.checkLocked(); err != nil {
+		return nil, err
+	}
+
+	s.pool = s.prune(free)
+
+	costs := make([][]int, len(s.types))
+	for _, c := range append(append([]Candidate(nil), s.locked...), s.pool...) {
+		p := s.pos[c.ElementType]
+		costs[p] = append(costs[p], c.Cost)
+	}
+	s.cheapTo = make([][]int, len(s.types))
+	for p := range s.types {
+		sort.Ints(costs[p])
+		s.cheapTo[p] = make([]int, len(costs[p])+1)
+		for k, c := range costs[p] {
+			s.cheapTo[p][k+1] = s.cheapTo[p][k] + c
+		}
+	}
+
+	return s, nil
+}
+
+func (s *solver) checkLocked() error {
+	count := make([]int, len(s.types))
+	clubs := make(map[int]int)
+	cost := 0
+	for _, c := range s.locked {
+		p := s.pos[c.ElementType]
+		if count[p]++; count[p] > s.quota[p].Select {
+			return fmt.Errorf("locked players exceed the quota of element type %d", c.ElementType)
+		}
+		if clubs[c.TeamID]++; clubs[c.TeamID] > s.rules.TeamLimit {
+			return fmt.Errorf("locked players exceed the limit for team %d", c.TeamID)
+		}
+		cost += c.Cost
+	}
+	if cost > s.rules.Budget {
+		return fmt.Errorf("locked players cost %d, over the budget of %d", cost, s.rules.Budget)
+	}
+	return nil
+}
+
+// prune drops candidates that are dominated, within their position, by
+// players from more clubs than an optimal squad can have blocked: at most
+// Select-1 dominators can already be in the squad and at most
+// (SquadSize-1)/TeamLimit other clubs can be full.
+func (s *solver) prune(cands []Candidate) []Candidate {
+	blocked := (s.rules.SquadSize - 1) / s.rules.TeamLimit
+
+	var kept []Candidate
+	for _, c := range cands {
+		need := s.quota[s.pos[c.ElementType]].Select + blocked
+
+		teams := make(map[int]bool)
+		for _, d := range cands {
+			if d.ElementType == c.ElementType && dominates(d, c) {
+				teams[d.TeamID] = true
+				if len(teams) >= need {
+					break
+				}
+			}
+		}
+		if len(teams) < need {
+			kept = append(kept, c)
+		}
+	}
+
+	return kept
+}
+
+// dominates reports whether a is at least as cheap and as good as b, with
+// ties broken by player id so two identical players never drop each other.
+func dominates(a, b Candidate) bool {
+	if a.PlayerID == b.PlayerID || a.Cost > b.Cost || a.Points < b.Points {
+		return false
+	}
+	return a.Cost < b.Cost || a.Points > b.Points || a.PlayerID < b.PlayerID
+}
+
+// localSearch seeds the incumbent: the cheapest legal squad, improved by the
+// best single swap until no swap helps.
+func (s *solver) localSearch() {
+	squad := append([]Candidate(nil), s.locked...)
+	inSquad := make(map[int]bool)
+	clubs := make(map[int]int)
+	count := make([]int, len(s.types))
+	cost := 0
+	for _, c := range squad {
+		inSquad[c.PlayerID] = true
+		clubs[c.TeamID]++
+		count[s.pos[c.ElementType]]++
+		cost += c.Cost
+	}
+
+	byCost := append([]Candidate(nil), s.pool...)
+	sort.SliceStable(byCost, func(i, j int) bool { return byCost[i].Cost < byCost[j].Cost })
+	for _, c := range byCost {
+		p := s.pos[c.ElementType]
+		if count[p] < s.quota[p].Select && clubs[c.TeamID] < s.rules.TeamLimit {
+			squad = append(squad, c)
+			inSquad[c.PlayerID] = true
+			clubs[c.TeamID]++
+			count[p]++
+			cost += c.Cost
+		}
+	}
+	if len(squad) != s.rules.SquadSize || cost > s.rules.Budget {
+		return
+	}
+
+	_, _, value := s.lineup(squad)
+	for {
+		bestOut, bestIn, bestVal := -1, Candidate{}, value
+		for k := len(s.locked); k < len(squad); k++ {
+			out := squad[k]
+			for _, c := range s.pool {
+				if c.ElementType != out.ElementType || inSquad[c.PlayerID] || cost-out.Cost+c.Cost > s.rules.Budget {
+					continue
+				}
+				if c.TeamID != out.TeamID && clubs[c.TeamID] >= s.rules.TeamLimit {
+					continue
+				}
+
+				squad[k] = c
+				if _, _, v := s.lineup(squad); v > bestVal+epsilon {
+					bestOut, bestIn, bestVal = k, c, v
+				}
+				squad[k] = out
+			}
+		}
+		if bestOut < 0 {
+			break
+		}
+
+		out := squad[bestOut]
+		delete(inSquad, out.PlayerID)
+		clubs[out.TeamID]--
+		inSquad[bestIn.PlayerID] = true
+		clubs[bestIn.TeamID]++
+		cost += bestIn.Cost - out.Cost
+		squad[bestOut] = bestIn
+		value = bestVal
+	}
+
+	s.found = true
+	s.bestStart, s.bestBench, s.bestVal = s.lineup(squad)
+	s.bestCost = cost
+}
+
+func (s *solver) lineup(squad []Candidate) ([]Candidate, []Candidate, float64) {
+	return Lineup(squad, s.rules, s.opts.BenchWeight)
+}
+
+// formations lists every legal number of starters per position.
+func (s *solver) formations() [][]int {
+	var out [][]int
+	var walk func(p, left int, cur []int)
+	walk = func(p, left int, cur []int) {
+		if p == len(s.types) {
+			if left == 0 {
+				out = append(out, append([]int(nil), cur...))
+			}
+			return
+		}
+		for n := s.quota[p].MinPlay; n <= s.quota[p].MaxPlay && n <= left; n++ {
+			walk(p+1, left-n, append(cur, n))
+		}
+	}
+	walk(0, s.rules.SquadPlay, nil)
+	return out
+}
+
+func (s *solver) solve(formation []int) {
+	s.startNeed = formation
+	s.benchNeed = make([]int, len(s.types))
+	for p := range s.types {
+		s.benchNeed[p] = s.quota[p].Select - formation[p]
+	}
+	s.placed = make([][]int, len(s.types))
+	s.starts = make([]int, len(s.types))
+	s.benches = make([]int, len(s.types))
+
+	s.cands = append(append([]Candidate(nil), s.locked...), s.pool...)
+	s.order()
+	s.setLambda(s.bestLambda())
+
+	root, ok := s.bound(0)
+	if !ok || (s.found && root <= s.bestVal+epsilon) {
+		return
+	}
+
+	s.cands = append(append([]Candidate(nil), s.locked...), s.fix(root)...)
+	s.order()
+	s.setLambda(s.lambda)
+	s.search(0)
+}
+
+// fix keeps the free candidates that could be part of a better squad than the
+// incumbent. Forcing a candidate into a slot replaces the worst candidate the
+// root bound picked for it, so the bound drops by the difference of their keys.
+func (s *solver) fix(root float64) []Candidate {
+	if !s.found {
+		return s.pool
+	}
+
+	startCut := make([]float64, len(s.types))
+	benchCut := make([]float64, len(s.types))
+	for p := range s.types {
+		if ns := s.startNeed[p]; ns > 0 {
+			startCut[p] = s.startValue(s.cands[s.startKey[p][ns-1]])
+		}
+		if nb := s.benchNeed[p]; nb > 0 {
+			benchCut[p] = s.benchValue(s.cands[s.benchKey[p][nb-1]])
+		}
+	}
+
+	var kept []Candidate
+	for _, c := range s.pool {
+		p := s.pos[c.ElementType]
+		asStarter := s.startNeed[p] > 0 && root-startCut[p]+s.startValue(c) > s.bestVal+epsilon
+		asBench := s.benchNeed[p] > 0 && root-benchCut[p]+s.benchValue(c) > s.bestVal+epsilon
+		if asStarter || asBench {
+			kept = append(kept, c)
+		}
+	}
+
+	return kept
+}
+
+// order sorts the free candidates by their value net of the budget
+// multiplier, so the search meets good squads first, and rebuilds the
+// per-position indexes.
+func (s *solver) order() {
+	free := s.cands[len(s.locked):]
+	sort.SliceStable(free, func(i, j int) bool {
+		a, b := s.startValue(free[i]), s.startValue(free[j])
+		if a != b {
+			return a > b
+		}
+		return free[i].Points > free[j].Points
+	})
+
+	s.role = make([]int, len(s.cands))
+	s.posOf = make([]int, len(s.cands))
+	for i, c := range s.cands {
+		s.posOf[i] = s.pos[c.ElementType]
+	}
+
+	s.remain = make([][]int, len(s.types))
+	for p := range s.types {
+		s.remain[p] = make([]int, len(s.cands)+1)
+		for i := len(s.cands) - 1; i >= 0; i-- {
+			s.remain[p][i] = s.remain[p][i+1]
+			if s.posOf[i] == p {
+				s.remain[p][i]++
+			}
+		}
+	}
+}
+
+func (s *solver) startValue(c Candidate) float64 {
+	return c.Points - s.lambda*float64(c.Cost)
+}
+
+func (s *solver) benchValue(c Candidate) float64 {
+	return s.opts.BenchWeight*c.Points - s.lambda*float64(c.Cost)
+}
+
+func (s *solver) setLambda(lambda float64) {
+	s.lambda = lambda
+	s.startKey = s.orderBy(s.startValue)
+	s.benchKey = s.orderBy(s.benchValue)
+}
+
+func (s *solver) orderBy(key func(Candidate) float64) [][]int {
+	out := make([][]int, len(s.types))
+	for i := range s.cands {
+		out[s.posOf[i]] = append(out[s.posOf[i]], i)
+	}
+	for p := range out {
+		sort.SliceStable(out[p], func(a, b int) bool {
+			return key(s.cands[out[p][a]]) > key(s.cands[out[p][b]])
+		})
+	}
+	return out
+}
+
+// bestLambda minimises the root bound, which is convex in lambda, by ternary
+// search. Filling every slot is forced, so the minimum can lie above the best
+// points-per-cost ratio; the range is doubled until the bound turns upwards.
+func (s *solver) bestLambda() float64 {
+	rootBound := func(lambda float64) float64 {
+		s.setLambda(lambda)
+		b, _ := s.bound(0)
+		return b
+	}
+
+	hi := 1.0 / 64
+	for prev := rootBound(0); hi < 1e6; hi *= 2 {
+		next := rootBound(hi)
+		if next >= prev {
+			break
+		}
+		prev = next
+	}
+	hi *= 2
+
+	lo := 0.0
+	for range 60 {
+		m1, m2 := lo+(hi-lo)/3, hi-(hi-lo)/3
+		if rootBound(m1) <= rootBound(m2) {
+			hi = m2
+		} else {
+			lo = m1
+		}
+	}
+
+	return (lo + hi) / 2
+}
+
+func (s *solver) search(i int) {
+	s.nodes++
+	if s.nodes > s.limit {
+		s.truncated = true
+		return
+	}
+
+	if i >= len(s.locked) && s.full() {
+		if !s.found || s.value > s.bestVal+epsilon {
+			s.keep()
+		}
+		return
+	}
+	if i == len(s.cands) {
+		return
+	}
+
+	bound, ok := s.bound(i)
+	if !ok || (s.found && bound <= s.bestVal+epsilon) {
+		return
+	}
+
+	c := s.cands[i]
+	p := s.posOf[i]
+
+	if s.clubs[c.TeamID] < s.rules.TeamLimit {
+		if s.starts[p] < s.startNeed[p] && s.canStart(p, c) {
+			s.assign(i, roleStart)
+			if s.fits() {
+				s.search(i + 1)
+			}
+			s.unassign(i)
+		}
+		if s.benches[p] < s.benchNeed[p] && s.canBench(p, c) {
+			s.assign(i, roleBench)
+			if s.fits() {
+				s.search(i + 1)
+			}
+			s.unassign(i)
+		}
+	}
+
+	if i >= len(s.locked) {
+		s.search(i + 1)
+	}
+}
+
+func (s *solver) keep() {
+	s.found = true
+	s.bestStart, s.bestBench = s.bestStart[:0], s.bestBench[:0]
+	for i, role := range s.role {
+		switch role {
+		case roleStart:
+			s.bestStart = append(s.bestStart, s.cands[i])
+		case roleBench:
+			s.bestBench = append(s.bestBench, s.cands[i])
+		}
+	}
+	s.bestCost = s.cost
+	s.bestVal = s.value
+}
+
+// canStart and canBench keep starters above the bench within a position:
+// swapping a starter with a better benched player never lowers the value, so
+// some optimal squad is always ordered that way.
+func (s *solver) canStart(p int, c Candidate) bool {
+	for _, i := range s.placed[p] {
+		if s.role[i] == roleBench && s.cands[i].Points > c.Points {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *solver) canBench(p int, c Candidate) bool {
+	for _, i := range s.placed[p] {
+		if s.role[i] == roleStart && s.cands[i].Points < c.Points {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *solver) full() bool {
+	for p := range s.types {
+		if s.starts[p] < s.startNeed[p] || s.benches[p] < s.benchNeed[p] {
+			return false
+		}
+	}
+	return true
+}
+
+// bound relaxes the budget with multiplier lambda and the club limit
+// entirely: each open slot takes the best remaining candidate for it, and a
+// candidate may fill both a starting and a bench slot. It is not ok when a
+// position can no longer be filled.
+func (s *solver) bound(i int) (float64, bool) {
+	bound := s.value + s.lambda*float64(s.rules.Budget-s.cost)
+
+	for p := range s.types {
+		ns := s.startNeed[p] - s.starts[p]
+		nb := s.benchNeed[p] - s.benches[p]
+		if s.remain[p][i] < ns+nb {
+			return 0, false
+		}
+		bound += s.topFrom(s.startKey[p], i, ns, s.startValue)
+		bound += s.topFrom(s.benchKey[p], i, nb, s.benchValue)
+	}
+
+	return bound, true
+}
+
+func (s *solver) topFrom(order []int, i, k int, key func(Candidate) float64) float64 {
+	var sum float64
+	for _, idx := range order {
+		if k == 0 {
+			break
+		}
+		if idx >= i {
+			sum += key(s.cands[idx])
+			k--
+		}
+	}
+	return sum
+}
+
+// fits reports whether the cheapest completion of the squad is within budget.
+func (s *solver) fits() bool {
+	cost := s.cost
+	for p := range s.types {
+		cost += s.cheapTo[p][s.startNeed[p]-s.starts[p]+s.benchNeed[p]-s.benches[p]]
+	}
+	return cost <= s.rules.Budget
+}
+
+func (s *solver) assign(i, role int) {
+	c := s.cands[i]
+	p := s.posOf[i]
+
+	s.role[i] = role
+	if role == roleStart {
+		s.starts[p]++
+		s.value += c.Points
+	} else {
+		s.benches[p]++
+		s.value += s.opts.BenchWeight * c.Points
+	}
+	s.placed[p] = append(s.placed[p], i)
+	s.clubs[c.TeamID]++
+	s.cost += c.Cost
+}
+
+func (s *solver) unassign(i int) {
+	c := s.cands[i]
+	p := s.posOf[i]
+
+	if s.role[i] == roleStart {
+		s.starts[p]--
+		s.value -= c.Points
+	} else {
+		s.benches[p]--
+		s.value -= s.opts.BenchWeight * c.Points
+	}
+	s.placed[p] = s.placed[p][:len(s.placed[p])-1]
+	s.role[i] = roleNone
+	s.clubs[c.TeamID]--
+	s.cost -= c.Cost
+}
+
+func (s *solver) result() *Result {
+	res := &Result{
+		Starters: append([]Candidate(nil), s.bestStart...),
+		Cost:     s.bestCost,
+		Value:    s.bestVal,
+		Optimal:  !s.truncated,
+		Nodes:    s.nodes,
+	}
+
+	for _, c := range res.Starters {
+		res.Points += c.Points
+	}
+	sort.SliceStable(res.Starters, func(i, j int) bool {
+		a, b := res.Starters[i], res.Starters[j]
+		if a.ElementType != b.ElementType {
+			return a.ElementType < b.ElementType
+		}
+		return a.Points > b.Points
+	})
+
+	// The bench goalkeeper comes first, then outfielders by points.
+	res.Bench = append([]Candidate(nil), s.bestBench...)
+	sort.SliceStable(res.Bench, func(i, j int) bool {
+		a, b := res.Bench[i], res.Bench[j]
+		ak, bk := s.quota[s.pos[a.ElementType]].MaxPlay == 1, s.quota[s.pos[b.ElementType]].MaxPlay == 1
+		if ak != bk {
+			return ak
+		}
+		return a.Points > b.Points
+	})
+
+	return res
+}
@@ -0,0 +1,72 @@
+package squad
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// LoadPositions reads the position quotas from element_types.
+func (r *Repo) LoadPositions(ctx context.Context) (map[int]Position, error) {
+	rows, err := r.db.QueryContext(ctx, `SELECT id, squad_select, squad_min_play, squad_max_play FROM element_types`)
+	if err != nil {
+		return nil, fmt.Errorf("querying element_types: %w", err)
+	}
+	defer rows.Close()
+
+	positions := make(map[int]Position)
+	for rows.Next() {
+		var p Position
+		if err := rows.Scan(&p.ElementType, &p.Select, &p.MinPlay, &p.MaxPlay); err != nil {
+			return nil, fmt.Errorf("scanning element_types: %w", err)
+		}
+		positions[p.ElementType] = p
+	}
+
+	return positions, rows.Err()
+}
+
+// LoadCandidates returns every selectable player of a season with their current
+// price and projected points over gameweeks [fromEvent, toEvent] for a
+// model version. Players who left the league (status 'u') are skipped.
+func (r *Repo) LoadCandidates(ctx context.Context, seasonID int, modelVersion string, fromEvent, toEvent int) ([]Candidate, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.player_id, COALESCE(p.web_name, ''), p.team_id, p.element_type_id,
+		       COALESCE(p.status, 'a'), COALESCE(c.now_cost, 0), COALESCE(SUM(g.x_points), 0)
+		FROM players p
+		LEFT JOIN player_costs c ON c.player_id = p.player_id AND c.season_id = p.season_id
+		LEFT JOIN player_projection_gameweeks g
+		       ON g.player_id = p.player_id AND g.season_id = p.season_id
+		      AND g.model_version = $2 AND g.event BETWEEN $3 AND $4
+		WHERE p.season_id = $1
+		  AND NOT COALESCE(p.removed, FALSE)
+		  AND COALESCE(p.can_select, TRUE)
+		  AND COALESCE(p.status, 'a') <> 'u'
+		  AND p.element_type_id BETWEEN 1 AND 4
+		GROUP BY p.player_id, p.web_name, p.team_id, p.element_type_id, p.status, c.now_cost`,
+		seasonID, modelVersion, fromEvent, toEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying candidates: %w", err)
+	}
+	defer rows.Close()
+
+	var candidates []Candidate
+	for rows.Next() {
+		var c Candidate
+		if err := rows.Scan(&c.PlayerID, &c.Name, &c.TeamID, &c.ElementType, &c.Status, &c.Cost, &c.Points); err != nil {
+			return nil, fmt.Errorf("scanning candidates: %w", err)
+		}
+		candidates = append(candidates, c)
+	}
+
+	return candidates, rows.Err()
+}
@@ -0,0 +1,128 @@
+package squad
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+// Position is the squad quota of an element type, as in element_types.
+type Position struct {
+	ElementType int
+	// Select is the number of players of this type in the squad (squad_select).
+	Select int
+	// MinPlay and MaxPlay bound the number of starters (squad_min_play, squad_max_play).
+	MinPlay int
+	MaxPlay int
+}
+
+// Rules are the squad constraints of the game; the names follow GameSettings.
+type Rules struct {
+	SquadSize int
+	SquadPlay int
+	TeamLimit int
+	// Budget is in tenths of a million, like now_cost.
+	Budget    int
+	Positions map[int]Position
+}
+
+// DefaultRules mirrors the element_types seed and the current GameSettings.
+func DefaultRules() Rules {
+	return Rules{
+		SquadSize: 15,
+		SquadPlay: 11,
+		TeamLimit: 3,
+		Budget:    1000,
+		Positions: map[int]Position{
+			projection.Goalkeeper: {ElementType: projection.Goalkeeper, Select: 2, MinPlay: 1, MaxPlay: 1},
+			projection.Defender:   {ElementType: projection.Defender, Select: 5, MinPlay: 3, MaxPlay: 5},
+			projection.Midfielder: {ElementType: projection.Midfielder, Select: 5, MinPlay: 2, MaxPlay: 5},
+			projection.Forward:    {ElementType: projection.Forward, Select: 3, MinPlay: 1, MaxPlay: 3},
+		},
+	}
+}
+
+// Validate checks that the position quotas add up to the squad and XI sizes.
+func (r Rules) Validate() error {
+	var selected, minPlay, maxPlay int
+	for _, p := range r.Positions {
+		if p.MinPlay > p.MaxPlay || p.MaxPlay > p.Select {
+			return fmt.Errorf("element type %d: invalid quota %d/%d-%d", p.ElementType, p.Select, p.MinPlay, p.MaxPlay)
+		}
+		selected += p.Select
+		minPlay += p.MinPlay
+		maxPlay += p.MaxPlay
+	}
+
+	if selected != r.SquadSize {
+		return fmt.Errorf("position quotas select %d players, squad size is %d", selected, r.SquadSize)
+	}
+	if minPlay > r.SquadPlay || maxPlay < r.SquadPlay {
+		return fmt.Errorf("position quotas cannot field %d starters", r.SquadPlay)
+	}
+	if r.TeamLimit < 1 || r.Budget < 0 {
+		return fmt.Errorf("invalid team limit %d or budget %d", r.TeamLimit, r.Budget)
+	}
+
+	return nil
+}
+
+// Lineup splits a squad into its best XI and bench and returns the XI points
+// plus benchWeight times the bench points. The top MinPlay of every position
+// start, then the best remaining players up to MaxPlay until the XI is full.
+func Lineup(squad []Candidate, rules Rules, benchWeight float64) ([]Candidate, []Candidate, float64) {
+	types := make([]int, 0, len(rules.Positions))
+	for t := range rules.Positions {
+		types = append(types, t)
+	}
+	sort.Ints(types)
+
+	groups := make([][]Candidate, len(types))
+	for p, t := range types {
+		for _, c := range squad {
+			if c.ElementType == t {
+				groups[p] = append(groups[p], c)
+			}
+		}
+	}
+
+	starters := make([]int, len(groups))
+	slots := rules.SquadPlay
+	for p := range groups {
+		sort.SliceStable(groups[p], func(i, j int) bool { return groups[p][i].Points > groups[p][j].Points })
+		starters[p] = min(rules.Positions[types[p]].MinPlay, len(groups[p]))
+		slots -= starters[p]
+	}
+	for ; slots > 0; slots-- {
+		best := -1
+		for p, group := range groups {
+			if starters[p] >= rules.Positions[types[p]].MaxPlay || starters[p] >= len(group) {
+				continue
+			}
+			if best < 0 || group[starters[p]].Points > groups[best][starters[best]].Points {
+				best = p
+			}
+		}
+		if best < 0 {
+			break
+		}
+		starters[best]++
+	}
+
+	var start, bench []Candidate
+	var value float64
+	for p, group := range groups {
+		start = append(start, group[:starters[p]]...)
+		bench = append(bench, group[starters[p]:]...)
+		for k, c := range group {
+			if k < starters[p] {
+				value += c.Points
+			} else {
+				value += benchWeight * c.Points
+			}
+		}
+	}
+
+	return start, bench, value
+}
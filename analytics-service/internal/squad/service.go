@@ -0,0 +1,65 @@
+package squad
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+type Service struct {
+	Repo        *Repo
+	Projections *projection.Repo
+	// ModelVersion selects the stored projections to optimise on.
+	ModelVersion string
+}
+
+// Build optimises a squad on the projected points of gameweeks
+// [fromEvent, fromEvent+horizon). A fromEvent of 0 means the next unfinished
+// gameweek. Position quotas come from element_types when it is seeded.
+func (s *Service) Build(ctx context.Context, seasonID, fromEvent, horizon int, rules Rules, opts Options) (*Result, error) {
+	if fromEvent == 0 {
+		next, err := s.Projections.NextEvent(ctx, seasonID)
+		if err != nil {
+			return nil, err
+		}
+		fromEvent = next
+	}
+	toEvent := fromEvent + horizon - 1
+
+	positions, err := s.Repo.LoadPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(positions) > 0 {
+		rules.Positions = positions
+	}
+
+	candidates, err := s.Repo.LoadCandidates(ctx, seasonID, s.ModelVersion, fromEvent, toEvent)
+	if err != nil {
+		return nil, err
+	}
+	if !hasProjections(candidates) {
+		return nil, fmt.Errorf("no %s projections for season %d GW%d-%d", s.ModelVersion, seasonID, fromEvent, toEvent)
+	}
+
+	result, err := Optimise(candidates, rules, opts)
+	if err != nil {
+		return nil, fmt.Errorf("optimising squad: %w", err)
+	}
+
+	log.Printf("Optimised squad for season %d GW%d-%d: %.2f points, cost %d, optimal=%t (%d nodes)",
+		seasonID, fromEvent, toEvent, result.Points, result.Cost, result.Optimal, result.Nodes)
+
+	return result, nil
+}
+
+func hasProjections(candidates []Candidate) bool {
+	for _, c := range candidates {
+		if c.Points != 0 {
+			return true
+		}
+	}
+	return false
+}
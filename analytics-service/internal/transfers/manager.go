@@ -0,0 +1,82 @@
+package transfers
+
+import "math"
+
+// Chip names as stored in manager_chips.
+const (
+	ChipWildcard      = "wildcard"
+	ChipFreeHit       = "freehit"
+	ChipBenchBoost    = "bboost"
+	ChipTripleCaptain = "3xc"
+)
+
+// Rules are the transfer rules of the game; SellOnFee follows
+// GameSettings.transfers_sell_on_fee.
+type Rules struct {
+	MaxFreeTransfers int
+	HitCost          int
+	SellOnFee        float64
+}
+
+func DefaultRules() Rules {
+	return Rules{
+		MaxFreeTransfers: 5,
+		HitCost:          4,
+		SellOnFee:        0.5,
+	}
+}
+
+// Holding is a player in a manager's squad and their purchase price.
+type Holding struct {
+	PlayerID      int
+	PurchasePrice int
+}
+
+// Manager is a manager's position going into the next gameweek.
+type Manager struct {
+	ManagerID int
+	SeasonID  int
+	// Event is the gameweek the squad was picked for.
+	Event         int
+	Holdings      []Holding
+	Bank          int
+	FreeTransfers int
+}
+
+// GameweekRecord is one row of manager_gameweek_history with the chip played.
+type GameweekRecord struct {
+	Event     int
+	Transfers int
+	Bank      int
+	Chip      string
+}
+
+// SellingPrice applies the sell-on fee to the profit on a player, rounded down
+// to the nearest tenth; a loss is taken in full.
+func SellingPrice(purchase, now int, sellOnFee float64) int {
+	if now <= purchase {
+		return now
+	}
+	return purchase + int(math.Floor(float64(now-purchase)*(1-sellOnFee)+1e-9))
+}
+
+// FreeTransfers replays a manager's history, oldest first, and returns the
+// free transfers available for the gameweek after the last record. The first
+// gameweek is free and leaves one transfer; after that every gameweek adds one
+// up to the cap, minus the transfers made. Wildcard and Free Hit weeks keep
+// the saved transfers.
+func FreeTransfers(history []GameweekRecord, rules Rules) int {
+	if len(history) == 0 {
+		return 0
+	}
+
+	free := 1
+	for _, gw := range history[1:] {
+		if gw.Chip != ChipWildcard && gw.Chip != ChipFreeHit {
+			free = max(free-gw.Transfers, 0)
+		}
+		free = min(free+1, rules.MaxFreeTransfers)
+	}
+
+	return free
+}
@@ -0,0 +1,356 @@
+package transfers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+)
+
+type Options struct {
+	// MaxPerEvent caps the transfers considered in a single gameweek.
+	MaxPerEvent int
+	// BeamWidth is the number of partial plans kept after each gameweek.
+	BeamWidth int
+	// InsPerOut is the number of replacements tried for each player sold.
+	InsPerOut int
+	// Plans is the number of plans returned.
+	Plans       int
+	BenchWeight float64
+}
+
+func DefaultOptions() Options {
+	return Options{
+		MaxPerEvent: 2,
+		BeamWidth:   50,
+		InsPerOut:   5,
+		Plans:       5,
+		BenchWeight: squad.DefaultBenchWeight,
+	}
+}
+
+type Transfer struct {
+	Out       squad.Candidate
+	In        squad.Candidate
+	SellPrice int
+}
+
+// Step is what a plan does in one gameweek.
+type Step struct {
+	Event     int
+	Transfers []Transfer
+	// FreeTransfers is what was available before the transfers.
+	FreeTransfers int
+	HitCost       int
+	Bank          int
+	// Points is the expected points of the squad in the gameweek, captain
+	// included and hits excluded.
+	Points float64
+}
+
+type Plan struct {
+	Steps []Step
+	// Points is the expected points over the horizon net of hits; Gain is the
+	// difference with making no transfers at all.
+	Points  float64
+	Gain    float64
+	HitCost int
+}
+
+// Market is what the planner knows about players: current price, club and
+// position, and projected points per gameweek.
+type Market struct {
+	Players map[int]squad.Candidate
+	Points  map[int]map[int]float64
+	Events  []int
+}
+
+// Planner searches transfer sequences with a beam search: each partial plan
+// is scored by its points so far plus what its squad would score over the
+// rest of the horizon without further transfers.
+type Planner struct {
+	Squad   squad.Rules
+	Rules   Rules
+	Options Options
+}
+
+type holding struct {
+	player squad.Candidate
+	sell   int
+}
+
+type state struct {
+	squad []holding
+	bank  int
+	free  int
+	net   float64
+	hits  int
+	steps []Step
+	score float64
+}
+
+type move struct {
+	transfers []Transfer
+	delta     float64
+}
+
+// Plan returns the best plans for a manager, best first.
+func (p *Planner) Plan(m *Manager, market Market) ([]Plan, error) {
+	if len(market.Events) == 0 {
+		return nil, fmt.Errorf("no gameweeks to plan")
+	}
+
+	start := &state{bank: m.Bank, free: m.FreeTransfers}
+	for _, h := range m.Holdings {
+		player, ok := market.Players[h.PlayerID]
+		if !ok {
+			return nil, fmt.Errorf("player %d of manager %d has no market data", h.PlayerID, m.ManagerID)
+		}
+		start.squad = append(start.squad, holding{
+			player: player,
+			sell:   SellingPrice(h.PurchasePrice, player.Cost, p.Rules.SellOnFee),
+		})
+	}
+	if len(start.squad) != p.Squad.SquadSize {
+		return nil, fmt.Errorf("manager %d has %d players, want %d", m.ManagerID, len(start.squad), p.Squad.SquadSize)
+	}
+
+	beam := []*state{start}
+	for idx, event := range market.Events {
+		// Paths that reach the same squad, bank and free transfers only differ
+		// in points so far; keep the best of them.
+		var next []*state
+		seen := make(map[string]int)
+
+		for _, s := range beam {
+			for _, mv := range p.moves(s, market, idx) {
+				n := p.apply(s, mv, market, idx, event)
+				key := n.key()
+				if k, ok := seen[key]; ok {
+					if n.score > next[k].score {
+						next[k] = n
+					}
+					continue
+				}
+				seen[key] = len(next)
+				next = append(next, n)
+			}
+		}
+
+		sort.SliceStable(next, func(i, j int) bool { return next[i].score > next[j].score })
+		if len(next) > p.Options.BeamWidth {
+			next = next[:p.Options.BeamWidth]
+		}
+		beam = next
+	}
+
+	baseline := start
+	for idx, event := range market.Events {
+		baseline = p.apply(baseline, move{}, market, idx, event)
+	}
+
+	plans := make([]Plan, 0, len(beam))
+	for _, s := range beam {
+		plans = append(plans, Plan{
+			Steps:   s.steps,
+			Points:  s.net,
+			Gain:    s.net - baseline.net,
+			HitCost: s.hits,
+		})
+	}
+	sort.SliceStable(plans, func(i, j int) bool { return plans[i].Points > plans[j].Points })
+	if len(plans) > p.Options.Plans {
+		plans = plans[:p.Options.Plans]
+	}
+
+	return plans, nil
+}
+
+// moves lists rolling the transfer, the best single transfers and pairs of
+// them. Singles are ranked by the points they add over the rest of the horizon.
+func (p *Planner) moves(s *state, market Market, idx int) []move {
+	moves := []move{{}}
+	if p.Options.MaxPerEvent == 0 {
+		return moves
+	}
+
+	inSquad := make(map[int]bool, len(s.squad))
+	clubs := make(map[int]int)
+	for _, h := range s.squad {
+		inSquad[h.player.PlayerID] = true
+		clubs[h.player.TeamID]++
+	}
+
+	remaining := func(id int) float64 {
+		var sum float64
+		for _, event := range market.Events[idx:] {
+			sum += market.Points[id][event]
+		}
+		return sum
+	}
+
+	byValue := make([]squad.Candidate, 0, len(market.Players))
+	for _, c := range market.Players {
+		if !inSquad[c.PlayerID] {
+			byValue = append(byValue, c)
+		}
+	}
+	value := make(map[int]float64, len(byValue))
+	for _, c := range byValue {
+		value[c.PlayerID] = remaining(c.PlayerID)
+	}
+	sort.SliceStable(byValue, func(i, j int) bool {
+		if value[byValue[i].PlayerID] != value[byValue[j].PlayerID] {
+			return value[byValue[i].PlayerID] > value[byValue[j].PlayerID]
+		}
+		return byValue[i].PlayerID < byValue[j].PlayerID
+	})
+
+	var singles []move
+	for _, h := range s.squad {
+		out := h.player
+		found := 0
+		for _, in := range byValue {
+			if found == p.Options.InsPerOut {
+				break
+			}
+			if in.ElementType != out.ElementType || in.Cost > s.bank+h.sell {
+				continue
+			}
+			if in.TeamID != out.TeamID && clubs[in.TeamID] >= p.Squad.TeamLimit {
+				continue
+			}
+			singles = append(singles, move{
+				transfers: []Transfer{{Out: out, In: in, SellPrice: h.sell}},
+				delta:     value[in.PlayerID] - remaining(out.PlayerID),
+			})
+			found++
+		}
+	}
+	sort.SliceStable(singles, func(i, j int) bool { return singles[i].delta > singles[j].delta })
+	moves = append(moves, singles...)
+
+	if p.Options.MaxPerEvent < 2 {
+		return moves
+	}
+
+	// Pairs are built from the best singles only; the budget and club limit
+	// are checked on the pair as a whole.
+	top := singles[:min(len(singles), 4*p.Options.InsPerOut)]
+	for i := range top {
+		for j := i + 1; j < len(top); j++ {
+			a, b := top[i].transfers[0], top[j].transfers[0]
+			if a.Out.PlayerID == b.Out.PlayerID || a.In.PlayerID == b.In.PlayerID {
+				continue
+			}
+			if a.In.Cost+b.In.Cost > s.bank+a.SellPrice+b.SellPrice {
+				continue
+			}
+			if !p.clubsAllow(clubs, a, b) {
+				continue
+			}
+			moves = append(moves, move{
+				transfers: []Transfer{a, b},
+				delta:     top[i].delta + top[j].delta,
+			})
+		}
+	}
+
+	return moves
+}
+
+func (p *Planner) clubsAllow(clubs map[int]int, transfers ...Transfer) bool {
+	after := make(map[int]int)
+	for _, t := range transfers {
+		after[t.Out.TeamID]--
+		after[t.In.TeamID]++
+	}
+	for team, d := range after {
+		if clubs[team]+d > p.Squad.TeamLimit {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Planner) apply(s *state, mv move, market Market, idx, event int) *state {
+	n := &state{
+		squad: append([]holding(nil), s.squad...),
+		bank:  s.bank,
+		net:   s.net,
+		hits:  s.hits,
+		steps: append([]Step(nil), s.steps...),
+	}
+
+	for _, t := range mv.transfers {
+		for k, h := range n.squad {
+			if h.player.PlayerID == t.Out.PlayerID {
+				// Prices are assumed flat, so a player bought in the plan sells
+				// for what it cost.
+				n.squad[k] = holding{player: t.In, sell: t.In.Cost}
+				break
+			}
+		}
+		n.bank += t.SellPrice - t.In.Cost
+	}
+
+	made := len(mv.transfers)
+	hitCost := max(made-s.free, 0) * p.Rules.HitCost
+	n.free = min(max(s.free-made, 0)+1, p.Rules.MaxFreeTransfers)
+
+	points := p.gameweekPoints(n.squad, market, event)
+	n.net += points - float64(hitCost)
+	n.hits += hitCost
+	n.steps = append(n.steps, Step{
+		Event:         event,
+		Transfers:     mv.transfers,
+		FreeTransfers: s.free,
+		HitCost:       hitCost,
+		Bank:          n.bank,
+		Points:        points,
+	})
+
+	n.score = n.net
+	for _, later := range market.Events[idx+1:] {
+		n.score += p.gameweekPoints(n.squad, market, later)
+	}
+
+	return n
+}
+
+// gameweekPoints is the value of the best XI of a squad in a gameweek with
+// the best starter as captain.
+func (p *Planner) gameweekPoints(holdings []holding, market Market, event int) float64 {
+	players := make([]squad.Candidate, len(holdings))
+	for k, h := range holdings {
+		players[k] = h.player
+		players[k].Points = market.Points[h.player.PlayerID][event]
+	}
+
+	starters, _, value := squad.Lineup(players, p.Squad, p.Options.BenchWeight)
+
+	var captain float64
+	for _, c := range starters {
+		captain = max(captain, c.Points)
+	}
+
+	return value + captain
+}
+
+func (s *state) key() string {
+	ids := make([]int, len(s.squad))
+	for k, h := range s.squad {
+		ids[k] = h.player.PlayerID
+	}
+	sort.Ints(ids)
+
+	var b strings.Builder
+	for _, id := range ids {
+		b.WriteString(strconv.Itoa(id))
+		b.WriteByte(',')
+	}
+	fmt.Fprintf(&b, "%d/%d", s.bank, s.free)
+	return b.String()
+}
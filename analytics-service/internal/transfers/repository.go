@@ -0,0 +1,182 @@
+package transfers
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+	"github.com/lib/pq"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// LoadHistory returns a manager's gameweeks of a season, oldest first, with
+// the chip played in each.
+func (r *Repo) LoadHistory(ctx context.Context, managerID, seasonID int) ([]GameweekRecord, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT h.event, COALESCE(h.event_transfers, 0), COALESCE(h.bank, 0), COALESCE(c.chip_name, '')
+		FROM manager_gameweek_history h
+		LEFT JOIN manager_chips c
+		       ON c.manager_id = h.manager_id AND c.season_id = h.season_id AND c.event = h.event
+		WHERE h.manager_id = $1 AND h.season_id = $2
+		ORDER BY h.event`,
+		managerID, seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying manager_gameweek_history: %w", err)
+	}
+	defer rows.Close()
+
+	var history []GameweekRecord
+	for rows.Next() {
+		var gw GameweekRecord
+		if err := rows.Scan(&gw.Event, &gw.Transfers, &gw.Bank, &gw.Chip); err != nil {
+			return nil, fmt.Errorf("scanning manager_gameweek_history: %w", err)
+		}
+		history = append(history, gw)
+	}
+
+	return history, rows.Err()
+}
+
+// LoadManager reconstructs a manager's squad, purchase prices, bank and free
+// transfers after their last recorded gameweek. A Free Hit squad reverts, so
+// when the last gameweek was a Free Hit the squad and bank of the one before
+// are used.
+func (r *Repo) LoadManager(ctx context.Context, managerID, seasonID int, rules Rules) (*Manager, error) {
+	history, err := r.LoadHistory(ctx, managerID, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		return nil, fmt.Errorf("manager %d has no history in season %d", managerID, seasonID)
+	}
+
+	squadGW := history[len(history)-1]
+	if squadGW.Chip == ChipFreeHit && len(history) > 1 {
+		squadGW = history[len(history)-2]
+	}
+
+	m := &Manager{
+		ManagerID:     managerID,
+		SeasonID:      seasonID,
+		Event:         squadGW.Event,
+		Bank:          squadGW.Bank,
+		FreeTransfers: FreeTransfers(history, rules),
+	}
+	if m.Holdings, err = r.loadHoldings(ctx, managerID, seasonID, squadGW.Event, history[0].Event); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// loadHoldings prices every pick at the last transfer that brought the player
+// in, outside Free Hit weeks. Players kept since the manager's first gameweek
+// are priced at their value that gameweek, or their current price if unknown.
+func (r *Repo) loadHoldings(ctx context.Context, managerID, seasonID, event, firstEvent int) ([]Holding, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT mp.player_id,
+		       COALESCE(
+		           (SELECT t.player_in_cost
+		            FROM manager_transfers t
+		            WHERE t.manager_id = mp.manager_id AND t.season_id = mp.season_id
+		              AND t.player_in_id = mp.player_id AND t.event <= mp.event
+		              AND t.event NOT IN (
+		                  SELECT c.event FROM manager_chips c
+		                  WHERE c.manager_id = mp.manager_id AND c.season_id = mp.season_id AND c.chip_name = $4)
+		            ORDER BY t.event DESC
+		            LIMIT 1),
+		           (SELECT MIN(g.value)
+		            FROM player_gameweek_stats g
+		            WHERE g.player_id = mp.player_id AND g.season_id = mp.season_id AND g.event = $5),
+		           (SELECT c.now_cost
+		            FROM player_costs c
+		            WHERE c.player_id = mp.player_id AND c.season_id = mp.season_id),
+		           0)
+		FROM manager_picks mp
+		WHERE mp.manager_id = $1 AND mp.season_id = $2 AND mp.event = $3
+		ORDER BY mp.position`,
+		managerID, seasonID, event, ChipFreeHit, firstEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying manager_picks: %w", err)
+	}
+	defer rows.Close()
+
+	var holdings []Holding
+	for rows.Next() {
+		var h Holding
+		if err := rows.Scan(&h.PlayerID, &h.PurchasePrice); err != nil {
+			return nil, fmt.Errorf("scanning manager_picks: %w", err)
+		}
+		holdings = append(holdings, h)
+	}
+
+	return holdings, rows.Err()
+}
+
+// LoadPlayers returns the current price, club and position of the given
+// players, whatever their status.
+func (r *Repo) LoadPlayers(ctx context.Context, seasonID int, ids []int) ([]squad.Candidate, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.player_id, COALESCE(p.web_name, ''), p.team_id, p.element_type_id,
+		       COALESCE(p.status, 'a'), COALESCE(c.now_cost, 0)
+		FROM players p
+		LEFT JOIN player_costs c ON c.player_id = p.player_id AND c.season_id = p.season_id
+		WHERE p.season_id = $1 AND p.player_id = ANY($2)`,
+		seasonID, pq.Array(ids),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying players: %w", err)
+	}
+	defer rows.Close()
+
+	var players []squad.Candidate
+	for rows.Next() {
+		var c squad.Candidate
+		if err := rows.Scan(&c.PlayerID, &c.Name, &c.TeamID, &c.ElementType, &c.Status, &c.Cost); err != nil {
+			return nil, fmt.Errorf("scanning players: %w", err)
+		}
+		players = append(players, c)
+	}
+
+	return players, rows.Err()
+}
+
+// LoadGameweekPoints returns projected points per player per gameweek of
+// [fromEvent, toEvent] for a model version.
+func (r *Repo) LoadGameweekPoints(ctx context.Context, seasonID int, modelVersion string, fromEvent, toEvent int) (map[int]map[int]float64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id, event, x_points
+		FROM player_projection_gameweeks
+		WHERE season_id = $1 AND model_version = $2 AND event BETWEEN $3 AND $4`,
+		seasonID, modelVersion, fromEvent, toEvent,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying player_projection_gameweeks: %w", err)
+	}
+	defer rows.Close()
+
+	points := make(map[int]map[int]float64)
+	for rows.Next() {
+		var playerID, event int
+		var x float64
+		if err := rows.Scan(&playerID, &event, &x); err != nil {
+			return nil, fmt.Errorf("scanning player_projection_gameweeks: %w", err)
+		}
+		if points[playerID] == nil {
+			points[playerID] = make(map[int]float64)
+		}
+		points[playerID][event] = x
+	}
+
+	return points, rows.Err()
+}
@@ -0,0 +1,98 @@
+package transfers
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+)
+
+type Service struct {
+	Repo         *Repo
+	Squads       *squad.Repo
+	Projections  *projection.Repo
+	ModelVersion string
+	Planner      *Planner
+}
+
+// PlanManager reconstructs a manager and plans their transfers over the next
+// horizon gameweeks from the stored projections.
+func (s *Service) PlanManager(ctx context.Context, managerID, seasonID, horizon int) (*Manager, []Plan, error) {
+	manager, err := s.Repo.LoadManager(ctx, managerID, seasonID, s.Planner.Rules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	from, err := s.Projections.NextEvent(ctx, seasonID)
+	if err != nil {
+		return nil, nil, err
+	}
+	to := from + horizon - 1
+
+	market, err := s.LoadMarket(ctx, manager, from, to)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	positions, err := s.Squads.LoadPositions(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	planner := *s.Planner
+	if len(positions) > 0 {
+		planner.Squad.Positions = positions
+	}
+
+	plans, err := planner.Plan(manager, market)
+	if err != nil {
+		return nil, nil, fmt.Errorf("planning transfers for manager %d: %w", managerID, err)
+	}
+
+	log.Printf("Planned transfers for manager %d, GW%d-%d: %d free transfers, £%.1fm in the bank, %d plans",
+		managerID, from, to, manager.FreeTransfers, float64(manager.Bank)/10, len(plans))
+
+	return manager, plans, nil
+}
+
+// LoadMarket loads the players on the market and their projected points over
+// gameweeks [from, to], with the players a manager owns whatever their status.
+func (s *Service) LoadMarket(ctx context.Context, manager *Manager, from, to int) (Market, error) {
+	market := Market{Players: make(map[int]squad.Candidate)}
+	for event := from; event <= to; event++ {
+		market.Events = append(market.Events, event)
+	}
+
+	candidates, err := s.Squads.LoadCandidates(ctx, manager.SeasonID, s.ModelVersion, from, to)
+	if err != nil {
+		return market, err
+	}
+	for _, c := range candidates {
+		market.Players[c.PlayerID] = c
+	}
+
+	// Owned players are on the market whatever their status, so they can be sold.
+	ids := make([]int, 0, len(manager.Holdings))
+	for _, h := range manager.Holdings {
+		ids = append(ids, h.PlayerID)
+	}
+	owned, err := s.Repo.LoadPlayers(ctx, manager.SeasonID, ids)
+	if err != nil {
+		return market, err
+	}
+	for _, c := range owned {
+		if _, ok := market.Players[c.PlayerID]; !ok {
+			market.Players[c.PlayerID] = c
+		}
+	}
+
+	if market.Points, err = s.Repo.LoadGameweekPoints(ctx, manager.SeasonID, s.ModelVersion, from, to); err != nil {
+		return market, err
+	}
+	if len(market.Points) == 0 {
+		return market, fmt.Errorf("no %s projections for season %d GW%d-%d", s.ModelVersion, manager.SeasonID, from, to)
+	}
+
+	return market, nil
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/backtest"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func TestEvaluateSegments(t *testing.T) {
+	samples := []backtest.Sample{
+		{ElementType: projection.Forward, Value: 110, Predicted: 6, Actual: 2},
+		{ElementType: projection.Forward, Value: 60, Predicted: 4, Actual: 8},
+		{ElementType: projection.Defender, Value: 45, Predicted: 2, Actual: 2},
+	}
+
+	metrics, bins := backtest.Evaluate(samples)
+
+	byKey := map[string]backtest.Metric{}
+	for _, m := range metrics {
+		byKey[m.SegmentType+"/"+m.Segment] = m
+	}
+
+	overall := byKey["overall/all"]
+	if overall.N != 3 || math.Abs(overall.MAE-8.0/3) > 1e-9 || math.Abs(overall.Bias) > 1e-9 {
+		t.Fatalf("overall: got %+v", overall)
+	}
+	if want := math.Sqrt(32.0 / 3); math.Abs(overall.RMSE-want) > 1e-9 {
+		t.Fatalf("overall RMSE: got %f, want %f", overall.RMSE, want)
+	}
+
+	if fwd := byKey["position/FWD"]; fwd.N != 2 || fwd.MAE != 4 {
+		t.Fatalf("FWD: got %+v", fwd)
+	}
+	for _, band := range []string{"<5.0", "5.0-7.4", "10.0+"} {
+		if byKey["price_band/"+band].N != 1 {
+			t.Fatalf("price band %s: got %+v", band, byKey["price_band/"+band])
+		}
+	}
+
+	// Overall predictions 2, 4, 6 land in three separate bins.
+	var overallBins int
+	for _, b := range bins {
+		if b.SegmentType == backtest.SegmentOverall {
+			overallBins++
+			if b.MeanPredicted < b.Lower || b.MeanPredicted >= b.Upper {
+				t.Fatalf("bin [%v, %v) holds mean prediction %v", b.Lower, b.Upper, b.MeanPredicted)
+			}
+		}
+	}
+	if overallBins != 3 {
+		t.Fatalf("got %d overall bins, want 3", overallBins)
+	}
+}
+
+func TestBacktestRunner(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	runner := &backtest.Runner{
+		Inputs:     projection.NewRepo(fplDb.DB()),
+		Repo:       backtest.NewRepo(fplDb.DB()),
+		FormWindow: cfg.Projection.FormWindow,
+	}
+
+	report, err := runner.Run(context.Background(), &backtest.ProjectionPredictor{Model: projection.NewModel()},
+		cfg.CurrentSeasonID, 2, 0)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("Backtested %d samples, %d metrics", len(report.Samples), len(report.Metrics))
+}
@@ -0,0 +1,99 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/captaincy"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func TestRankSafeAndDifferential(t *testing.T) {
+	xi := []captaincy.Player{
+		{PlayerID: 1, Name: "template", Fixtures: 1, XPoints: 8, Variance: 16},
+		{PlayerID: 2, Name: "punt", Fixtures: 1, XPoints: 7.5, Variance: 25},
+		{PlayerID: 3, Name: "defender", Fixtures: 1, XPoints: 3, Variance: 9},
+	}
+	players := map[int]captaincy.Player{
+		1: xi[0], 2: xi[1], 3: xi[2],
+		4: {PlayerID: 4, Fixtures: 1, XPoints: 5, Variance: 12},
+	}
+	field := captaincy.Field{
+		Managers: 100,
+		Exposures: map[int]captaincy.Exposure{
+			1: {Owned: 0.9, Captained: 0.9, EO: 1.8},
+			2: {Owned: 0.1, EO: 0.1},
+			3: {Owned: 0.9, EO: 0.9},
+			4: {Owned: 0.5, EO: 0.5},
+		},
+	}
+
+	ranking := captaincy.Rank(xi, players, field, captaincy.DefaultOptions())
+
+	if ranking.Candidates[0].PlayerID != 1 || ranking.Candidates[2].PlayerID != 3 {
+		t.Fatalf("candidates should be ordered by projection, got %+v", ranking.Candidates)
+	}
+	if ranking.Safe == nil || ranking.Safe.PlayerID != 1 {
+		t.Fatalf("expected the template captain as safe pick, got %+v", ranking.Safe)
+	}
+	if ranking.Differential == nil || ranking.Differential.PlayerID != 2 {
+		t.Fatalf("expected the low-owned punt as differential, got %+v", ranking.Differential)
+	}
+
+	// Relative variance with player 1 as captain: (2-1.8)^2*16 + 0.9^2*25 +
+	// 0.1^2*9 + 0.5^2*12.
+	want := math.Sqrt(0.04*16 + 0.81*25 + 0.01*9 + 0.25*12)
+	if got := ranking.Safe.RelativeStdDev; math.Abs(got-want) > 1e-9 {
+		t.Fatalf("relative std dev: got %.4f, want %.4f", got, want)
+	}
+}
+
+func TestFixtureVarianceShrinksToPrior(t *testing.T) {
+	if got := captaincy.FixtureVariance(captaincy.Stats{}, 4, 3, 4); got != 12 {
+		t.Fatalf("no history: got %.2f, want the prior 12", got)
+	}
+
+	got := captaincy.FixtureVariance(captaincy.Stats{Appearances: 4, Variance: 20}, 4, 3, 4)
+	if got != 16 {
+		t.Fatalf("4 appearances: got %.2f, want 16", got)
+	}
+}
+
+func TestCaptaincyService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	var managerID int
+	err = fplDb.DB().QueryRow(`SELECT manager_id FROM manager_picks WHERE season_id = $1 LIMIT 1`,
+		cfg.CurrentSeasonID).Scan(&managerID)
+	if err != nil {
+		t.Skipf("No manager picks: %v", err)
+	}
+
+	service := &captaincy.Service{
+		Repo:         captaincy.NewRepo(fplDb.DB()),
+		Projections:  projection.NewRepo(fplDb.DB()),
+		ModelVersion: projection.ModelVersion,
+		SquadPlay:    cfg.Squad.SquadPlay,
+		Options:      captaincy.DefaultOptions(),
+	}
+
+	ranking, field, err := service.RankManager(context.Background(), managerID, cfg.CurrentSeasonID, 0, nil)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("Manager %d: %d captain candidates against %d managers", managerID, len(ranking.Candidates), field.Managers)
+}
@@ -0,0 +1,154 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/chips"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+	"github.com/imadeddine-belkat/analytics-service/internal/transfers"
+)
+
+func TestCalendarFindsBlanksAndDoubles(t *testing.T) {
+	fixtures := []projection.Fixture{
+		{FixtureID: 1, Event: 1, TeamH: 1, TeamA: 2},
+		{FixtureID: 2, Event: 1, TeamH: 3, TeamA: 4},
+		{FixtureID: 3, Event: 2, TeamH: 2, TeamA: 1},
+		{FixtureID: 4, Event: 2, TeamH: 1, TeamA: 3},
+	}
+
+	// Club 5 has no fixture left in the window at all.
+	calendar := chips.Calendar([]int{1, 2, 3, 4, 5}, fixtures, 1, 3)
+	if len(calendar) != 3 {
+		t.Fatalf("expected 3 gameweeks, got %d", len(calendar))
+	}
+
+	if gw := calendar[0]; len(gw.Blank) != 1 || gw.Blank[0] != 5 || gw.IsDouble() || gw.Fixtures != 2 {
+		t.Fatalf("GW1 should only be blank for 5, got %+v", gw)
+	}
+	if gw := calendar[1]; len(gw.Blank) != 2 || gw.Blank[0] != 4 || len(gw.Double) != 1 || gw.Double[0] != 1 {
+		t.Fatalf("GW2 should be blank for 4 and 5 and double for 1, got %+v", gw)
+	}
+	if gw := calendar[2]; len(gw.Blank) != 5 || gw.Fixtures != 0 {
+		t.Fatalf("GW3 should be blank for everyone, got %+v", gw)
+	}
+}
+
+func TestRemainingChipWindows(t *testing.T) {
+	used := []chips.Used{
+		{Chip: transfers.ChipWildcard, Event: 5},
+		{Chip: transfers.ChipBenchBoost, Event: 22},
+	}
+
+	windows := chips.Remaining(used, chips.DefaultRules(), 12)
+
+	want := map[string][][2]int{
+		transfers.ChipWildcard:      {{20, 38}},
+		transfers.ChipFreeHit:       {{12, 19}, {20, 38}},
+		transfers.ChipBenchBoost:    {{12, 19}},
+		transfers.ChipTripleCaptain: {{12, 19}, {20, 38}},
+	}
+	got := make(map[string][][2]int)
+	for _, w := range windows {
+		got[w.Chip] = append(got[w.Chip], [2]int{w.FromEvent, w.ToEvent})
+	}
+	for chip, ranges := range want {
+		if len(got[chip]) != len(ranges) {
+			t.Fatalf("%s: got windows %v, want %v", chip, got[chip], ranges)
+		}
+		for k := range ranges {
+			if got[chip][k] != ranges[k] {
+				t.Fatalf("%s: got windows %v, want %v", chip, got[chip], ranges)
+			}
+		}
+	}
+}
+
+func TestAdvisorTimesChips(t *testing.T) {
+	events := []int{10, 11, 12}
+	manager, market := plannerMarket(events)
+	// GW11 is a double for the whole squad.
+	for _, h := range manager.Holdings {
+		market.Points[h.PlayerID][11] = 6
+	}
+
+	advisor := &chips.Advisor{Squad: squad.DefaultRules(), SellOnFee: 0.5, BenchWeight: squad.DefaultBenchWeight}
+	windows := chips.Remaining(nil, chips.Rules{LastEvent: 38}, 10)
+	advice, err := advisor.Advise(manager, market, nil, windows)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	if gain := advice.Simulations[1].Gains[transfers.ChipBenchBoost]; gain != 24 {
+		t.Fatalf("GW11 bench boost: got %.2f, want 24", gain)
+	}
+	if gain := advice.Simulations[0].Gains[transfers.ChipFreeHit]; gain != 6 {
+		t.Fatalf("GW10 free hit: got %.2f, want 6", gain)
+	}
+
+	played := make(map[string]int)
+	seen := make(map[int]bool)
+	for _, r := range advice.Recommendations {
+		if r.Event != 0 && seen[r.Event] {
+			t.Fatalf("two chips recommended for GW%d", r.Event)
+		}
+		seen[r.Event] = true
+		played[r.Chip] = r.Event
+	}
+
+	want := map[string]int{
+		transfers.ChipBenchBoost:    11,
+		transfers.ChipWildcard:      10,
+		transfers.ChipFreeHit:       12,
+		transfers.ChipTripleCaptain: 0,
+	}
+	for chip, event := range want {
+		if played[chip] != event {
+			t.Fatalf("%s: got GW%d, want GW%d (%+v)", chip, played[chip], event, advice.Recommendations)
+		}
+	}
+}
+
+func TestChipService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	var managerID int
+	err = fplDb.DB().QueryRow(`SELECT manager_id FROM manager_picks WHERE season_id = $1 LIMIT 1`,
+		cfg.CurrentSeasonID).Scan(&managerID)
+	if err != nil {
+		t.Skipf("No manager picks: %v", err)
+	}
+
+	service := &chips.Service{
+		Repo: chips.NewRepo(fplDb.DB()),
+		Transfers: &transfers.Service{
+			Repo:         transfers.NewRepo(fplDb.DB()),
+			Squads:       squad.NewRepo(fplDb.DB()),
+			Projections:  projection.NewRepo(fplDb.DB()),
+			ModelVersion: projection.ModelVersion,
+			Planner:      &transfers.Planner{Squad: squad.DefaultRules(), Rules: transfers.DefaultRules(), Options: transfers.DefaultOptions()},
+		},
+		Rules:   chips.DefaultRules(),
+		Advisor: &chips.Advisor{Squad: squad.DefaultRules(), SellOnFee: 0.5, BenchWeight: cfg.Squad.BenchWeight},
+	}
+
+	_, advice, err := service.AdviseManager(context.Background(), managerID, cfg.CurrentSeasonID, cfg.Projection.Horizon)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("Manager %d: %d chip windows left", managerID, len(advice.Recommendations))
+}
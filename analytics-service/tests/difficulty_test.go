@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/difficulty"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func TestDifficultyPriorsFromStrength(t *testing.T) {
+	priors := difficulty.Priors(map[int]projection.TeamStrength{
+		1: {TeamID: 1, AttackHome: 1300, AttackAway: 1300, DefenceHome: 1300, DefenceAway: 1300},
+		2: {TeamID: 2, AttackHome: 1000, AttackAway: 1000, DefenceHome: 1000, DefenceAway: 1000},
+	})
+
+	if a := priors[1].AttackHome; math.Abs(a-1300.0/1150) > 1e-9 {
+		t.Fatalf("attack prior: got %.4f", a)
+	}
+	if priors[1].DefenceHome >= 1 || priors[2].DefenceHome <= 1 {
+		t.Fatalf("the stronger defence should concede less, got %+v", priors)
+	}
+}
+
+func TestDifficultyRateMovesTowardsXG(t *testing.T) {
+	opts := difficulty.Options{HalfLife: 1, PriorHomeXG: 1.5, PriorAwayXG: 1, PriorMatches: 1}
+	kickoff := time.Date(2025, 8, 16, 14, 0, 0, 0, time.UTC)
+	matches := []difficulty.Match{
+		{FixtureID: 1, Event: 1, Kickoff: kickoff, TeamH: 1, TeamA: 2, XGH: 3, XGA: 0.5},
+		{FixtureID: 2, Event: 2, Kickoff: kickoff.AddDate(0, 0, 7), TeamH: 2, TeamA: 1, XGH: 1, XGA: 1},
+	}
+
+	// Only GW1 counts before GW2. With a half-life of one match the rating
+	// moves half way: attack 1 + (3/1.5 - 1)/2 and defence 1 + (0.5/1 - 1)/2.
+	r := difficulty.Rate(matches, nil, 2, opts)
+	if got := r.Teams[1].AttackHome; math.Abs(got-1.5) > 1e-9 {
+		t.Fatalf("home attack: got %.4f, want 1.5", got)
+	}
+	if got := r.Teams[1].DefenceHome; math.Abs(got-0.75) > 1e-9 {
+		t.Fatalf("home defence: got %.4f, want 0.75", got)
+	}
+	if r.Teams[1].AttackAway != 1 || r.Teams[1].Matches != 1 {
+		t.Fatalf("away ratings should not move on a home match, got %+v", r.Teams[1])
+	}
+	if math.Abs(r.HomeXG-2.25) > 1e-9 || math.Abs(r.AwayXG-0.75) > 1e-9 {
+		t.Fatalf("league averages: got %.4f/%.4f", r.HomeXG, r.AwayXG)
+	}
+
+	sides := r.Fixture(2025, difficulty.Fixture{FixtureID: 3, Event: 2, TeamH: 1, TeamA: 2})
+	if sides[0].AttackDifficulty >= 3 || sides[0].DefenceDifficulty >= 3 {
+		t.Fatalf("team 1 at home should have an easy fixture, got %+v", sides[0])
+	}
+	if sides[1].AttackDifficulty <= 3 || sides[1].DefenceDifficulty <= 3 {
+		t.Fatalf("team 2 away should have a hard fixture, got %+v", sides[1])
+	}
+}
+
+func TestDifficultyScale(t *testing.T) {
+	cases := map[float64]float64{1: 3, 2: 1, 0.5: 5, 4: 1, math.Sqrt2: 2}
+	for ratio, want := range cases {
+		if got := difficulty.Scale(ratio); math.Abs(got-want) > 1e-9 {
+			t.Fatalf("Scale(%.3f): got %.3f, want %.3f", ratio, got, want)
+		}
+	}
+}
+
+func TestDifficultyMapsSofascoreTeamsByMatchDay(t *testing.T) {
+	day := time.Date(2025, 8, 16, 14, 0, 0, 0, time.UTC)
+	fixtures := []difficulty.Match{
+		{FixtureID: 1, Kickoff: day, TeamH: 1, TeamA: 2},
+		{FixtureID: 2, Kickoff: day, TeamH: 3, TeamA: 4},
+		{FixtureID: 3, Kickoff: day.AddDate(0, 0, 7), TeamH: 2, TeamA: 3},
+		{FixtureID: 4, Kickoff: day.AddDate(0, 0, 8), TeamH: 4, TeamA: 1},
+	}
+	sofascore := []difficulty.SofascoreMatch{
+		{MatchID: 10, Kickoff: day, HomeTeamID: 100, AwayTeamID: 200, XGH: 2.1, XGA: 0.4},
+		{MatchID: 11, Kickoff: day.Add(2 * time.Hour), HomeTeamID: 300, AwayTeamID: 400, XGH: 1, XGA: 1},
+		{MatchID: 12, Kickoff: day.AddDate(0, 0, 7), HomeTeamID: 200, AwayTeamID: 300, XGH: 0.8, XGA: 1.7},
+		{MatchID: 13, Kickoff: day.AddDate(0, 0, 8), HomeTeamID: 400, AwayTeamID: 100, XGH: 1.2, XGA: 1.3},
+	}
+
+	teams := difficulty.MapTeams(fixtures, sofascore)
+	for sofascoreID, fplID := range map[int]int{100: 1, 200: 2, 300: 3, 400: 4} {
+		if teams[sofascoreID] != fplID {
+			t.Fatalf("Sofascore team %d: got FPL team %d, want %d (%v)", sofascoreID, teams[sofascoreID], fplID, teams)
+		}
+	}
+
+	merged := difficulty.MergeXG(fixtures, sofascore, teams)
+	if merged[0].Source != "sofascore" || merged[0].XGH != 2.1 || merged[2].XGA != 1.7 {
+		t.Fatalf("got %+v", merged)
+	}
+}
+
+func TestDifficultyService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	service := &difficulty.Service{
+		Repo:        difficulty.NewRepo(fplDb.DB()),
+		Projections: projection.NewRepo(fplDb.DB()),
+		Options:     difficulty.DefaultOptions(),
+		Horizon:     cfg.Projection.Horizon,
+	}
+
+	result, err := service.Run(context.Background(), cfg.CurrentSeasonID, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("GW%d-%d: %d difficulties from %v", result.FromEvent, result.ToEvent, len(result.Difficulties), result.Sources)
+}
@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/difficulty"
+	"github.com/imadeddine-belkat/analytics-service/internal/goals"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func TestGoalsCompute(t *testing.T) {
+	home := difficulty.Difficulty{SeasonID: 2025, Event: 3, FixtureID: 21, TeamID: 1, OpponentTeamID: 2, WasHome: true, XGoalsFor: 1.5}
+	away := difficulty.Difficulty{SeasonID: 2025, Event: 3, FixtureID: 21, TeamID: 2, OpponentTeamID: 1, XGoalsFor: 0.8}
+
+	p := goals.Compute(home, away)
+	if got, want := p.Away.CleanSheet, math.Exp(-1.5); math.Abs(got-want) > 1e-9 {
+		t.Fatalf("away clean sheet: got %.4f, want %.4f", got, want)
+	}
+	if got, want := p.Home.CleanSheet, math.Exp(-0.8); math.Abs(got-want) > 1e-9 {
+		t.Fatalf("home clean sheet: got %.4f, want %.4f", got, want)
+	}
+	if got, want := p.Home.Goals[1], 1.5*math.Exp(-1.5); math.Abs(got-want) > 1e-9 {
+		t.Fatalf("home one goal: got %.4f, want %.4f", got, want)
+	}
+
+	for _, side := range []goals.Side{p.Home, p.Away} {
+		var total float64
+		for _, g := range side.Goals {
+			total += g
+		}
+		if math.Abs(total-1) > 1e-9 {
+			t.Fatalf("team %d goals sum to %.6f", side.TeamID, total)
+		}
+	}
+
+	msg := goals.Message(p)
+	if msg.GetFixtureId() != 21 || len(msg.GetHome().GetGoals()) != goals.MaxGoals+1 || msg.GetModelVersion() != goals.ModelVersion {
+		t.Fatalf("message: got %v", msg)
+	}
+}
+
+func TestGoalsPairSkipsHalfFixtures(t *testing.T) {
+	pairs := goals.Pair([]difficulty.Difficulty{
+		{FixtureID: 1, TeamID: 2, WasHome: false},
+		{FixtureID: 1, TeamID: 1, WasHome: true},
+		{FixtureID: 2, TeamID: 3, WasHome: true},
+	})
+	if len(pairs) != 1 || pairs[0][0].TeamID != 1 || pairs[0][1].TeamID != 2 {
+		t.Fatalf("pairs: got %+v", pairs)
+	}
+}
+
+func TestGoalsService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	service := &goals.Service{
+		Repo: goals.NewRepo(fplDb.DB()),
+		Difficulty: &difficulty.Service{
+			Repo:        difficulty.NewRepo(fplDb.DB()),
+			Projections: projection.NewRepo(fplDb.DB()),
+			Options:     difficulty.DefaultOptions(),
+			Horizon:     cfg.Projection.Horizon,
+		},
+	}
+
+	probabilities, err := service.Run(context.Background(), cfg.CurrentSeasonID, 0, 0, 0)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("%d fixtures", len(probabilities))
+}
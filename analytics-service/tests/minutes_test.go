@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/minutes"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func TestMinutesUsageWeighsRecentMatches(t *testing.T) {
+	opts := minutes.DefaultOptions()
+	opts.PriorMatches = 0
+	opts.HalfLife = 1
+
+	kickoff := time.Date(2025, 8, 16, 15, 0, 0, 0, time.UTC)
+	// Benched, then two starts: weights ¼, ½ and 1.
+	history := []minutes.Appearance{
+		{Kickoff: kickoff, Minutes: 0},
+		{Kickoff: kickoff.AddDate(0, 0, 7), Minutes: 90, Started: true},
+		{Kickoff: kickoff.AddDate(0, 0, 14), Minutes: 60, Started: true},
+	}
+	u := minutes.UsageOf(history, opts)
+	if math.Abs(u.StartRate-1.5/1.75) > 1e-9 {
+		t.Fatalf("start rate: got %.4f, want %.4f", u.StartRate, 1.5/1.75)
+	}
+	if math.Abs(u.StartMinutes-(45+60)/1.5) > 1e-9 {
+		t.Fatalf("start minutes: got %.4f, want 70", u.StartMinutes)
+	}
+	if u.SubRate != 0 {
+		t.Fatalf("sub rate: got %.4f, want 0", u.SubRate)
+	}
+}
+
+func TestMinutesPredict(t *testing.T) {
+	opts := minutes.DefaultOptions()
+	kickoff := time.Date(2025, 9, 20, 15, 0, 0, 0, time.UTC)
+	var history []minutes.Appearance
+	for k := 5; k > 0; k-- {
+		history = append(history, minutes.Appearance{Kickoff: kickoff.AddDate(0, 0, -7*k), Minutes: 90, Started: true})
+	}
+	regular := minutes.Player{PlayerID: 1, TeamID: 1, Status: "a", History: history, LastStart: history[len(history)-1].Kickoff}
+	f := minutes.Fixture{FixtureID: 50, Event: 5, Kickoff: kickoff, TeamID: 1}
+
+	rested := minutes.Predict(2025, regular, f, kickoff.AddDate(0, 0, -7), opts)
+	if rested.StartProbability < 0.9 || rested.ExpectedMinutes < 80 || *rested.RestDays != 7 {
+		t.Fatalf("regular starter: got %+v", rested)
+	}
+
+	// A midweek cup start three days before costs a share of the start chance.
+	regular.LastStart = kickoff.AddDate(0, 0, -3)
+	tired := minutes.Predict(2025, regular, f, kickoff.AddDate(0, 0, -3), opts)
+	if math.Abs(tired.StartProbability-rested.StartProbability*(1-opts.ShortRestPenalty)) > 1e-9 {
+		t.Fatalf("short rest: got %.4f from %.4f", tired.StartProbability, rested.StartProbability)
+	}
+
+	regular.Status = "i"
+	if injured := minutes.Predict(2025, regular, f, time.Time{}, opts); injured.ExpectedMinutes != 0 || injured.RestDays != nil {
+		t.Fatalf("injured: got %+v", injured)
+	}
+
+	regular.Status, regular.Missing = "a", "doubtful"
+	if doubtful := minutes.Predict(2025, regular, f, time.Time{}, opts); doubtful.Availability != opts.DoubtfulFactor {
+		t.Fatalf("doubtful: got %.2f", doubtful.Availability)
+	}
+}
+
+func TestMinutesMapsSofascorePlayersByName(t *testing.T) {
+	players := []minutes.Player{
+		{PlayerID: 1, TeamID: 1, FirstName: "Martin", SecondName: "Ødegaard", WebName: "Ødegaard"},
+		{PlayerID: 2, TeamID: 1, FirstName: "Bukayo", SecondName: "Saka", WebName: "Saka"},
+		{PlayerID: 3, TeamID: 1, FirstName: "Jurriën", SecondName: "Timber", WebName: "J.Timber"},
+		{PlayerID: 4, TeamID: 1, FirstName: "Quinten", SecondName: "Timber", WebName: "Q.Timber"},
+		{PlayerID: 5, TeamID: 2, FirstName: "Bruno Miguel", SecondName: "Borges Fernandes", WebName: "B.Fernandes"},
+	}
+	deadline := time.Date(2025, 9, 20, 10, 0, 0, 0, time.UTC)
+	cup := deadline.AddDate(0, 0, -3)
+	lineups := []minutes.LineupPlayer{
+		{MatchID: 9, TeamID: 100, PlayerID: 11, Name: "Martin Odegaard", Kickoff: cup},
+		{MatchID: 9, TeamID: 100, PlayerID: 12, Name: "Bukayo Saka", Kickoff: cup, Substitute: true},
+		{MatchID: 9, TeamID: 100, PlayerID: 13, Name: "Jurrien Timber", Kickoff: cup},
+		{MatchID: 9, TeamID: 100, PlayerID: 14, Name: "Timber", Kickoff: cup, Missing: "missing"},
+		{MatchID: 8, TeamID: 200, PlayerID: 15, Name: "Bruno Fernandes", Kickoff: cup},
+	}
+	teams := map[int]int{100: 1, 200: 2}
+
+	ids := minutes.MapPlayers(players, lineups, teams)
+	if ids[11] != 1 || ids[12] != 2 || ids[13] != 3 {
+		t.Fatalf("mapped: got %v", ids)
+	}
+	if _, ok := ids[14]; ok {
+		t.Fatalf("an ambiguous name should not map, got %v", ids)
+	}
+	if ids[15] != 5 {
+		t.Fatalf("web name: got %v", ids)
+	}
+
+	minutes.Apply(players, lineups, ids, teams, deadline)
+	if !players[0].LastStart.Equal(cup) || !players[1].LastStart.IsZero() {
+		t.Fatalf("last starts: got %v and %v", players[0].LastStart, players[1].LastStart)
+	}
+}
+
+func TestMinutesSchedule(t *testing.T) {
+	s := make(minutes.Schedule)
+	saturday := time.Date(2025, 9, 20, 15, 0, 0, 0, time.UTC)
+	s.Add(1, saturday)
+	s.Add(1, saturday.AddDate(0, 0, -7))
+	s.Add(1, saturday.AddDate(0, 0, -3))
+	// The same match from the other source.
+	s.Add(1, saturday.Add(-2*time.Hour))
+
+	if got := s.Previous(1, saturday); !got.Equal(saturday.AddDate(0, 0, -3)) {
+		t.Fatalf("previous: got %v", got)
+	}
+	if got := s.Previous(1, saturday.AddDate(0, 0, -7)); !got.IsZero() {
+		t.Fatalf("first match: got %v", got)
+	}
+}
+
+func TestMinutesService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	service := &minutes.Service{
+		Repo:         minutes.NewRepo(fplDb.DB()),
+		Projections:  projection.NewRepo(fplDb.DB()),
+		Options:      minutes.DefaultOptions(),
+		LookbackDays: cfg.Minutes.LookbackDays,
+	}
+
+	predictions, err := service.Run(context.Background(), cfg.CurrentSeasonID, 0, 0)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("%d player fixtures", len(predictions))
+}
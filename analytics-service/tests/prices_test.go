@@ -0,0 +1,111 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/prices"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func priceOptions() prices.Options {
+	// 1% ownership is 1000 owners: thresholds of 1100 in and 1080 out.
+	return prices.Options{TotalManagers: 100000, BaseThreshold: 1000, RiseFraction: 0.1, FallFraction: 0.08}
+}
+
+func TestPriceThresholdsGrowWithOwnership(t *testing.T) {
+	rise, fall := priceOptions().Thresholds(1)
+	if rise != 1100 || fall != 1080 {
+		t.Fatalf("got rise %d, fall %d", rise, fall)
+	}
+	if more, _ := priceOptions().Thresholds(10); more <= rise {
+		t.Fatalf("a more owned player should need more transfers, got %d", more)
+	}
+}
+
+func TestPriceStepAccumulatesAcrossDeadline(t *testing.T) {
+	start := time.Date(2025, 9, 1, 6, 0, 0, 0, time.UTC)
+	first := prices.Snapshot{PlayerID: 1, TakenAt: start, NowCost: 55, TransfersIn: 500, TransfersOut: 100, SelectedBy: 1}
+
+	p, change := prices.Step(nil, first, prices.Progress{}, priceOptions())
+	if change != nil || p.NetSinceChange != 400 {
+		t.Fatalf("first snapshot: got %+v, change %v", p, change)
+	}
+
+	// The deadline passes and the gameweek counters restart.
+	second := first
+	second.TakenAt = start.Add(4 * time.Hour)
+	second.TransfersIn, second.TransfersOut = 300, 0
+
+	p, change = prices.Step(&first, second, p, priceOptions())
+	if change != nil || p.NetSinceChange != 700 || p.HourlyRate != 75 {
+		t.Fatalf("after the deadline: got %+v", p)
+	}
+	if math.Abs(p.Progress-700.0/1100) > 1e-9 {
+		t.Fatalf("progress: got %.4f", p.Progress)
+	}
+	if p.ETAHours == nil || math.Abs(*p.ETAHours-400.0/75) > 1e-9 {
+		t.Fatalf("eta: got %v", p.ETAHours)
+	}
+}
+
+func TestPriceStepDetectsChange(t *testing.T) {
+	start := time.Date(2025, 9, 1, 6, 0, 0, 0, time.UTC)
+	prev := prices.Snapshot{PlayerID: 1, TakenAt: start, NowCost: 55, TransfersIn: 900, SelectedBy: 1}
+	curr := prices.Snapshot{PlayerID: 1, TakenAt: start.Add(2 * time.Hour), NowCost: 56, TransfersIn: 1300, SelectedBy: 1}
+
+	p, change := prices.Step(&prev, curr, prices.Progress{NetSinceChange: 900}, priceOptions())
+	if change == nil || !change.Rise() || change.NetTransfers != 1300 {
+		t.Fatalf("expected a rise after 1300 net transfers, got %+v", change)
+	}
+	if p.NetSinceChange != 0 || p.Progress != 0 || p.LastChangeAt == nil || !p.LastChangeAt.Equal(curr.TakenAt) {
+		t.Fatalf("progress should restart after the change, got %+v", p)
+	}
+
+	// Transfers out: heading to a fall.
+	next := curr
+	next.TakenAt = curr.TakenAt.Add(time.Hour)
+	next.TransfersOut = 540
+
+	p, change = prices.Step(&curr, next, p, priceOptions())
+	if change != nil || p.Progress != -0.5 || p.ETAHours == nil || *p.ETAHours != 1 {
+		t.Fatalf("got %+v", p)
+	}
+}
+
+func TestPriceService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	service := &prices.Service{
+		Repo:        prices.NewRepo(fplDb.DB()),
+		Projections: projection.NewRepo(fplDb.DB()),
+		Options:     prices.DefaultOptions(),
+	}
+
+	ctx := context.Background()
+	run, err := service.Snapshot(ctx, cfg.CurrentSeasonID)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	report, err := service.Report(ctx, cfg.CurrentSeasonID, 5, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("GW%d: %d snapshots, %d changes; %d risers, %d fallers",
+		run.Event, len(run.Snapshots), len(run.Changes), len(report.Risers), len(report.Fallers))
+}
@@ -0,0 +1,144 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+)
+
+func TestPoissonFloorMean(t *testing.T) {
+	// E[floor(X/1)] is the Poisson mean itself.
+	if got := projection.PoissonFloorMean(1.7, 1); math.Abs(got-1.7) > 1e-9 {
+		t.Fatalf("block 1: got %f, want 1.7", got)
+	}
+
+	// E[floor(X/2)] for lambda=1: P(X>=2) + P(X>=4) + ...
+	want := 0.0
+	for k := 2; k < 30; k += 2 {
+		want += poissonTail(1, k)
+	}
+	if got := projection.PoissonFloorMean(1, 2); math.Abs(got-want) > 1e-9 {
+		t.Fatalf("block 2: got %f, want %f", got, want)
+	}
+}
+
+func TestModelProjectsStrongerFixtureHigher(t *testing.T) {
+	in := &projection.Inputs{
+		SeasonID:     2025,
+		BeforeEvent:  10,
+		GoalsPerTeam: 1.4,
+		Rules:        projection.DefaultScoringRules(),
+		Teams: map[int]projection.TeamStrength{
+			1: {TeamID: 1, AttackHome: 1300, AttackAway: 1250, DefenceHome: 1300, DefenceAway: 1250},
+			2: {TeamID: 2, AttackHome: 1050, AttackAway: 1000, DefenceHome: 1050, DefenceAway: 1000},
+			3: {TeamID: 3, AttackHome: 1350, AttackAway: 1300, DefenceHome: 1350, DefenceAway: 1300},
+		},
+		Players: []projection.PlayerInput{{
+			PlayerID: 100, TeamID: 1, ElementType: projection.Forward, Status: "a",
+			TeamMatches: 6, Appearances: 6, Starts60: 6, Minutes: 520,
+			SeasonMinutes: 800, XG: 5.5, XA: 1.2, Bonus: 8,
+		}},
+	}
+
+	fixtures := []projection.Fixture{
+		{FixtureID: 1, Event: 10, TeamH: 1, TeamA: 2},
+		{FixtureID: 2, Event: 11, TeamH: 3, TeamA: 1},
+	}
+
+	got := projection.NewModel().Project(in, fixtures)
+	if len(got) != 2 {
+		t.Fatalf("got %d projections, want 2", len(got))
+	}
+
+	easy, hard := got[0], got[1]
+	if easy.XPoints <= hard.XPoints {
+		t.Fatalf("home vs weak side %.2f should beat away at strong side %.2f", easy.XPoints, hard.XPoints)
+	}
+	if easy.P60 != 1 || easy.PPlay != 1 {
+		t.Fatalf("nailed starter: p_play=%.2f p_60=%.2f", easy.PPlay, easy.P60)
+	}
+}
+
+func TestModelInjuredPlayerScoresNothing(t *testing.T) {
+	in := &projection.Inputs{
+		GoalsPerTeam: 1.4,
+		Teams:        map[int]projection.TeamStrength{},
+		Players: []projection.PlayerInput{{
+			PlayerID: 1, TeamID: 1, ElementType: projection.Midfielder, Status: "i",
+			TeamMatches: 6, Appearances: 6, Starts60: 6, Minutes: 540, SeasonMinutes: 900, XG: 3,
+		}},
+	}
+
+	got := projection.NewModel().Project(in, []projection.Fixture{{FixtureID: 1, Event: 1, TeamH: 1, TeamA: 2}})
+	if len(got) != 1 || got[0].XPoints != 0 {
+		t.Fatalf("injured player projected %+v", got)
+	}
+}
+
+func TestModelIgnoresMissingStrengths(t *testing.T) {
+	in := &projection.Inputs{
+		GoalsPerTeam: 1.4,
+		Teams: map[int]projection.TeamStrength{
+			1: {TeamID: 1, AttackHome: 1300, AttackAway: 1250, DefenceHome: 1300, DefenceAway: 1250},
+			2: {TeamID: 2, AttackHome: 1050, AttackAway: 1000, DefenceHome: 1050, DefenceAway: 1000},
+		},
+		Players: []projection.PlayerInput{{
+			PlayerID: 100, TeamID: 1, ElementType: projection.Forward, Status: "a",
+			TeamMatches: 6, Appearances: 6, Starts60: 6, Minutes: 520,
+			SeasonMinutes: 800, XG: 5.5, XA: 1.2, Bonus: 8,
+		}},
+	}
+	fixtures := []projection.Fixture{{FixtureID: 1, Event: 10, TeamH: 1, TeamA: 2}}
+
+	want := projection.NewModel().Project(in, fixtures)
+
+	// Team 3 has no attack ratings and defends at the league average, so the
+	// averages, and the projection, must not move.
+	in.Teams[3] = projection.TeamStrength{TeamID: 3, DefenceHome: 1150, DefenceAway: 1150}
+	got := projection.NewModel().Project(in, fixtures)
+
+	if len(got) != 1 || math.Abs(got[0].XPoints-want[0].XPoints) > 1e-9 {
+		t.Fatalf("missing strengths moved the projection from %+v to %+v", want, got)
+	}
+}
+
+func TestProjectionService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	service := &projection.Service{
+		Repo:  projection.NewRepo(fplDb.DB()),
+		Model: projection.NewModel(),
+	}
+
+	projections, err := service.ProjectNext(context.Background(), cfg.CurrentSeasonID, cfg.Projection.Horizon, cfg.Projection.FormWindow)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("Projected %d player-fixtures", len(projections))
+}
+
+func poissonTail(lambda float64, k int) float64 {
+	cdf, pmf := 0.0, math.Exp(-lambda)
+	for x := 0; x < k; x++ {
+		if x > 0 {
+			pmf *= lambda / float64(x)
+		}
+		cdf += pmf
+	}
+	return 1 - cdf
+}
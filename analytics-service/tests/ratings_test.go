@@ -0,0 +1,135 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/ratings"
+)
+
+func TestRatingsEloUpdate(t *testing.T) {
+	opts := ratings.EloOptions{K: 20, HomeAdvantage: 0, Initial: 1500, NewTeam: 1400, CarryOver: 0.5}
+	elo := ratings.NewElo(opts)
+	kickoff := time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC)
+
+	// Even sides: a one-goal home win moves K·½ = 10 points each way.
+	sides := elo.Update(ratings.Match{SeasonID: 1, MatchID: 1, Kickoff: kickoff, Home: 1, Away: 2, HomeGoals: 1, AwayGoals: 0, Finished: true})
+	if sides[0].Before != 1500 || math.Abs(sides[0].After-1510) > 1e-9 || math.Abs(sides[1].After-1490) > 1e-9 {
+		t.Fatalf("one-goal win: got %+v", sides)
+	}
+
+	// A new season halves the distance from the mean; newcomers join lower.
+	elo.Update(ratings.Match{SeasonID: 2, MatchID: 1, Kickoff: kickoff.AddDate(1, 0, 0), Home: 1, Away: 3, HomeGoals: 0, AwayGoals: 0, Finished: true})
+	if got := elo.Ratings[2]; math.Abs(got-1495) > 1e-9 {
+		t.Fatalf("carry over: got %.2f, want 1495", got)
+	}
+	if got := elo.Rating(4); got != 1400 {
+		t.Fatalf("new team: got %.2f, want 1400", got)
+	}
+
+	// A postponed match of the old season must not regress again.
+	before := elo.Ratings[2]
+	elo.StartSeason(1)
+	if elo.Ratings[2] != before {
+		t.Fatalf("season 1 regressed twice")
+	}
+
+	o := elo.Outcome(1, 2)
+	if math.Abs(o.Home+o.Draw+o.Away-1) > 1e-9 || o.Home <= o.Away {
+		t.Fatalf("outcome: got %+v", o)
+	}
+}
+
+func TestRatingsDixonColesFindsTheStrongerTeam(t *testing.T) {
+	at := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	// Team 1 beats everyone; the others win 2-1 at home against each other.
+	var matches []ratings.Match
+	for k := 0; k < 5; k++ {
+		for home := 1; home <= 4; home++ {
+			for away := 1; away <= 4; away++ {
+				if home == away {
+					continue
+				}
+				m := ratings.Match{MatchID: len(matches), Kickoff: at.AddDate(0, 0, -7*(len(matches)+1)), Home: home, Away: away, HomeGoals: 2, AwayGoals: 1, Finished: true}
+				switch {
+				case home == 1:
+					m.HomeGoals, m.AwayGoals = 3, 0
+				case away == 1:
+					m.HomeGoals, m.AwayGoals = 1, 2
+				}
+				matches = append(matches, m)
+			}
+		}
+	}
+	// Fixtures still to play don't count.
+	matches = append(matches, ratings.Match{MatchID: 99, Kickoff: at.AddDate(0, 0, 3), Home: 2, Away: 1})
+
+	dc := ratings.FitDixonColes(ratings.FPLLeague, matches, at, ratings.DefaultDixonColesOptions())
+	if dc.Matches != 60 {
+		t.Fatalf("matches: got %d, want 60", dc.Matches)
+	}
+	if dc.Attack[1] <= dc.Attack[2] || dc.Defence[1] >= dc.Defence[2] {
+		t.Fatalf("team 1 should be stronger, got attack %v defence %v", dc.Attack, dc.Defence)
+	}
+	if dc.Home <= 1 || math.IsInf(dc.Home, 0) {
+		t.Fatalf("home advantage: got %.3f", dc.Home)
+	}
+
+	grid := dc.Scorelines(1, 2, 6)
+	var total float64
+	for _, row := range grid {
+		for _, p := range row {
+			total += p
+		}
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Fatalf("scorelines sum to %.6f", total)
+	}
+	o := ratings.GridOutcome(grid)
+	if math.Abs(o.Home+o.Draw+o.Away-1) > 1e-9 || o.Home <= o.Away {
+		t.Fatalf("outcome: got %+v", o)
+	}
+}
+
+func TestRatingsUnknownTeamsAreAverage(t *testing.T) {
+	dc := ratings.FitDixonColes(ratings.FPLLeague, nil, time.Now(), ratings.DefaultDixonColesOptions())
+	home, away := dc.Rates(1, 2)
+	if home != 1 || away != 1 {
+		t.Fatalf("rates: got %.3f-%.3f", home, away)
+	}
+	if grid := dc.Scorelines(1, 2, 3); len(grid) != 4 || len(grid[3]) != 4 {
+		t.Fatalf("grid size: got %d", len(grid))
+	}
+}
+
+func TestRatingsService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	service := &ratings.Service{
+		Repo:        ratings.NewRepo(fplDb.DB()),
+		Elo:         ratings.DefaultEloOptions(),
+		DixonColes:  ratings.DefaultDixonColesOptions(),
+		HorizonDays: cfg.Ratings.HorizonDays,
+	}
+
+	summary, err := service.Run(context.Background(), ratings.FPLLeague, 0, time.Now())
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("%d results, seasons %v, %d predictions", summary.Results, summary.Seasons, len(summary.Predictions))
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/snapshots"
+)
+
+const snapshotBootstrap = `{
+	"events": [{"id": 5, "deadline_time": "2025-09-20T10:00:00Z"}],
+	"teams": [{"id": 1, "name": "Arsenal", "short_name": "ARS", "position": 2, "strength": 5,
+		"strength_overall_home": 1340, "strength_attack_away": 1300, "form": null}],
+	"elements": [
+		{"id": 7, "team": 1, "element_type": 3, "web_name": "Saka", "status": "d",
+		 "news": "Hamstring injury - 75% chance of playing", "news_added": "2025-09-18T15:30:00Z",
+		 "chance_of_playing_next_round": 75, "chance_of_playing_this_round": null,
+		 "now_cost": 101, "selected_by_percent": "31.4", "form": "6.5", "selected_rank": 4},
+		{"id": 8, "team": 1, "element_type": 4, "web_name": "Gyökeres", "status": "a",
+		 "news": "", "news_added": null, "chance_of_playing_next_round": null,
+		 "now_cost": 90, "selected_by_percent": "25.0", "form": "4.0"}
+	],
+	"an_unknown_field": true
+}`
+
+func snapshotOf(t *testing.T, raw string) snapshots.Snapshot {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(raw)); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	sum := sha256.Sum256([]byte(raw))
+	return snapshots.Snapshot{
+		SeasonID:      2025,
+		Event:         5,
+		Version:       1,
+		FormatVersion: snapshots.FormatVersion,
+		SHA256:        hex.EncodeToString(sum[:]),
+		Payload:       buf.Bytes(),
+	}
+}
+
+func TestSnapshotsDecode(t *testing.T) {
+	bootstrap, err := snapshots.Decode(snapshotOf(t, snapshotBootstrap))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	players := snapshots.Players(bootstrap)
+	if len(players) != 2 {
+		t.Fatalf("players: got %d, want 2", len(players))
+	}
+	saka := players[0]
+	if saka.Status != "d" || saka.ChanceNextRound == nil || *saka.ChanceNextRound != 75 ||
+		saka.NowCost != 101 || saka.SelectedByPercent != 31.4 || saka.SelectedRank != 4 || saka.Form != 6.5 {
+		t.Fatalf("doubtful player: got %+v", saka)
+	}
+	if players[1].ChanceNextRound != nil {
+		t.Fatalf("available player: got chance %d", *players[1].ChanceNextRound)
+	}
+
+	teams := snapshots.Teams(bootstrap)
+	if len(teams) != 1 || teams[0].StrengthOverallHome != 1340 || teams[0].StrengthAttackAway != 1300 || teams[0].Position != 2 {
+		t.Fatalf("teams: got %+v", teams)
+	}
+}
+
+func TestSnapshotsDecodeRejectsBadPayloads(t *testing.T) {
+	tampered := snapshotOf(t, snapshotBootstrap)
+	tampered.SHA256 = hex.EncodeToString(make([]byte, sha256.Size))
+	if _, err := snapshots.Decode(tampered); err == nil {
+		t.Fatal("a payload not matching its hash should not decode")
+	}
+
+	future := snapshotOf(t, snapshotBootstrap)
+	future.FormatVersion = snapshots.FormatVersion + 1
+	if _, err := snapshots.Decode(future); err == nil {
+		t.Fatal("an unknown format should not decode")
+	}
+}
+
+func TestSnapshotsService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	service := &snapshots.Service{Repo: snapshots.NewRepo(fplDb.DB())}
+
+	loaded, err := service.RehydrateAll(context.Background(), cfg.CurrentSeasonID)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("%d gameweeks rehydrated", len(loaded))
+}
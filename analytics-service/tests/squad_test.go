@@ -0,0 +1,236 @@
+package tests
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+)
+
+// smallRules is a 6-man game small enough to brute force.
+func smallRules() squad.Rules {
+	return squad.Rules{
+		SquadSize: 6,
+		SquadPlay: 4,
+		TeamLimit: 2,
+		Budget:    300,
+		Positions: map[int]squad.Position{
+			projection.Goalkeeper: {ElementType: projection.Goalkeeper, Select: 1, MinPlay: 1, MaxPlay: 1},
+			projection.Defender:   {ElementType: projection.Defender, Select: 2, MinPlay: 1, MaxPlay: 2},
+			projection.Midfielder: {ElementType: projection.Midfielder, Select: 2, MinPlay: 0, MaxPlay: 2},
+			projection.Forward:    {ElementType: projection.Forward, Select: 1, MinPlay: 0, MaxPlay: 1},
+		},
+	}
+}
+
+func randomCandidates(r *rand.Rand, n, teams int) []squad.Candidate {
+	var cands []squad.Candidate
+	for id := 1; id <= n; id++ {
+		cands = append(cands, squad.Candidate{
+			PlayerID:    id,
+			TeamID:      1 + r.Intn(teams),
+			ElementType: 1 + r.Intn(4),
+			Cost:        30 + r.Intn(40),
+			Points:      float64(r.Intn(100)) / 10,
+		})
+	}
+	return cands
+}
+
+func TestOptimiseMatchesBruteForce(t *testing.T) {
+	rules := smallRules()
+	const benchWeight = 0.3
+
+	for seed := int64(1); seed <= 40; seed++ {
+		cands := randomCandidates(rand.New(rand.NewSource(seed)), 14, 4)
+
+		want, ok := bruteForce(cands, rules, benchWeight)
+		got, err := squad.Optimise(cands, rules, squad.Options{BenchWeight: benchWeight})
+		if !ok {
+			if err == nil {
+				t.Fatalf("seed %d: found a squad where none is legal", seed)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("seed %d: %v", seed, err)
+		}
+		if !got.Optimal || math.Abs(got.Value-want) > 1e-6 {
+			t.Fatalf("seed %d: got %.3f (optimal=%t), want %.3f", seed, got.Value, got.Optimal, want)
+		}
+	}
+}
+
+func TestOptimiseRespectsRules(t *testing.T) {
+	rules := squad.DefaultRules()
+	cands := randomCandidates(rand.New(rand.NewSource(7)), 400, 20)
+	for i := range cands {
+		cands[i].Cost += 15
+	}
+
+	var locked, banned []int
+	for _, c := range cands {
+		if c.ElementType == projection.Forward && len(locked) == 0 {
+			locked = append(locked, c.PlayerID)
+		}
+	}
+
+	first, err := squad.Optimise(cands, rules, squad.Options{BenchWeight: squad.DefaultBenchWeight})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	banned = append(banned, first.Starters[0].PlayerID)
+
+	got, err := squad.Optimise(cands, rules, squad.Options{
+		Locked:      locked,
+		Banned:      banned,
+		BenchWeight: squad.DefaultBenchWeight,
+	})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	if len(got.Starters) != rules.SquadPlay || len(got.Starters)+len(got.Bench) != rules.SquadSize {
+		t.Fatalf("got %d starters and %d on the bench", len(got.Starters), len(got.Bench))
+	}
+	if got.Cost > rules.Budget {
+		t.Fatalf("cost %d over budget %d", got.Cost, rules.Budget)
+	}
+	if got.Bench[0].ElementType != projection.Goalkeeper {
+		t.Fatalf("bench should start with the goalkeeper, got type %d", got.Bench[0].ElementType)
+	}
+
+	clubs := map[int]int{}
+	selected := map[int]int{}
+	ids := map[int]bool{}
+	for _, c := range append(append([]squad.Candidate(nil), got.Starters...), got.Bench...) {
+		clubs[c.TeamID]++
+		selected[c.ElementType]++
+		ids[c.PlayerID] = true
+		if clubs[c.TeamID] > rules.TeamLimit {
+			t.Fatalf("more than %d players from team %d", rules.TeamLimit, c.TeamID)
+		}
+	}
+	for et, p := range rules.Positions {
+		if selected[et] != p.Select {
+			t.Fatalf("element type %d: got %d players, want %d", et, selected[et], p.Select)
+		}
+	}
+	if !ids[locked[0]] || ids[banned[0]] {
+		t.Fatalf("locked %v or banned %v ignored", locked, banned)
+	}
+}
+
+func TestOptimiseRejectsImpossibleLocks(t *testing.T) {
+	rules := smallRules()
+	cands := []squad.Candidate{
+		{PlayerID: 1, TeamID: 1, ElementType: projection.Goalkeeper, Cost: 40},
+		{PlayerID: 2, TeamID: 2, ElementType: projection.Goalkeeper, Cost: 40},
+	}
+
+	if _, err := squad.Optimise(cands, rules, squad.Options{Locked: []int{1, 2}}); err == nil {
+		t.Fatal("expected an error when locking two goalkeepers in a one-keeper squad")
+	}
+	if _, err := squad.Optimise(cands, rules, squad.Options{Locked: []int{1}, Banned: []int{1}}); err == nil {
+		t.Fatal("expected an error when a player is both locked and banned")
+	}
+}
+
+func TestSquadService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	service := &squad.Service{
+		Repo:         squad.NewRepo(fplDb.DB()),
+		Projections:  projection.NewRepo(fplDb.DB()),
+		ModelVersion: projection.ModelVersion,
+	}
+
+	result, err := service.Build(context.Background(), cfg.CurrentSeasonID, 0, cfg.Projection.Horizon,
+		squad.DefaultRules(), squad.Options{BenchWeight: cfg.Squad.BenchWeight})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("Squad costs %d for %.2f projected points", result.Cost, result.Points)
+}
+
+// bruteForce tries every subset of cands and returns the best objective.
+func bruteForce(cands []squad.Candidate, rules squad.Rules, benchWeight float64) (float64, bool) {
+	best, found := 0.0, false
+
+	for mask := 0; mask < 1<<len(cands); mask++ {
+		var picked []squad.Candidate
+		cost := 0
+		for i, c := range cands {
+			if mask>>i&1 == 1 {
+				picked = append(picked, c)
+				cost += c.Cost
+			}
+		}
+		if len(picked) != rules.SquadSize || cost > rules.Budget || !legal(picked, rules) {
+			continue
+		}
+
+		// Try every XI of the squad.
+		for xi := 0; xi < 1<<len(picked); xi++ {
+			starters := map[int]int{}
+			var n int
+			var value float64
+			for i, c := range picked {
+				if xi>>i&1 == 1 {
+					starters[c.ElementType]++
+					n++
+					value += c.Points
+				} else {
+					value += benchWeight * c.Points
+				}
+			}
+			if n != rules.SquadPlay {
+				continue
+			}
+			ok := true
+			for et, p := range rules.Positions {
+				if starters[et] < p.MinPlay || starters[et] > p.MaxPlay {
+					ok = false
+				}
+			}
+			if ok && (!found || value > best) {
+				best, found = value, true
+			}
+		}
+	}
+
+	return best, found
+}
+
+func legal(picked []squad.Candidate, rules squad.Rules) bool {
+	selected := map[int]int{}
+	clubs := map[int]int{}
+	for _, c := range picked {
+		selected[c.ElementType]++
+		if clubs[c.TeamID]++; clubs[c.TeamID] > rules.TeamLimit {
+			return false
+		}
+	}
+	for et, p := range rules.Positions {
+		if selected[et] != p.Select {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,185 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/imadeddine-belkat/analytics-service/config"
+	"github.com/imadeddine-belkat/analytics-service/internal/db/connection"
+	"github.com/imadeddine-belkat/analytics-service/internal/projection"
+	"github.com/imadeddine-belkat/analytics-service/internal/squad"
+	"github.com/imadeddine-belkat/analytics-service/internal/transfers"
+)
+
+func TestSellingPrice(t *testing.T) {
+	cases := []struct {
+		purchase, now, want int
+	}{
+		{purchase: 55, now: 55, want: 55},
+		{purchase: 55, now: 56, want: 55}, // half of 0.1m rounds down
+		{purchase: 55, now: 57, want: 56},
+		{purchase: 55, now: 60, want: 57},
+		{purchase: 55, now: 52, want: 52}, // losses are taken in full
+	}
+
+	for _, c := range cases {
+		if got := transfers.SellingPrice(c.purchase, c.now, 0.5); got != c.want {
+			t.Errorf("bought %d, now %d: got %d, want %d", c.purchase, c.now, got, c.want)
+		}
+	}
+}
+
+func TestFreeTransfers(t *testing.T) {
+	rules := transfers.DefaultRules()
+
+	cases := []struct {
+		name    string
+		history []transfers.GameweekRecord
+		want    int
+	}{
+		{"first gameweek", []transfers.GameweekRecord{{Event: 1}}, 1},
+		{"rolled twice", []transfers.GameweekRecord{{Event: 1}, {Event: 2}, {Event: 3}}, 3},
+		{"used one", []transfers.GameweekRecord{{Event: 1}, {Event: 2}, {Event: 3, Transfers: 1}}, 2},
+		{"took a hit", []transfers.GameweekRecord{{Event: 1}, {Event: 2, Transfers: 3}}, 1},
+		{"capped", []transfers.GameweekRecord{{Event: 1}, {Event: 2}, {Event: 3}, {Event: 4}, {Event: 5}, {Event: 6}, {Event: 7}}, 5},
+		{"wildcard keeps saved", []transfers.GameweekRecord{{Event: 1}, {Event: 2}, {Event: 3, Transfers: 9, Chip: transfers.ChipWildcard}}, 3},
+	}
+
+	for _, c := range cases {
+		if got := transfers.FreeTransfers(c.history, rules); got != c.want {
+			t.Errorf("%s: got %d, want %d", c.name, got, c.want)
+		}
+	}
+}
+
+// plannerMarket builds a 15-man squad of 5.0m players from 15 clubs scoring 3
+// points a gameweek, plus one cheaper forward scoring 6 and an unaffordable
+// forward scoring 9.
+func plannerMarket(events []int) (*transfers.Manager, transfers.Market) {
+	types := []int{
+		projection.Goalkeeper, projection.Goalkeeper,
+		projection.Defender, projection.Defender, projection.Defender, projection.Defender, projection.Defender,
+		projection.Midfielder, projection.Midfielder, projection.Midfielder, projection.Midfielder, projection.Midfielder,
+		projection.Forward, projection.Forward, projection.Forward,
+	}
+
+	manager := &transfers.Manager{ManagerID: 1, SeasonID: 2025, Event: events[0] - 1, Bank: 5, FreeTransfers: 1}
+	market := transfers.Market{
+		Players: make(map[int]squad.Candidate),
+		Points:  make(map[int]map[int]float64),
+		Events:  events,
+	}
+
+	add := func(id, team, elementType, cost int, points float64) {
+		market.Players[id] = squad.Candidate{PlayerID: id, TeamID: team, ElementType: elementType, Cost: cost}
+		market.Points[id] = make(map[int]float64)
+		for _, e := range events {
+			market.Points[id][e] = points
+		}
+	}
+
+	for i, et := range types {
+		add(i+1, i+1, et, 50, 3)
+		manager.Holdings = append(manager.Holdings, transfers.Holding{PlayerID: i + 1, PurchasePrice: 50})
+	}
+	add(100, 16, projection.Forward, 45, 6)
+	add(101, 17, projection.Forward, 120, 9)
+
+	return manager, market
+}
+
+func TestPlannerFindsBestTransfer(t *testing.T) {
+	events := []int{10, 11, 12}
+	manager, market := plannerMarket(events)
+
+	planner := &transfers.Planner{Squad: squad.DefaultRules(), Rules: transfers.DefaultRules(), Options: transfers.DefaultOptions()}
+	plans, err := planner.Plan(manager, market)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	best := plans[0]
+	first := best.Steps[0]
+	if len(first.Transfers) != 1 || first.Transfers[0].In.PlayerID != 100 || first.HitCost != 0 {
+		t.Fatalf("expected a free transfer for player 100 in GW%d, got %+v", events[0], first)
+	}
+	if first.Bank != 5+50-45 {
+		t.Fatalf("bank after transfer: got %d, want %d", first.Bank, 10)
+	}
+
+	// The new forward starts and captains: +3 as a starter and +3 as captain,
+	// over three gameweeks.
+	if best.Gain < 17.9 || best.Gain > 18.1 {
+		t.Fatalf("gain: got %.2f, want 18", best.Gain)
+	}
+	for _, plan := range plans {
+		for _, step := range plan.Steps {
+			for _, tr := range step.Transfers {
+				if tr.In.PlayerID == 101 {
+					t.Fatalf("plan buys an unaffordable player: %+v", plan)
+				}
+			}
+		}
+	}
+}
+
+func TestPlannerChargesHits(t *testing.T) {
+	events := []int{10}
+	manager, market := plannerMarket(events)
+	manager.FreeTransfers = 0
+
+	planner := &transfers.Planner{Squad: squad.DefaultRules(), Rules: transfers.DefaultRules(), Options: transfers.DefaultOptions()}
+	plans, err := planner.Plan(manager, market)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	// A single gameweek gain of 6 beats a 4-point hit.
+	first := plans[0].Steps[0]
+	if len(first.Transfers) != 1 || first.HitCost != 4 {
+		t.Fatalf("expected one transfer for a 4-point hit, got %+v", first)
+	}
+	if gain := plans[0].Gain; gain < 1.9 || gain > 2.1 {
+		t.Fatalf("gain: got %.2f, want 2", gain)
+	}
+}
+
+func TestTransferService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping database test")
+	}
+
+	cfg := config.LoadConfig()
+	fplDb, err := connection.NewRepository(cfg.Postgres.Host, cfg.Postgres.Port, cfg.Postgres.User,
+		cfg.Postgres.Password, cfg.Postgres.FplDatabase, cfg.Postgres.SSLMode)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	defer fplDb.Close()
+
+	var managerID int
+	err = fplDb.DB().QueryRow(`SELECT manager_id FROM manager_picks WHERE season_id = $1 LIMIT 1`,
+		cfg.CurrentSeasonID).Scan(&managerID)
+	if err != nil {
+		t.Skipf("No manager picks: %v", err)
+	}
+
+	service := &transfers.Service{
+		Repo:         transfers.NewRepo(fplDb.DB()),
+		Squads:       squad.NewRepo(fplDb.DB()),
+		Projections:  projection.NewRepo(fplDb.DB()),
+		ModelVersion: projection.ModelVersion,
+		Planner: &transfers.Planner{
+			Squad:   squad.DefaultRules(),
+			Rules:   transfers.DefaultRules(),
+			Options: transfers.DefaultOptions(),
+		},
+	}
+
+	manager, plans, err := service.PlanManager(context.Background(), managerID, cfg.CurrentSeasonID, cfg.Projection.Horizon)
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Logf("Manager %d: %d free transfers, best plan gains %.2f points", manager.ManagerID, manager.FreeTransfers, plans[0].Gain)
+}
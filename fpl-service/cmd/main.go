@@ -8,12 +8,21 @@ import (
 	api "github.com/imadeddine-belkat/fpl-service/internal/api"
 	"github.com/imadeddine-belkat/fpl-service/internal/services"
 	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"go.opentelemetry.io/otel"
 )
 
 func main() {
 	ctx := context.Background()
 
 	cfg := config.LoadConfig()
+
+	shutdownTracing, err := tracing.Setup(ctx, "fpl-service")
+	if err != nil {
+		log.Fatalf("Error setting up tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
 	client := api.NewFplApiClient(cfg)
 	producer := kafka.NewProducer()
 
@@ -23,7 +32,11 @@ func main() {
 		Producer: producer,
 	}
 
-	if err := service.IngestPlayersBootstrap(ctx); err != nil {
+	// One trace for the crawl, from the fetch through every message it publishes.
+	ctx, span := otel.Tracer("github.com/imadeddine-belkat/fpl-service/cmd").Start(ctx, "ingest players bootstrap")
+	err = service.IngestPlayersBootstrap(ctx)
+	tracing.End(span, err)
+	if err != nil {
 		log.Fatalf("Error ingesting player bootstrap data: %v", err)
 	}
 
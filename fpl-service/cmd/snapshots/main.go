@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/imadeddine-belkat/fpl-service/config"
+	api "github.com/imadeddine-belkat/fpl-service/internal/api"
+	"github.com/imadeddine-belkat/fpl-service/internal/services"
+	httpx "github.com/imadeddine-belkat/tactify-http"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+)
+
+func main() {
+	once := flag.Bool("once", false, "capture a snapshot for the next deadline now and exit")
+	flag.Parse()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cfg := config.LoadConfig()
+
+	shutdownTracing, err := tracing.Setup(ctx, "fpl-snapshots")
+	if err != nil {
+		log.Fatalf("Error setting up tracing: %v", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	producer := kafka.NewProducer()
+	defer producer.Close()
+
+	service := &services.SnapshotService{
+		Config:   cfg,
+		Client:   api.NewFplApiClient(cfg),
+		Producer: producer,
+	}
+
+	if *once {
+		if err := service.Capture(ctx); err != nil {
+			log.Fatalf("Error capturing snapshot: %v", err)
+		}
+		return
+	}
+
+	go httpx.Serve(ctx, cfg.MetricsAddr)
+
+	if err := service.Run(ctx); err != nil && ctx.Err() == nil {
+		log.Fatalf("Error running snapshots: %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"strconv"
+	"time"
 
 	kafkaConfig "github.com/imadeddine-belkat/tactify-kafka/config"
 	"github.com/joho/godotenv"
@@ -12,12 +13,16 @@ import (
 type Config struct {
 	FplApi      FplApi
 	PlApi       PlApi
+	Snapshot    Snapshot
 	KafkaConfig kafkaConfig.KafkaConfig
 
 	CurrentSeasonID int32 `envconfig:"FPL_CURRENT_SEASON_ID" required:"true"`
 
 	DeleteWorkerCount  int32 `envconfig:"WORKER_DELETE_POOL_SIZE" default:"10"`
 	PublishWorkerCount int32 `envconfig:"WORKER_PUBLISH_POOL_SIZE" default:"100"`
+
+	// MetricsAddr is where long-running commands serve /metrics.
+	MetricsAddr string `envconfig:"FPL_METRICS_ADDR" default:":9102"`
 }
 
 type FplApi struct {
@@ -42,6 +47,13 @@ type PlApi struct {
 	PlayerStats  string `envconfig:"PLAPI_PLAYER_STATS" required:"true"`
 }
 
+type Snapshot struct {
+	// Lead is how long before a deadline bootstrap-static is captured.
+	Lead time.Duration `envconfig:"SNAPSHOT_LEAD" default:"5m"`
+	// Recheck is how often the next deadline is re-read while waiting for it.
+	Recheck time.Duration `envconfig:"SNAPSHOT_RECHECK" default:"1h"`
+}
+
 type ProcessedModel struct {
 	ID   int32
 	Data []byte
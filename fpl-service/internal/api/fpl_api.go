@@ -6,23 +6,36 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/imadeddine-belkat/fpl-service/config"
+	httpx "github.com/imadeddine-belkat/tactify-http"
 	fplProto "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
 )
 
 type FplApiClient struct {
 	Config     config.Config
 	HttpClient *http.Client
 	UserAgent  string
+	Retry      *httpx.RetryPolicies
 }
 
 func NewFplApiClient(cfg *config.Config) *FplApiClient {
-	return &FplApiClient{
+	client := &FplApiClient{
 		Config:     *cfg,
 		HttpClient: &http.Client{},
 		UserAgent:  "FPL-Service-Client/1.0",
+		Retry:      httpx.NewRetryPolicies(httpx.DefaultRetryPolicy),
 	}
+
+	// The live and picks endpoints return 503 while the game is updating after a deadline,
+	// so give them a longer backoff than the rest.
+	updating := httpx.RetryPolicy{MaxAttempts: 5, BaseDelay: 2 * time.Second, MaxDelay: 30 * time.Second}
+	client.Retry.Set(cfg.FplApi.LiveEvent, updating)
+	client.Retry.Set(cfg.FplApi.EntryPicks, updating)
+
+	return client
 }
 
 func (c *FplApiClient) GetPlayersBootstrap(ctx context.Context) (*fplProto.PlayersBootstrap, error) {
@@ -51,17 +64,34 @@ func (c *FplApiClient) GetAndUnmarshal(ctx context.Context, endpoint string, res
 	}
 
 	if err = json.Unmarshal(data, result); err != nil {
-		return fmt.Errorf("fpl-api: unmarshaling response: %w", err)
+		return fmt.Errorf("fpl-api: unmarshaling response: %w", &httpx.DecodeError{URL: endpoint, Err: err})
 	}
 
 	return nil
 }
 
+// Get fetches endpoint, retrying according to the policy registered for it.
+// Failures are returned as the typed errors from tactify-http.
 func (c *FplApiClient) Get(ctx context.Context, endpoint string) ([]byte, error) {
+	var body []byte
+
+	err := c.Retry.For(endpoint).Do(ctx, func(ctx context.Context) error {
+		var err error
+		body, err = c.get(ctx, endpoint)
+		return err
+	})
+
+	return body, err
+}
+
+func (c *FplApiClient) get(ctx context.Context, endpoint string) (_ []byte, err error) {
 	baseUrl := c.Config.FplApi.BaseUrl
 
 	url := fmt.Sprintf("%s%s", baseUrl, endpoint)
 
+	ctx, span := httpx.StartRequest(ctx, "fpl", "GET", endpoint, url)
+	defer func() { tracing.End(span, err) }()
+
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("fpl-api: creating request: %w", err)
@@ -70,7 +100,10 @@ func (c *FplApiClient) Get(ctx context.Context, endpoint string) ([]byte, error)
 	req.Header.Set("User-Agent", c.UserAgent)
 	req.Header.Set("Accept", "application/json")
 
+	start := time.Now()
 	resp, err := c.HttpClient.Do(req)
+	httpx.ObserveRequest("fpl", endpoint, resp, start)
+	httpx.RecordStatus(span, resp)
 	if err != nil {
 		return nil, fmt.Errorf("fpl-api: making request: %w", err)
 	}
@@ -81,14 +114,14 @@ func (c *FplApiClient) Get(ctx context.Context, endpoint string) ([]byte, error)
 		}
 	}(resp.Body)
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("fpl-api: API request failed with status: %d", resp.StatusCode)
-	}
-
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("fpl-api: reading response body: %w", err)
 	}
 
+	if err = httpx.CheckResponse(url, resp, body); err != nil {
+		return nil, fmt.Errorf("fpl-api: %w", err)
+	}
+
 	return body, nil
 }
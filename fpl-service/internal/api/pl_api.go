@@ -6,14 +6,18 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/imadeddine-belkat/fpl-service/config"
+	httpx "github.com/imadeddine-belkat/tactify-http"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
 )
 
 type PlApiClient struct {
 	Config     config.Config
 	HttpClient *http.Client
 	UserAgent  string
+	Retry      *httpx.RetryPolicies
 }
 
 func NewPlApiClient(cfg *config.Config) *PlApiClient {
@@ -21,14 +25,32 @@ func NewPlApiClient(cfg *config.Config) *PlApiClient {
 		Config:     *cfg,
 		HttpClient: &http.Client{},
 		UserAgent:  "FPL-Service-Client/1.0",
+		Retry:      httpx.NewRetryPolicies(httpx.DefaultRetryPolicy),
 	}
 }
 
+// Get fetches endpoint, retrying according to the policy registered for it.
+// Failures are returned as the typed errors from tactify-http.
 func (c *PlApiClient) Get(ctx context.Context, endpoint string) ([]byte, error) {
+	var body []byte
+
+	err := c.Retry.For(endpoint).Do(ctx, func(ctx context.Context) error {
+		var err error
+		body, err = c.get(ctx, endpoint)
+		return err
+	})
+
+	return body, err
+}
+
+func (c *PlApiClient) get(ctx context.Context, endpoint string) (_ []byte, err error) {
 	baseUrl := c.Config.PlApi.BaseUrl
 
 	url := fmt.Sprintf("%s%s", baseUrl, endpoint)
 
+	ctx, span := httpx.StartRequest(ctx, "pl", "GET", endpoint, url)
+	defer func() { tracing.End(span, err) }()
+
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
 		return nil, fmt.Errorf("pl-api: creating request: %w", err)
@@ -37,7 +59,10 @@ func (c *PlApiClient) Get(ctx context.Context, endpoint string) ([]byte, error)
 	req.Header.Set("User-Agent", c.UserAgent)
 	req.Header.Set("Accept", "application/json")
 
+	start := time.Now()
 	resp, err := c.HttpClient.Do(req)
+	httpx.ObserveRequest("pl", endpoint, resp, start)
+	httpx.RecordStatus(span, resp)
 	if err != nil {
 		return nil, fmt.Errorf("pl-api: making request: %w", err)
 	}
@@ -48,15 +73,15 @@ func (c *PlApiClient) Get(ctx context.Context, endpoint string) ([]byte, error)
 		}
 	}(resp.Body)
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("pl-api: API request failed with status: %d", resp.StatusCode)
-	}
-
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("pl-api: reading response body: %w", err)
 	}
 
+	if err = httpx.CheckResponse(url, resp, body); err != nil {
+		return nil, fmt.Errorf("pl-api: %w", err)
+	}
+
 	return body, nil
 }
 
@@ -67,7 +92,7 @@ func (c *PlApiClient) GetAndUnmarshal(ctx context.Context, endpoint string, resu
 	}
 
 	if err = json.Unmarshal(data, result); err != nil {
-		return fmt.Errorf("pl-api: unmarshaling response: %w", err)
+		return fmt.Errorf("pl-api: unmarshaling response: %w", &httpx.DecodeError{URL: endpoint, Err: err})
 	}
 
 	return nil
@@ -0,0 +1,202 @@
+package services
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/imadeddine-belkat/fpl-service/config"
+	fpl_api "github.com/imadeddine-belkat/fpl-service/internal/api"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/imadeddine-belkat/fpl-service/internal/services")
+
+// SnapshotFormatVersion is the encoding of snapshot payloads: the
+// bootstrap-static response as FPL sent it, gzipped.
+const SnapshotFormatVersion = 1
+
+// SnapshotService publishes the full bootstrap-static response shortly
+// before every gameweek deadline, so that the state the deadline was met
+// with (prices, statuses, ownership, team strengths) can be replayed.
+type SnapshotService struct {
+	Config   *config.Config
+	Client   *fpl_api.FplApiClient
+	Producer *kafka.Producer
+}
+
+// Run captures a snapshot before each deadline until the season has no
+// deadline left or ctx is done. A failed fetch, e.g. while the game is
+// updating around a deadline, is retried after Snapshot.Recheck.
+func (s *SnapshotService) Run(ctx context.Context) error {
+	for {
+		raw, bootstrap, err := s.fetchBootstrap(ctx)
+		if err != nil {
+			log.Printf("Error fetching bootstrap, retrying in %s: %v", s.Config.Snapshot.Recheck, err)
+			if err := sleep(ctx, s.Config.Snapshot.Recheck); err != nil {
+				return err
+			}
+			continue
+		}
+
+		event, deadline, ok := NextDeadline(bootstrap.GetEvents(), time.Now())
+		if !ok {
+			log.Println("No deadline left this season, stopping snapshots")
+			return nil
+		}
+
+		// The deadline can move, so wake up in time to re-read it.
+		at := deadline.Add(-s.Config.Snapshot.Lead)
+		if time.Until(at) > s.Config.Snapshot.Recheck {
+			log.Printf("Next snapshot: GW%d at %s", event.GetId(), at.Format(time.RFC3339))
+			if err := sleep(ctx, s.Config.Snapshot.Recheck); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := sleep(ctx, time.Until(at)); err != nil {
+			return err
+		}
+
+		s.capture(ctx, event, raw)
+
+		if err := sleep(ctx, time.Until(deadline)+time.Minute); err != nil {
+			return err
+		}
+	}
+}
+
+// capture refetches bootstrap-static at the deadline and publishes it,
+// falling back to the earlier fetch raw, in one trace.
+func (s *SnapshotService) capture(ctx context.Context, event *fpl.Event, raw []byte) {
+	ctx, span := tracer.Start(ctx, "snapshot.capture",
+		trace.WithAttributes(attribute.Int("fpl.event", int(event.GetId()))),
+	)
+
+	if latest, _, err := s.fetchBootstrap(ctx); err != nil {
+		log.Printf("Error refetching bootstrap for GW%d, publishing the earlier one: %v", event.GetId(), err)
+	} else {
+		raw = latest
+	}
+	err := s.Publish(ctx, event, raw)
+	if err != nil {
+		log.Printf("Error publishing GW%d snapshot: %v", event.GetId(), err)
+	}
+	tracing.End(span, err)
+}
+
+// Capture fetches bootstrap-static now and publishes it as the snapshot of
+// the next deadline.
+func (s *SnapshotService) Capture(ctx context.Context) (err error) {
+	ctx, span := tracer.Start(ctx, "snapshot.capture")
+	defer func() { tracing.End(span, err) }()
+
+	raw, bootstrap, err := s.fetchBootstrap(ctx)
+	if err != nil {
+		return err
+	}
+
+	event, _, ok := NextDeadline(bootstrap.GetEvents(), time.Now())
+	if !ok {
+		return fmt.Errorf("no deadline left this season")
+	}
+	return s.Publish(ctx, event, raw)
+}
+
+// Publish publishes a raw bootstrap-static response as event's snapshot.
+func (s *SnapshotService) Publish(ctx context.Context, event *fpl.Event, raw []byte) error {
+	msg, err := NewSnapshotMessage(s.Config.CurrentSeasonID, event, raw, time.Now())
+	if err != nil {
+		return err
+	}
+
+	topic := s.Config.KafkaConfig.TopicsName.FplBootstrapSnapshots.Name
+	key := []byte(fmt.Sprintf("%d-%d", msg.GetSeasonId(), msg.GetEvent()))
+	if err := s.Producer.PublishWithProcess(ctx, msg, topic, key); err != nil {
+		return fmt.Errorf("publishing snapshot: %w", err)
+	}
+
+	log.Printf("Published GW%d snapshot: %d bytes, %d gzipped", msg.GetEvent(), len(raw), len(msg.GetPayload()))
+	return nil
+}
+
+func (s *SnapshotService) fetchBootstrap(ctx context.Context) ([]byte, *fpl.BootstrapResponse, error) {
+	raw, err := s.Client.Get(ctx, s.Config.FplApi.Bootstrap)
+	if err != nil {
+		return nil, nil, fmt.Errorf("fetching bootstrap: %w", err)
+	}
+
+	var bootstrap fpl.BootstrapResponse
+	if err := json.Unmarshal(raw, &bootstrap); err != nil {
+		return nil, nil, fmt.Errorf("unmarshaling bootstrap: %w", err)
+	}
+	return raw, &bootstrap, nil
+}
+
+// NextDeadline returns the first event whose deadline is after now.
+func NextDeadline(events []*fpl.Event, now time.Time) (*fpl.Event, time.Time, bool) {
+	var next *fpl.Event
+	var nextDeadline time.Time
+	for _, e := range events {
+		deadline, err := time.Parse(time.RFC3339, e.GetDeadlineTime())
+		if err != nil || !deadline.After(now) {
+			continue
+		}
+		if next == nil || deadline.Before(nextDeadline) {
+			next, nextDeadline = e, deadline
+		}
+	}
+	return next, nextDeadline, next != nil
+}
+
+// NewSnapshotMessage gzips a raw bootstrap-static response into event's
+// snapshot.
+func NewSnapshotMessage(seasonID int32, event *fpl.Event, raw []byte, capturedAt time.Time) (*fpl.BootstrapSnapshotMessage, error) {
+	var buf bytes.Buffer
+	zw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := zw.Write(raw); err != nil {
+		return nil, fmt.Errorf("compressing snapshot: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("compressing snapshot: %w", err)
+	}
+
+	sum := sha256.Sum256(raw)
+	return &fpl.BootstrapSnapshotMessage{
+		SeasonId:      seasonID,
+		Event:         event.GetId(),
+		DeadlineTime:  event.GetDeadlineTime(),
+		CapturedAt:    capturedAt.UTC().Format(time.RFC3339),
+		FormatVersion: SnapshotFormatVersion,
+		Sha256:        hex.EncodeToString(sum[:]),
+		Payload:       buf.Bytes(),
+	}, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
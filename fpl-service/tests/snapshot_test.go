@@ -0,0 +1,42 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/imadeddine-belkat/fpl-service/internal/services"
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+)
+
+func TestNextDeadline(t *testing.T) {
+	events := []*fpl.Event{
+		{Id: 4, DeadlineTime: "2025-09-13T10:00:00Z"},
+		{Id: 6, DeadlineTime: "2025-09-27T10:00:00Z"},
+		{Id: 5, DeadlineTime: "2025-09-20T10:00:00Z"},
+	}
+
+	event, deadline, ok := services.NextDeadline(events, time.Date(2025, 9, 14, 0, 0, 0, 0, time.UTC))
+	if !ok || event.GetId() != 5 || !deadline.Equal(time.Date(2025, 9, 20, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("next deadline: got GW%d at %v", event.GetId(), deadline)
+	}
+
+	if _, _, ok := services.NextDeadline(events, time.Date(2025, 9, 27, 10, 0, 0, 0, time.UTC)); ok {
+		t.Fatal("no deadline is left once the last one has passed")
+	}
+}
+
+func TestNewSnapshotMessage(t *testing.T) {
+	raw := []byte(`{"events": [], "elements": []}`)
+	event := &fpl.Event{Id: 5, DeadlineTime: "2025-09-20T10:00:00Z"}
+
+	msg, err := services.NewSnapshotMessage(2025, event, raw, time.Date(2025, 9, 20, 9, 55, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if msg.GetEvent() != 5 || msg.GetCapturedAt() != "2025-09-20T09:55:00Z" || msg.GetFormatVersion() != services.SnapshotFormatVersion {
+		t.Fatalf("message: got %+v", msg)
+	}
+	if len(msg.GetSha256()) != 64 || len(msg.GetPayload()) == 0 {
+		t.Fatalf("payload: got sha256 %q, %d bytes", msg.GetSha256(), len(msg.GetPayload()))
+	}
+}
@@ -9,8 +9,11 @@ import (
 	"time"
 
 	"github.com/imadeddine-belkat/indexer-service/internal/sofascore_repositories"
+	httpx "github.com/imadeddine-belkat/tactify-http"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
 	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
 	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
 	_ "github.com/lib/pq"
 
 	"github.com/imadeddine-belkat/indexer-service/config"
@@ -25,6 +28,11 @@ func main() {
 	cfg := config.LoadConfig()
 	kafkaCfg := &cfg.Kafka
 
+	shutdownTracing, err := tracing.Setup(context.Background(), "indexer-service")
+	if err != nil {
+		log.Fatal("Failed to set up tracing:", err)
+	}
+
 	// Connect to database
 	fplDb, err := connection.NewRepository(
 		cfg.Postgres.Host,
@@ -81,21 +89,43 @@ func main() {
 		&fpl.EntryHistoryMessage{},
 	)
 
+	fplBonusRepo := fpl_repositories.NewBonusRepo(
+		fplDb.DB(),
+		&fpl.BonusCorrectionMessage{},
+	)
+
+	fplAvailabilityRepo := fpl_repositories.NewAvailabilityRepo(
+		fplDb.DB(),
+		&fpl.PlayerAvailabilityChangeMessage{},
+	)
+
+	fplSnapshotRepo := fpl_repositories.NewSnapshotRepo(
+		fplDb.DB(),
+		&fpl.BootstrapSnapshotMessage{},
+	)
+
 	sofascoreTeamReop := sofascore_repositories.NewTeamRepo(
 		sofascoreDb.DB(),
 		&sofascore.StandingMessage{},
 		&sofascore.TeamOverallStatsMessage{},
 		&sofascore.MatchStatsMessage{},
+		&sofascore.TopTeamStatItem{},
 	)
 
 	sofacorePlayerRepo := sofascore_repositories.NewPlayerRepo(
 		sofascoreDb.DB(),
 		&sofascore.PlayerMessage{},
+		&sofascore.PlayerMatchStatsMessage{},
+		&sofascore.PlayerSeasonStatsMessage{},
+		&sofascore.PlayerAttributesMessage{},
 	)
 
 	sofascoreMatchRepo := sofascore_repositories.NewMatchRepo(
 		sofascoreDb.DB(),
 		&sofascore.Event{},
+		&sofascore.MatchLineupMessage{},
+		&sofascore.MatchH2HMessage{},
+		&sofascore.MatchBestPlayersMessage{},
 	)
 
 	sofascoreLeagueRepo := sofascore_repositories.NewLeagueRepo(
@@ -103,6 +133,10 @@ func main() {
 		&sofascore.LeagueUniqueTournaments{},
 	)
 
+	// Bonus corrections and availability changes are published back to Kafka
+	producer := kafka.NewProducer()
+	defer producer.Close()
+
 	// 3. Initialize Handler
 	FplHandler := fpl_handler.NewHandler(
 		cfg,
@@ -111,6 +145,10 @@ func main() {
 		FplTeamRepo,
 		fplFixtureRepo,
 		FplManagerRepo,
+		fplBonusRepo,
+		fplAvailabilityRepo,
+		fplSnapshotRepo,
+		producer,
 	)
 
 	sofascoreHandler := sofascore_handler.NewHandler(
@@ -126,6 +164,8 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	go httpx.Serve(ctx, cfg.MetricsAddr)
+
 	// 5. Start Consumers in Background Goroutines
 	// The Route method uses 'go fn(ctx)' internally, so these are non-blocking calls
 	log.Println("🚀 Starting Kafka consumers...")
@@ -142,6 +182,7 @@ func main() {
 	FplHandler.Route(ctx, kafkaCfg.TopicsName.FplEntryPicks.Name)
 	FplHandler.Route(ctx, kafkaCfg.TopicsName.FplEntryTransfers.Name)
 	FplHandler.Route(ctx, kafkaCfg.TopicsName.FplEntryHistory.Name)
+	FplHandler.Route(ctx, kafkaCfg.TopicsName.FplBootstrapSnapshots.Name)
 
 	// Sofascore Topics
 	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascoreLeagueStandings.Name)
@@ -151,6 +192,13 @@ func main() {
 	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascoreLeagueRoundMatches.Name)
 	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascoreLeagueIDs.Name)
 	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascoreLeagueSeasons.Name)
+	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascoreMatchLineups.Name)
+	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascorePlayerMatchStats.Name)
+	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascoreTopTeamsStats.Name)
+	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascorePlayerSeasonsStats.Name)
+	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascorePlayerAttributes.Name)
+	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascoreMatchH2hHistory.Name)
+	sofascoreHandler.Route(ctx, kafkaCfg.TopicsName.SofascoreMatchBestPlayers.Name)
 
 	log.Println("✅ All Kafka consumers started.")
 
@@ -167,5 +215,11 @@ func main() {
 	cancel()
 
 	time.Sleep(2 * time.Second)
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Printf("Error flushing traces: %v", err)
+	}
 	log.Println("🏁 Shutdown complete.")
 }
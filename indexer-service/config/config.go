@@ -15,6 +15,7 @@ type IndexerConfig struct {
 	WorkerCount   int
 	BatchSize     int           `envconfig:"DB_BATCH_SIZE" default:"100"`
 	FlushInterval time.Duration `envconfig:"DB_FLUSH_INTERVAL" default:"30s"`
+	MetricsAddr   string        `envconfig:"INDEXER_METRICS_ADDR" default:":9101"`
 }
 
 type PostgresConfig struct {
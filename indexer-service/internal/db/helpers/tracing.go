@@ -0,0 +1,21 @@
+package helpers
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/imadeddine-belkat/indexer-service/internal/db")
+
+// StartSpan starts the span of a repository call, e.g. "PlayerRepo.InsertPlayers".
+// Callers end it with tracing.End(span, err) so failed calls are marked.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("db.system.name", "postgresql")),
+		trace.WithAttributes(attrs...),
+	)
+}
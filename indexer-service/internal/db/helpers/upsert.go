@@ -5,9 +5,13 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
-	"github.com/imadeddine-belkat/indexer-service/config"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type Executor interface {
@@ -20,15 +24,40 @@ type UpsertOpts struct {
 	ConflictCols []string
 	SkipUpdate   []string
 	Rows         [][]any
-	config       *config.IndexerConfig
+	BatchSize    int // rows per INSERT; defaults to DefaultBatchSize
 }
 
-func BatchUpsert(ctx context.Context, db Executor, opts UpsertOpts) error {
+var upsertDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "tactify",
+	Subsystem: "db",
+	Name:      "upsert_duration_seconds",
+	Help:      "Time taken by BatchUpsert across all of its chunks, by table.",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"table"})
+
+// DefaultBatchSize keeps a chunk well under Postgres' 65535 bind parameter limit for wide tables.
+const DefaultBatchSize = 500
+
+func BatchUpsert(ctx context.Context, db Executor, opts UpsertOpts) (err error) {
 	if len(opts.Rows) == 0 {
 		return nil
 	}
 
-	batchSize := opts.config.BatchSize
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	ctx, span := StartSpan(ctx, "upsert "+opts.Table,
+		attribute.String("db.collection.name", opts.Table),
+		attribute.Int("db.operation.batch.size", len(opts.Rows)),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	start := time.Now()
+	defer func() {
+		upsertDuration.WithLabelValues(opts.Table).Observe(time.Since(start).Seconds())
+	}()
 
 	suffix := buildUpsertSuffix(opts.Columns, opts.ConflictCols, opts.SkipUpdate)
 
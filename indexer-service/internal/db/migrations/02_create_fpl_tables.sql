@@ -45,6 +45,10 @@ CREATE TABLE IF NOT EXISTS players (
                                        team_code INTEGER,
                                        element_type_id INTEGER,
                                        status VARCHAR(50),
+                                       news TEXT,
+                                       news_added TIMESTAMP,
+                                       chance_of_playing_this_round INTEGER,
+                                       chance_of_playing_next_round INTEGER,
                                        photo VARCHAR(255),
                                        squad_number INTEGER,
                                        birth_date DATE,
@@ -80,6 +84,10 @@ CREATE TABLE IF NOT EXISTS player_costs (
                                             cost_change_event_fall INTEGER,
                                             cost_change_start INTEGER,
                                             cost_change_start_fall INTEGER,
+                                            transfers_in INTEGER,
+                                            transfers_out INTEGER,
+                                            transfers_in_event INTEGER,
+                                            transfers_out_event INTEGER,
                                             updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 
                                             PRIMARY KEY (player_id, season_id),
@@ -201,6 +209,7 @@ CREATE TABLE IF NOT EXISTS fixtures (
                                         team_h_score INTEGER,
                                         team_a_score INTEGER,
                                         finished BOOLEAN,
+                                        finished_provisional BOOLEAN,
                                         minutes INTEGER,
                                         provisional_start_time BOOLEAN,
                                         team_h_difficulty INTEGER,
@@ -297,6 +306,9 @@ CREATE TABLE IF NOT EXISTS player_gameweek_explain (
                                                        points INTEGER,
                                                        value INTEGER,
                                                        points_modification INTEGER,
+    -- Bonus computed from live BPS before FPL confirms it; the official bonus
+    -- row replaces it
+                                                       provisional BOOLEAN DEFAULT FALSE,
                                                        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 
                                                        PRIMARY KEY (player_id, season_id, fixture_id, identifier),
@@ -305,6 +317,65 @@ CREATE TABLE IF NOT EXISTS player_gameweek_explain (
                                                        CONSTRAINT fk_gw_explain_fixture FOREIGN KEY (fixture_id, season_id) REFERENCES fixtures(fixture_id, season_id)
 );
 
+-- Player Availability Changes (status and news history; players only keeps the latest)
+CREATE TABLE IF NOT EXISTS player_availability_changes (
+                                                           id BIGSERIAL PRIMARY KEY,
+                                                           player_id INTEGER NOT NULL,
+                                                           season_id INTEGER NOT NULL,
+                                                           old_status VARCHAR(50),
+                                                           new_status VARCHAR(50),
+                                                           old_news TEXT,
+                                                           news TEXT,
+                                                           news_added TIMESTAMP,
+                                                           old_chance_of_playing_next_round INTEGER,
+                                                           chance_of_playing_next_round INTEGER,
+                                                           changed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+                                                           CONSTRAINT fk_availability_player FOREIGN KEY (player_id, season_id) REFERENCES players(player_id, season_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_availability_changes_changed ON player_availability_changes (season_id, changed_at DESC);
+
+-- Player Attribute History (slowly-changing, type 2: one row per version, the current one without valid_to).
+-- A player's price at a deadline:
+--   SELECT now_cost FROM player_attribute_history
+--   WHERE player_id = $1 AND season_id = $2 AND valid_from <= $3 AND (valid_to IS NULL OR valid_to > $3)
+CREATE TABLE IF NOT EXISTS player_attribute_history (
+                                                        player_id INTEGER NOT NULL,
+                                                        season_id INTEGER NOT NULL,
+                                                        team_id INTEGER,
+                                                        element_type_id INTEGER,
+                                                        now_cost INTEGER,
+                                                        selected_rank INTEGER,
+                                                        valid_from TIMESTAMP NOT NULL,
+                                                        valid_to TIMESTAMP,
+
+                                                        PRIMARY KEY (player_id, season_id, valid_from),
+                                                        CONSTRAINT fk_attribute_history_player FOREIGN KEY (player_id, season_id) REFERENCES players(player_id, season_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_player_attribute_history_current ON player_attribute_history (player_id, season_id) WHERE valid_to IS NULL;
+
+-- Team Attribute History (slowly-changing, type 2, as player_attribute_history)
+CREATE TABLE IF NOT EXISTS team_attribute_history (
+                                                      team_id INTEGER NOT NULL,
+                                                      season_id INTEGER NOT NULL,
+                                                      strength INTEGER,
+                                                      strength_overall_home INTEGER,
+                                                      strength_overall_away INTEGER,
+                                                      strength_attack_home INTEGER,
+                                                      strength_attack_away INTEGER,
+                                                      strength_defence_home INTEGER,
+                                                      strength_defence_away INTEGER,
+                                                      valid_from TIMESTAMP NOT NULL,
+                                                      valid_to TIMESTAMP,
+
+                                                      PRIMARY KEY (team_id, season_id, valid_from),
+                                                      CONSTRAINT fk_attribute_history_team FOREIGN KEY (team_id, season_id) REFERENCES teams(team_id, season_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_team_attribute_history_current ON team_attribute_history (team_id, season_id) WHERE valid_to IS NULL;
+
 -- Player Past Seasons (Note: Links via player_code conceptually, no strict FK to players due to multi-season redundancy)
 CREATE TABLE IF NOT EXISTS player_past_seasons (
                                                    player_code INTEGER NOT NULL,
@@ -480,4 +551,24 @@ CREATE TABLE IF NOT EXISTS manager_chips (
                                              PRIMARY KEY (manager_id, season_id, event, chip_name),
 
                                              CONSTRAINT fk_chips_manager FOREIGN KEY (manager_id, season_id) REFERENCES managers(manager_id, season_id)
+);
+
+-- ==========================================
+-- 6. SNAPSHOTS
+-- ==========================================
+
+-- Bootstrap Snapshots (bootstrap-static as it was just before each deadline, gzipped;
+-- format_version numbers the payload encoding, version the captures of a gameweek)
+CREATE TABLE IF NOT EXISTS bootstrap_snapshots (
+                                                   season_id INTEGER NOT NULL,
+                                                   event INTEGER NOT NULL,
+                                                   version INTEGER NOT NULL,
+                                                   deadline_time TIMESTAMP,
+                                                   captured_at TIMESTAMP,
+                                                   format_version INTEGER NOT NULL,
+                                                   sha256 VARCHAR(64) NOT NULL,
+                                                   payload BYTEA NOT NULL,
+                                                   created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                   PRIMARY KEY (season_id, event, version)
 );
\ No newline at end of file
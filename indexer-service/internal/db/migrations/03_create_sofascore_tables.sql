@@ -64,6 +64,8 @@ CREATE TABLE IF NOT EXISTS matches (
                                        round VARCHAR(50),
                                        status VARCHAR(50),
                                        status_description VARCHAR(255),
+                                       home_score INTEGER, -- after normal time, NULL before kick-off
+                                       away_score INTEGER,
                                        updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
 
                                        PRIMARY KEY (match_id, season_id, league_id),
@@ -441,4 +443,310 @@ CREATE TABLE IF NOT EXISTS match_goalkeeping (
 
                                                  PRIMARY KEY (match_id, period),
                                                  CONSTRAINT fk_goalkeeping_match FOREIGN KEY (match_id) REFERENCES matches(match_id)
-);
\ No newline at end of file
+);
+-- ==========================================
+-- 3. LINEUPS & PLAYER MATCH STATS
+-- ==========================================
+
+-- Match Lineups (one row per team per match)
+CREATE TABLE IF NOT EXISTS match_lineups (
+                                             match_id INTEGER NOT NULL,
+                                             team_id INTEGER NOT NULL,
+                                             season_id INTEGER NOT NULL,
+                                             league_id INTEGER NOT NULL,
+                                             round INTEGER,
+                                             is_home BOOLEAN NOT NULL,
+                                             formation VARCHAR(20),
+                                             confirmed BOOLEAN,
+                                             updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                             PRIMARY KEY (match_id, team_id),
+                                             CONSTRAINT fk_lineups_match FOREIGN KEY (match_id) REFERENCES matches(match_id)
+);
+
+-- Lineup Players (starters and substitutes)
+CREATE TABLE IF NOT EXISTS match_lineup_players (
+                                                    match_id INTEGER NOT NULL,
+                                                    team_id INTEGER NOT NULL,
+                                                    player_id INTEGER NOT NULL,
+                                                    player_name VARCHAR(255),
+                                                    position VARCHAR(10),
+                                                    shirt_number INTEGER,
+                                                    substitute BOOLEAN NOT NULL DEFAULT FALSE,
+                                                    captain BOOLEAN NOT NULL DEFAULT FALSE,
+                                                    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                    PRIMARY KEY (match_id, player_id),
+                                                    CONSTRAINT fk_lineup_players_lineup FOREIGN KEY (match_id, team_id) REFERENCES match_lineups(match_id, team_id)
+);
+
+-- Missing Players (injured / suspended / doubtful at kickoff)
+CREATE TABLE IF NOT EXISTS match_missing_players (
+                                                     match_id INTEGER NOT NULL,
+                                                     team_id INTEGER NOT NULL,
+                                                     player_id INTEGER NOT NULL,
+                                                     player_name VARCHAR(255),
+                                                     type VARCHAR(20),
+                                                     reason INTEGER,
+                                                     description VARCHAR(255),
+                                                     expected_end_date VARCHAR(50),
+                                                     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                     PRIMARY KEY (match_id, player_id),
+                                                     CONSTRAINT fk_missing_players_lineup FOREIGN KEY (match_id, team_id) REFERENCES match_lineups(match_id, team_id)
+);
+
+-- Player Match Stats
+CREATE TABLE IF NOT EXISTS player_match_stats (
+                                                  match_id INTEGER NOT NULL,
+                                                  player_id INTEGER NOT NULL,
+                                                  team_id INTEGER,
+                                                  season_id INTEGER NOT NULL,
+                                                  league_id INTEGER NOT NULL,
+                                                  round INTEGER,
+                                                  player_name VARCHAR(255),
+                                                  position VARCHAR(10),
+                                                  substitute BOOLEAN,
+                                                  captain BOOLEAN,
+
+    -- General
+                                                  minutes_played INTEGER,
+                                                  rating DECIMAL,
+                                                  rating_original DECIMAL,
+                                                  rating_alternative DECIMAL,
+                                                  touches INTEGER,
+
+    -- Passing
+                                                  total_pass INTEGER,
+                                                  accurate_pass INTEGER,
+                                                  total_long_balls INTEGER,
+                                                  accurate_long_balls INTEGER,
+                                                  accurate_own_half_passes INTEGER,
+                                                  total_own_half_passes INTEGER,
+                                                  accurate_opposition_half_passes INTEGER,
+                                                  total_opposition_half_passes INTEGER,
+                                                  total_cross INTEGER,
+                                                  accurate_cross INTEGER,
+                                                  key_pass INTEGER,
+                                                  goal_assist INTEGER,
+                                                  big_chance_created INTEGER,
+
+    -- Shooting
+                                                  total_shots INTEGER,
+                                                  shot_off_target INTEGER,
+                                                  on_target_scoring_attempt INTEGER,
+                                                  blocked_scoring_attempt INTEGER,
+                                                  hit_woodwork INTEGER,
+                                                  goals INTEGER,
+                                                  big_chance_missed INTEGER,
+                                                  expected_goals DECIMAL,
+                                                  expected_goals_on_target DECIMAL,
+                                                  expected_assists DECIMAL,
+
+    -- Defending
+                                                  total_tackle INTEGER,
+                                                  won_tackle INTEGER,
+                                                  total_clearance INTEGER,
+                                                  interception_won INTEGER,
+                                                  outfielder_block INTEGER,
+                                                  ball_recovery INTEGER,
+                                                  error_lead_to_a_shot INTEGER,
+                                                  error_lead_to_a_goal INTEGER,
+
+    -- Duels & Possession
+                                                  duel_lost INTEGER,
+                                                  duel_won INTEGER,
+                                                  aerial_lost INTEGER,
+                                                  aerial_won INTEGER,
+                                                  total_contest INTEGER,
+                                                  won_contest INTEGER,
+                                                  challenge_lost INTEGER,
+                                                  possession_lost_ctrl INTEGER,
+                                                  unsuccessful_touch INTEGER,
+                                                  dispossessed INTEGER,
+                                                  fouls INTEGER,
+                                                  was_fouled INTEGER,
+                                                  total_offside INTEGER,
+
+    -- Goalkeeping
+                                                  saves INTEGER,
+                                                  total_keeper_sweeper INTEGER,
+                                                  accurate_keeper_sweeper INTEGER,
+                                                  keeper_save_value DECIMAL,
+                                                  goals_prevented DECIMAL,
+
+    -- Value Models
+                                                  shot_value_normalized DECIMAL,
+                                                  pass_value_normalized DECIMAL,
+                                                  dribble_value_normalized DECIMAL,
+                                                  defensive_value_normalized DECIMAL,
+                                                  goalkeeper_value_normalized DECIMAL,
+
+                                                  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+                                                  PRIMARY KEY (match_id, player_id),
+                                                  CONSTRAINT fk_player_match_stats_match FOREIGN KEY (match_id) REFERENCES matches(match_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_match_stats_player ON player_match_stats (player_id, season_id);
+
+-- Match H2H (record between the two sides before the match, from the home
+-- side's point of view; manager columns are NULL when Sofascore has no manager duel)
+CREATE TABLE IF NOT EXISTS match_h2h (
+                                         match_id INTEGER NOT NULL,
+                                         season_id INTEGER NOT NULL,
+                                         league_id INTEGER NOT NULL,
+                                         home_team_id INTEGER,
+                                         away_team_id INTEGER,
+                                         team_home_wins INTEGER,
+                                         team_away_wins INTEGER,
+                                         team_draws INTEGER,
+                                         manager_home_wins INTEGER,
+                                         manager_away_wins INTEGER,
+                                         manager_draws INTEGER,
+                                         updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                         PRIMARY KEY (match_id),
+                                         CONSTRAINT fk_h2h_match FOREIGN KEY (match_id) REFERENCES matches(match_id)
+);
+
+-- Match Best Players (top rated players per side, rank 1 is the best)
+CREATE TABLE IF NOT EXISTS match_best_players (
+                                                  match_id INTEGER NOT NULL,
+                                                  player_id INTEGER NOT NULL,
+                                                  team_id INTEGER,
+                                                  season_id INTEGER NOT NULL,
+                                                  league_id INTEGER NOT NULL,
+                                                  player_name VARCHAR(255),
+                                                  is_home BOOLEAN NOT NULL,
+                                                  rank INTEGER,
+                                                  label VARCHAR(50),
+                                                  value DECIMAL,
+                                                  player_of_the_match BOOLEAN NOT NULL DEFAULT FALSE,
+                                                  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                  PRIMARY KEY (match_id, player_id),
+                                                  CONSTRAINT fk_best_players_match FOREIGN KEY (match_id) REFERENCES matches(match_id)
+);
+
+-- ==========================================
+-- 4. LEADERBOARDS
+-- ==========================================
+
+-- Top Teams Leaderboard (latest position per stat)
+CREATE TABLE IF NOT EXISTS top_teams_leaderboard (
+                                                     season_id INTEGER NOT NULL,
+                                                     league_id INTEGER NOT NULL,
+                                                     stat_label VARCHAR(50) NOT NULL,
+                                                     team_id INTEGER NOT NULL,
+                                                     value DECIMAL,
+                                                     rank INTEGER,
+                                                     matches INTEGER,
+                                                     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                     PRIMARY KEY (season_id, league_id, stat_label, team_id),
+                                                     CONSTRAINT fk_leaderboard_team FOREIGN KEY (team_id, league_id) REFERENCES teams(team_id, league_id),
+                                                     CONSTRAINT fk_leaderboard_season FOREIGN KEY (season_id) REFERENCES seasons(season_id)
+);
+
+-- Top Teams Leaderboard History (one snapshot per capture date, so re-runs
+-- within a day overwrite instead of piling up while rank changes between
+-- matchdays are kept)
+CREATE TABLE IF NOT EXISTS top_teams_leaderboard_history (
+                                                             season_id INTEGER NOT NULL,
+                                                             league_id INTEGER NOT NULL,
+                                                             stat_label VARCHAR(50) NOT NULL,
+                                                             team_id INTEGER NOT NULL,
+                                                             recorded_on DATE NOT NULL,
+                                                             matches INTEGER,
+                                                             value DECIMAL,
+                                                             rank INTEGER,
+                                                             recorded_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                             PRIMARY KEY (season_id, league_id, stat_label, team_id, recorded_on),
+                                                             CONSTRAINT fk_leaderboard_history_current FOREIGN KEY (season_id, league_id, stat_label, team_id)
+                                                                 REFERENCES top_teams_leaderboard(season_id, league_id, stat_label, team_id)
+);
+
+-- ==========================================
+-- 5. PLAYER SEASON STATS & ATTRIBUTES
+-- ==========================================
+
+-- Player Season Stats (one row per competition, season, team and stat type of
+-- /player/{id}/statistics; covers competitions outside the tracked leagues, so
+-- no foreign keys to seasons or teams)
+CREATE TABLE IF NOT EXISTS player_season_stats (
+                                                   player_id INTEGER NOT NULL,
+                                                   unique_tournament_id INTEGER NOT NULL,
+                                                   season_id INTEGER NOT NULL,
+                                                   team_id INTEGER NOT NULL,
+                                                   stat_type VARCHAR(20) NOT NULL,
+                                                   unique_tournament_name VARCHAR(255),
+                                                   season_name VARCHAR(255),
+                                                   season_year VARCHAR(20),
+                                                   start_year INTEGER,
+                                                   end_year INTEGER,
+                                                   team_name VARCHAR(255),
+
+                                                   appearances INTEGER,
+                                                   minutes_played INTEGER,
+                                                   rating DECIMAL,
+                                                   total_rating DECIMAL,
+                                                   count_rating INTEGER,
+                                                   goals INTEGER,
+                                                   assists INTEGER,
+                                                   goals_assists_sum INTEGER,
+                                                   expected_goals DECIMAL,
+                                                   expected_assists DECIMAL,
+                                                   big_chances_created INTEGER,
+                                                   big_chances_missed INTEGER,
+                                                   total_shots INTEGER,
+                                                   shots_on_target INTEGER,
+                                                   shots_from_inside_the_box INTEGER,
+                                                   key_passes INTEGER,
+                                                   pass_to_assist INTEGER,
+                                                   total_passes INTEGER,
+                                                   accurate_passes INTEGER,
+                                                   accurate_passes_percentage DECIMAL,
+                                                   total_long_balls INTEGER,
+                                                   accurate_long_balls INTEGER,
+                                                   accurate_long_balls_percentage DECIMAL,
+                                                   total_cross INTEGER,
+                                                   accurate_crosses INTEGER,
+                                                   accurate_crosses_percentage DECIMAL,
+                                                   successful_dribbles INTEGER,
+                                                   dribbled_past INTEGER,
+                                                   tackles INTEGER,
+                                                   interceptions INTEGER,
+                                                   blocked_shots INTEGER,
+                                                   outfielder_blocks INTEGER,
+                                                   aerial_duels_won INTEGER,
+                                                   error_lead_to_goal INTEGER,
+                                                   clean_sheet INTEGER,
+                                                   goals_conceded INTEGER,
+                                                   saves INTEGER,
+                                                   yellow_cards INTEGER,
+                                                   red_cards INTEGER,
+                                                   updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                   PRIMARY KEY (player_id, unique_tournament_id, season_id, team_id, stat_type)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_season_stats_season ON player_season_stats (unique_tournament_id, season_id);
+
+-- Player Attributes (kind is 'player' for the player's own profile and
+-- 'average' for the position average it is compared against; year_shift 0 is
+-- the current year, 1 the year before, ...)
+CREATE TABLE IF NOT EXISTS player_attributes (
+                                                 player_id INTEGER NOT NULL,
+                                                 kind VARCHAR(10) NOT NULL,
+                                                 year_shift INTEGER NOT NULL,
+                                                 position VARCHAR(5),
+                                                 attacking INTEGER,
+                                                 technical INTEGER,
+                                                 tactical INTEGER,
+                                                 defending INTEGER,
+                                                 creativity INTEGER,
+                                                 updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                 PRIMARY KEY (player_id, kind, year_shift)
+);
@@ -0,0 +1,375 @@
+-- Analytics (projections, backtests, prices, difficulty, ratings, goals, minutes, snapshots) Database Schema
+\connect fpl;
+
+-- ==========================================
+-- 1. PROJECTIONS
+-- ==========================================
+
+-- Player Projections (one row per player per fixture per model version; double
+-- gameweeks have two rows for the same event, blanks have none)
+CREATE TABLE IF NOT EXISTS player_projections (
+                                                  season_id INTEGER NOT NULL,
+                                                  event INTEGER NOT NULL,
+                                                  player_id INTEGER NOT NULL,
+                                                  fixture_id INTEGER NOT NULL,
+                                                  model_version VARCHAR(50) NOT NULL,
+                                                  opponent_team_id INTEGER,
+                                                  was_home BOOLEAN,
+                                                  x_minutes DECIMAL,
+                                                  p_play DECIMAL,
+                                                  p_60 DECIMAL,
+                                                  xg DECIMAL,
+                                                  xa DECIMAL,
+                                                  p_clean_sheet DECIMAL,
+                                                  x_conceded DECIMAL,
+                                                  x_points DECIMAL,
+                                                  inputs JSONB,
+                                                  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                  PRIMARY KEY (season_id, event, player_id, fixture_id, model_version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_projections_event ON player_projections (season_id, model_version, event);
+
+-- Expected points per player per gameweek
+CREATE OR REPLACE VIEW player_projection_gameweeks AS
+SELECT season_id, event, player_id, model_version,
+       COUNT(*)      AS fixtures,
+       SUM(x_minutes) AS x_minutes,
+       SUM(x_points)  AS x_points
+FROM player_projections
+GROUP BY season_id, event, player_id, model_version;
+
+-- ==========================================
+-- 2. BACKTESTS
+-- ==========================================
+
+-- Backtest Runs (one row per predictor replayed over a range of gameweeks)
+CREATE TABLE IF NOT EXISTS backtest_runs (
+                                             run_id BIGSERIAL PRIMARY KEY,
+                                             predictor VARCHAR(50) NOT NULL,
+                                             model_version VARCHAR(50) NOT NULL,
+                                             season_id INTEGER NOT NULL,
+                                             from_event INTEGER NOT NULL,
+                                             to_event INTEGER NOT NULL,
+                                             params JSONB,
+                                             created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Backtest Samples (prediction vs outcome per player per fixture)
+CREATE TABLE IF NOT EXISTS backtest_samples (
+                                                run_id BIGINT NOT NULL REFERENCES backtest_runs(run_id) ON DELETE CASCADE,
+                                                event INTEGER NOT NULL,
+                                                player_id INTEGER NOT NULL,
+                                                fixture_id INTEGER NOT NULL,
+                                                element_type INTEGER,
+                                                value INTEGER,
+                                                predicted DECIMAL,
+                                                actual DECIMAL,
+
+                                                PRIMARY KEY (run_id, player_id, fixture_id)
+);
+
+-- Backtest Metrics (segment_type: 'overall', 'position', 'price_band')
+CREATE TABLE IF NOT EXISTS backtest_metrics (
+                                                run_id BIGINT NOT NULL REFERENCES backtest_runs(run_id) ON DELETE CASCADE,
+                                                segment_type VARCHAR(20) NOT NULL,
+                                                segment VARCHAR(20) NOT NULL,
+                                                n INTEGER,
+                                                mae DECIMAL,
+                                                rmse DECIMAL,
+                                                bias DECIMAL,
+                                                mean_predicted DECIMAL,
+                                                mean_actual DECIMAL,
+
+                                                PRIMARY KEY (run_id, segment_type, segment)
+);
+
+-- Backtest Calibration (bin_upper is NULL for the open-ended top bin)
+CREATE TABLE IF NOT EXISTS backtest_calibration (
+                                                    run_id BIGINT NOT NULL REFERENCES backtest_runs(run_id) ON DELETE CASCADE,
+                                                    segment_type VARCHAR(20) NOT NULL,
+                                                    segment VARCHAR(20) NOT NULL,
+                                                    bin_lower DECIMAL NOT NULL,
+                                                    bin_upper DECIMAL,
+                                                    n INTEGER,
+                                                    mean_predicted DECIMAL,
+                                                    mean_actual DECIMAL,
+
+                                                    PRIMARY KEY (run_id, segment_type, segment, bin_lower)
+);
+
+-- ==========================================
+-- 3. PRICES
+-- ==========================================
+
+-- Price Snapshots (one row per player each time player_costs is refreshed;
+-- transfers_*_event restart at every deadline)
+CREATE TABLE IF NOT EXISTS player_price_snapshots (
+                                                      season_id INTEGER NOT NULL,
+                                                      player_id INTEGER NOT NULL,
+                                                      taken_at TIMESTAMP NOT NULL,
+                                                      event INTEGER,
+                                                      now_cost INTEGER,
+                                                      transfers_in_event INTEGER,
+                                                      transfers_out_event INTEGER,
+                                                      selected_by_percent DECIMAL,
+
+                                                      PRIMARY KEY (season_id, player_id, taken_at)
+);
+
+-- Price Changes (detected between two snapshots; net_transfers is the net
+-- transfers counted since the previous change)
+CREATE TABLE IF NOT EXISTS player_price_changes (
+                                                    season_id INTEGER NOT NULL,
+                                                    player_id INTEGER NOT NULL,
+                                                    detected_at TIMESTAMP NOT NULL,
+                                                    event INTEGER,
+                                                    old_cost INTEGER,
+                                                    new_cost INTEGER,
+                                                    net_transfers INTEGER,
+                                                    selected_by_percent DECIMAL,
+
+                                                    PRIMARY KEY (season_id, player_id, detected_at)
+);
+
+-- Price Progress (latest estimate per player; progress is signed, +1 at the
+-- rise threshold and -1 at the fall threshold, eta_hours NULL when the
+-- transfers do not head towards either)
+CREATE TABLE IF NOT EXISTS player_price_progress (
+                                                     season_id INTEGER NOT NULL,
+                                                     player_id INTEGER NOT NULL,
+                                                     event INTEGER,
+                                                     now_cost INTEGER,
+                                                     selected_by_percent DECIMAL,
+                                                     net_since_change INTEGER,
+                                                     rise_threshold INTEGER,
+                                                     fall_threshold INTEGER,
+                                                     progress DECIMAL,
+                                                     hourly_rate DECIMAL,
+                                                     eta_hours DECIMAL,
+                                                     last_change_at TIMESTAMP,
+                                                     updated_at TIMESTAMP,
+
+                                                     PRIMARY KEY (season_id, player_id)
+);
+
+-- ==========================================
+-- 4. FIXTURE DIFFICULTY
+-- ==========================================
+
+-- Team Ratings (as of the deadline of event; attack and defence relative to
+-- the league average at each venue, a defence above 1 concedes more)
+CREATE TABLE IF NOT EXISTS team_ratings (
+                                            season_id INTEGER NOT NULL,
+                                            event INTEGER NOT NULL,
+                                            team_id INTEGER NOT NULL,
+                                            model_version VARCHAR(50) NOT NULL,
+                                            attack_home DECIMAL,
+                                            attack_away DECIMAL,
+                                            defence_home DECIMAL,
+                                            defence_away DECIMAL,
+                                            matches INTEGER,
+                                            league_home_xg DECIMAL,
+                                            league_away_xg DECIMAL,
+                                            created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                            PRIMARY KEY (season_id, event, team_id, model_version)
+);
+
+-- Fixture Difficulty (one row per side of a fixture; difficulties run from 1,
+-- easiest, to 5 like FPL's, kept in fpl_difficulty)
+CREATE TABLE IF NOT EXISTS fixture_difficulty (
+                                                  season_id INTEGER NOT NULL,
+                                                  event INTEGER NOT NULL,
+                                                  fixture_id INTEGER NOT NULL,
+                                                  team_id INTEGER NOT NULL,
+                                                  model_version VARCHAR(50) NOT NULL,
+                                                  opponent_team_id INTEGER,
+                                                  was_home BOOLEAN,
+                                                  x_goals_for DECIMAL,
+                                                  x_goals_against DECIMAL,
+                                                  attack_difficulty DECIMAL,
+                                                  defence_difficulty DECIMAL,
+                                                  fpl_difficulty INTEGER,
+                                                  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                  PRIMARY KEY (season_id, fixture_id, team_id, model_version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_fixture_difficulty_event ON fixture_difficulty (season_id, model_version, event);
+
+-- ==========================================
+-- 5. TEAM RATINGS (Elo, Dixon-Coles)
+-- ==========================================
+
+-- league is 'fpl' (teams keyed by FPL team code) or 'sofascore-<league id>'
+-- (Sofascore team ids); match_id is the FPL fixture or Sofascore match id.
+
+-- Elo History (one row per team per rated match)
+CREATE TABLE IF NOT EXISTS elo_history (
+                                           league VARCHAR(30) NOT NULL,
+                                           season_id INTEGER NOT NULL,
+                                           match_id INTEGER NOT NULL,
+                                           team_id INTEGER NOT NULL,
+                                           model_version VARCHAR(50) NOT NULL,
+                                           kickoff TIMESTAMP,
+                                           opponent_team_id INTEGER,
+                                           was_home BOOLEAN,
+                                           rating_before DECIMAL,
+                                           rating_after DECIMAL,
+
+                                           PRIMARY KEY (league, season_id, match_id, team_id, model_version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_elo_history_team ON elo_history (league, model_version, team_id, kickoff);
+
+-- Dixon-Coles Fits (one row per fit; home_advantage multiplies the home rate)
+CREATE TABLE IF NOT EXISTS dixon_coles_fits (
+                                                league VARCHAR(30) NOT NULL,
+                                                fit_at TIMESTAMP NOT NULL,
+                                                model_version VARCHAR(50) NOT NULL,
+                                                home_advantage DECIMAL,
+                                                rho DECIMAL,
+                                                matches INTEGER,
+
+                                                PRIMARY KEY (league, fit_at, model_version)
+);
+
+-- Dixon-Coles Teams (defence above 1 concedes more than average)
+CREATE TABLE IF NOT EXISTS dixon_coles_teams (
+                                                 league VARCHAR(30) NOT NULL,
+                                                 fit_at TIMESTAMP NOT NULL,
+                                                 model_version VARCHAR(50) NOT NULL,
+                                                 team_id INTEGER NOT NULL,
+                                                 attack DECIMAL,
+                                                 defence DECIMAL,
+
+                                                 PRIMARY KEY (league, fit_at, model_version, team_id),
+                                                 FOREIGN KEY (league, fit_at, model_version) REFERENCES dixon_coles_fits(league, fit_at, model_version) ON DELETE CASCADE
+);
+
+-- Match Predictions (scorelines[h][a] up to the grid size, whose last row and
+-- column hold that many goals or more)
+CREATE TABLE IF NOT EXISTS match_predictions (
+                                                 league VARCHAR(30) NOT NULL,
+                                                 season_id INTEGER NOT NULL,
+                                                 match_id INTEGER NOT NULL,
+                                                 model_version VARCHAR(50) NOT NULL,
+                                                 kickoff TIMESTAMP,
+                                                 home_team_id INTEGER,
+                                                 away_team_id INTEGER,
+                                                 elo_home_win DECIMAL,
+                                                 elo_draw DECIMAL,
+                                                 elo_away_win DECIMAL,
+                                                 dc_home_win DECIMAL,
+                                                 dc_draw DECIMAL,
+                                                 dc_away_win DECIMAL,
+                                                 x_goals_home DECIMAL,
+                                                 x_goals_away DECIMAL,
+                                                 scorelines JSONB,
+                                                 created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                 PRIMARY KEY (league, season_id, match_id, model_version)
+);
+
+-- ==========================================
+-- 6. GOAL PROBABILITIES
+-- ==========================================
+
+-- Fixture Goal Probabilities (one row per side of a fixture; goals_3_plus is
+-- three goals or more and clean_sheet the opponent's goals_0)
+CREATE TABLE IF NOT EXISTS fixture_goal_probabilities (
+                                                          season_id INTEGER NOT NULL,
+                                                          event INTEGER NOT NULL,
+                                                          fixture_id INTEGER NOT NULL,
+                                                          team_id INTEGER NOT NULL,
+                                                          model_version VARCHAR(50) NOT NULL,
+                                                          opponent_team_id INTEGER,
+                                                          was_home BOOLEAN,
+                                                          expected_goals DECIMAL,
+                                                          clean_sheet DECIMAL,
+                                                          goals_0 DECIMAL,
+                                                          goals_1 DECIMAL,
+                                                          goals_2 DECIMAL,
+                                                          goals_3_plus DECIMAL,
+                                                          created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                          PRIMARY KEY (season_id, fixture_id, team_id, model_version)
+);
+
+-- ==========================================
+-- 7. MINUTES
+-- ==========================================
+
+-- Player Minutes (one row per player per fixture of the gameweek predicted;
+-- rest_days is NULL when the team's previous match is unknown)
+CREATE TABLE IF NOT EXISTS player_minutes (
+                                              season_id INTEGER NOT NULL,
+                                              event INTEGER NOT NULL,
+                                              fixture_id INTEGER NOT NULL,
+                                              player_id INTEGER NOT NULL,
+                                              model_version VARCHAR(50) NOT NULL,
+                                              team_id INTEGER,
+                                              kickoff TIMESTAMP,
+                                              start_probability DECIMAL,
+                                              sub_probability DECIMAL,
+                                              expected_minutes DECIMAL,
+                                              availability DECIMAL,
+                                              rest_days DECIMAL,
+                                              created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                              PRIMARY KEY (season_id, fixture_id, player_id, model_version)
+);
+
+CREATE INDEX IF NOT EXISTS idx_player_minutes_event ON player_minutes (season_id, event, model_version);
+
+-- ==========================================
+-- 8. SNAPSHOTS
+-- ==========================================
+
+-- Snapshot Players (players as bootstrap_snapshots found them at a gameweek's
+-- deadline, rehydrated by cmd/snapshots)
+CREATE TABLE IF NOT EXISTS snapshot_players (
+                                                season_id INTEGER NOT NULL,
+                                                event INTEGER NOT NULL,
+                                                player_id INTEGER NOT NULL,
+                                                snapshot_version INTEGER NOT NULL,
+                                                team_id INTEGER,
+                                                element_type_id INTEGER,
+                                                web_name VARCHAR(255),
+                                                status VARCHAR(50),
+                                                news TEXT,
+                                                chance_of_playing_next_round INTEGER,
+                                                now_cost INTEGER,
+                                                selected_by_percent DECIMAL,
+                                                selected_rank INTEGER,
+                                                form DECIMAL,
+                                                total_points INTEGER,
+                                                transfers_in_event INTEGER,
+                                                transfers_out_event INTEGER,
+                                                created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                                PRIMARY KEY (season_id, event, player_id)
+);
+
+-- Snapshot Teams (teams at a gameweek's deadline, as snapshot_players)
+CREATE TABLE IF NOT EXISTS snapshot_teams (
+                                              season_id INTEGER NOT NULL,
+                                              event INTEGER NOT NULL,
+                                              team_id INTEGER NOT NULL,
+                                              snapshot_version INTEGER NOT NULL,
+                                              name VARCHAR(255),
+                                              short_name VARCHAR(50),
+                                              position INTEGER,
+                                              strength INTEGER,
+                                              strength_overall_home INTEGER,
+                                              strength_overall_away INTEGER,
+                                              strength_attack_home INTEGER,
+                                              strength_attack_away INTEGER,
+                                              strength_defence_home INTEGER,
+                                              strength_defence_away INTEGER,
+                                              created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+                                              PRIMARY KEY (season_id, event, team_id)
+);
@@ -0,0 +1,205 @@
+package fpl_bonus
+
+import (
+	"sort"
+
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+)
+
+const (
+	explainMinutes = "minutes"
+	explainBonus   = "bonus"
+)
+
+// AwardBonus gives 3, 2 and 1 bonus points by BPS in one fixture with FPL's
+// tie rules: tied players share the higher award and push the next player
+// down. Two level on top both get 3 and the next gets 1, two level second get
+// 2 each and nobody gets 1, and everybody level third gets 1.
+func AwardBonus(bps map[int32]int32) map[int32]int32 {
+	type entry struct{ playerID, bps int32 }
+	ranked := make([]entry, 0, len(bps))
+	for id, b := range bps {
+		ranked = append(ranked, entry{id, b})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].bps != ranked[j].bps {
+			return ranked[i].bps > ranked[j].bps
+		}
+		return ranked[i].playerID < ranked[j].playerID
+	})
+
+	bonus := make(map[int32]int32)
+	rank := 0
+	for i, e := range ranked {
+		if i == 0 || e.bps < ranked[i-1].bps {
+			rank = i + 1
+		}
+		if rank > 3 {
+			break
+		}
+		bonus[e.playerID] = int32(4 - rank)
+	}
+
+	return bonus
+}
+
+type FixtureKey struct {
+	SeasonID  int32
+	FixtureID int32
+}
+
+// Fixture is what the live topic has said so far about one fixture: the BPS
+// of every player who played in it and whether FPL has confirmed its bonus.
+type Fixture struct {
+	FixtureKey
+	Event int32
+	BPS   map[int32]int32
+	// Split lists double gameweek players whose live BPS covers both
+	// fixtures; their BPS for this one has to come from fixture_stats.
+	Split     map[int32]bool
+	Confirmed bool
+
+	// Pending is the provisional bonus stored before confirmation, waiting
+	// for each player's official figure; nil until it has been loaded.
+	Pending  map[int32]int32
+	resolved map[int32]bool
+}
+
+// Provisional is the bonus FPL would award on the current BPS.
+func (f *Fixture) Provisional() map[int32]int32 {
+	bps := make(map[int32]int32, len(f.BPS))
+	for id, b := range f.BPS {
+		if !f.Split[id] {
+			bps[id] = b
+		}
+	}
+	return AwardBonus(bps)
+}
+
+// SetBPS sets a player's BPS in one fixture, for double gameweek players.
+func (f *Fixture) SetBPS(playerID, bps int32) {
+	if _, ok := f.BPS[playerID]; !ok {
+		return
+	}
+	f.BPS[playerID] = bps
+	delete(f.Split, playerID)
+}
+
+// Resolve settles a player's provisional bonus against the official one
+// once the fixture is confirmed. It reports the provisional bonus and whether
+// it was wrong, at most once per player, and only for fixtures that had a
+// provisional bonus stored.
+func (f *Fixture) Resolve(playerID, official int32) (int32, bool) {
+	if len(f.Pending) == 0 || f.resolved[playerID] {
+		return 0, false
+	}
+	f.resolved[playerID] = true
+	provisional := f.Pending[playerID]
+	return provisional, provisional != official
+}
+
+// Calculator keeps the per-fixture BPS of the live topic, since each message
+// only carries one player.
+type Calculator struct {
+	fixtures map[FixtureKey]*Fixture
+}
+
+func NewCalculator() *Calculator {
+	return &Calculator{fixtures: make(map[FixtureKey]*Fixture)}
+}
+
+func (c *Calculator) Fixture(key FixtureKey) *Fixture {
+	return c.fixtures[key]
+}
+
+// Seed starts a fixture from the BPS already stored for it, so that after a
+// restart the bonus is not awarded among only the players heard from since.
+// Live messages added afterwards take precedence. A fixture already known is
+// left alone.
+func (c *Calculator) Seed(key FixtureKey, event int32, bps map[int32]int32) {
+	if c.fixtures[key] != nil {
+		return
+	}
+	f := newFixture(key, event)
+	for id, b := range bps {
+		f.BPS[id] = b
+	}
+	c.fixtures[key] = f
+}
+
+// EvictBefore forgets the fixtures of gameweeks before event, whose bonus FPL
+// has confirmed by the time the next gameweek is live.
+func (c *Calculator) EvictBefore(event int32) {
+	for key, f := range c.fixtures {
+		if f.Event < event {
+			delete(c.fixtures, key)
+		}
+	}
+}
+
+func newFixture(key FixtureKey, event int32) *Fixture {
+	return &Fixture{
+		FixtureKey: key,
+		Event:      event,
+		BPS:        make(map[int32]int32),
+		Split:      make(map[int32]bool),
+		resolved:   make(map[int32]bool),
+	}
+}
+
+// Add records a live message and returns the fixtures it touched.
+func (c *Calculator) Add(msg *fpl.LiveEventMessage) []FixtureKey {
+	played := 0
+	for _, item := range msg.GetExplain() {
+		if minutesIn(item) > 0 {
+			played++
+		}
+	}
+
+	var touched []FixtureKey
+	for _, item := range msg.GetExplain() {
+		key := FixtureKey{SeasonID: msg.GetSeasonId(), FixtureID: item.GetFixture()}
+		f := c.fixtures[key]
+		if f == nil {
+			f = newFixture(key, msg.GetEvent())
+			c.fixtures[key] = f
+		}
+		touched = append(touched, key)
+
+		if _, ok := OfficialBonus(item); ok {
+			f.Confirmed = true
+		}
+		if minutesIn(item) == 0 {
+			delete(f.BPS, msg.GetPlayerId())
+			delete(f.Split, msg.GetPlayerId())
+			continue
+		}
+
+		f.BPS[msg.GetPlayerId()] = msg.GetStats().GetBps()
+		if played > 1 {
+			f.Split[msg.GetPlayerId()] = true
+		} else {
+			delete(f.Split, msg.GetPlayerId())
+		}
+	}
+	return touched
+}
+
+// OfficialBonus is the bonus FPL has added in a fixture, if any.
+func OfficialBonus(item *fpl.ExplainItem) (int32, bool) {
+	for _, stat := range item.GetStats() {
+		if stat.GetIdentifier() == explainBonus {
+			return stat.GetPoints(), true
+		}
+	}
+	return 0, false
+}
+
+func minutesIn(item *fpl.ExplainItem) int32 {
+	for _, stat := range item.GetStats() {
+		if stat.GetIdentifier() == explainMinutes {
+			return stat.GetValue()
+		}
+	}
+	return 0
+}
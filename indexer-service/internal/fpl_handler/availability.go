@@ -0,0 +1,44 @@
+package fpl_handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+)
+
+// processPlayerBootstrap stores a batch of players after recording and
+// publishing the availability changes it brings. The changes are read against
+// the stored players, so if they cannot be recorded the batch fails rather
+// than overwrite the old status and lose them.
+func (h *Handler) processPlayerBootstrap(ctx context.Context, players []*fpl.PlayerBootstrapMessage) error {
+	if h.availabilityRepo != nil {
+		changes, err := h.availabilityRepo.RecordChanges(ctx, players)
+		if err != nil {
+			return fmt.Errorf("recording availability changes: %w", err)
+		}
+		h.publishAvailabilityChanges(ctx, changes)
+	}
+
+	return h.playerRepo.InsertPlayerBootstrapComplete(ctx, players)
+}
+
+func (h *Handler) publishAvailabilityChanges(ctx context.Context, changes []*fpl.PlayerAvailabilityChangeMessage) {
+	if h.producer == nil || len(changes) == 0 {
+		return
+	}
+
+	// Changes still go out when the batch is flushed on shutdown.
+	ctx = context.WithoutCancel(ctx)
+
+	topic := h.kafkaConfig.TopicsName.FplPlayerAvailability.Name
+	for _, c := range changes {
+		log.Printf("Player %d availability: %q -> %q %s", c.GetPlayerId(), c.GetOldStatus(), c.GetNewStatus(), c.GetNews())
+
+		key := []byte(fmt.Sprintf("%d-%d", c.GetSeasonId(), c.GetPlayerId()))
+		if err := h.producer.PublishWithProcess(ctx, c, topic, key); err != nil {
+			log.Printf("Error publishing availability change for player %d: %v", c.GetPlayerId(), err)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package fpl_handler
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/indexer-service/internal/fpl_bonus"
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+)
+
+// processLiveEvent stores a batch of the live topic and keeps the provisional
+// bonus of the fixtures it touches up to date. Once FPL confirms a fixture's
+// bonus the provisional rows go, and each player whose official bonus differs
+// from the provisional one is published as a correction. The calculator only
+// holds the gameweek being played: earlier ones are dropped once a batch of a
+// later one is through.
+func (h *Handler) processLiveEvent(ctx context.Context, events []*fpl.LiveEventMessage) error {
+	if h.bonusRepo == nil {
+		return h.playerRepo.InsertPlayerGameweekExplain(ctx, events)
+	}
+
+	h.seedBonus(ctx, events)
+
+	touched := make(map[fpl_bonus.FixtureKey]bool)
+	latest := int32(0)
+	for _, e := range events {
+		for _, key := range h.bonus.Add(e) {
+			touched[key] = true
+		}
+		latest = max(latest, e.GetEvent())
+	}
+
+	// The provisional bonus has to be read before the official rows
+	// overwrite it.
+	for key := range touched {
+		f := h.bonus.Fixture(key)
+		if !f.Confirmed {
+			finished, err := h.bonusRepo.IsFixtureFinished(ctx, key.SeasonID, key.FixtureID)
+			if err != nil {
+				log.Printf("Error checking fixture %d: %v", key.FixtureID, err)
+			}
+			f.Confirmed = finished
+		}
+		if !f.Confirmed || f.Pending != nil {
+			continue
+		}
+		pending, err := h.bonusRepo.LoadProvisionalBonus(ctx, key.SeasonID, key.FixtureID)
+		if err != nil {
+			log.Printf("Error loading provisional bonus for fixture %d: %v", key.FixtureID, err)
+			continue
+		}
+		f.Pending = pending
+	}
+
+	if err := h.playerRepo.InsertPlayerGameweekExplain(ctx, events); err != nil {
+		return err
+	}
+
+	for key := range touched {
+		f := h.bonus.Fixture(key)
+		if f.Confirmed {
+			if err := h.bonusRepo.DeleteProvisionalBonus(ctx, key.SeasonID, key.FixtureID); err != nil {
+				log.Printf("Error deleting provisional bonus for fixture %d: %v", key.FixtureID, err)
+			}
+			continue
+		}
+
+		if len(f.Split) > 0 {
+			bps, err := h.bonusRepo.LoadFixtureBPS(ctx, key.SeasonID, key.FixtureID)
+			if err != nil {
+				log.Printf("Error loading BPS for fixture %d: %v", key.FixtureID, err)
+			}
+			for playerID, b := range bps {
+				if f.Split[playerID] {
+					f.SetBPS(playerID, b)
+				}
+			}
+		}
+
+		if err := h.bonusRepo.ReplaceProvisionalBonus(ctx, key.SeasonID, f.Event, key.FixtureID, f.Provisional()); err != nil {
+			log.Printf("Error storing provisional bonus for fixture %d: %v", key.FixtureID, err)
+		}
+	}
+
+	h.publishBonusCorrections(ctx, events)
+	h.bonus.EvictBefore(latest)
+
+	return nil
+}
+
+// seedBonus loads the stored BPS of the fixtures the calculator has not seen
+// since the indexer started.
+func (h *Handler) seedBonus(ctx context.Context, events []*fpl.LiveEventMessage) {
+	for _, e := range events {
+		for _, item := range e.GetExplain() {
+			key := fpl_bonus.FixtureKey{SeasonID: e.GetSeasonId(), FixtureID: item.GetFixture()}
+			if h.bonus.Fixture(key) != nil {
+				continue
+			}
+			bps, err := h.bonusRepo.LoadFixtureBPS(ctx, key.SeasonID, key.FixtureID)
+			if err != nil {
+				log.Printf("Error loading BPS for fixture %d: %v", key.FixtureID, err)
+				continue
+			}
+			h.bonus.Seed(key, e.GetEvent(), bps)
+		}
+	}
+}
+
+// publishBonusCorrections settles the provisional bonus of every player in
+// the batch whose fixture is confirmed: each message carries the player's
+// full explain, so a missing bonus line means no bonus.
+func (h *Handler) publishBonusCorrections(ctx context.Context, events []*fpl.LiveEventMessage) {
+	if h.producer == nil {
+		return
+	}
+
+	// Corrections still go out when the batch is flushed on shutdown.
+	ctx = context.WithoutCancel(ctx)
+
+	topic := h.kafkaConfig.TopicsName.FplBonusCorrections.Name
+	for _, e := range events {
+		for _, item := range e.GetExplain() {
+			f := h.bonus.Fixture(fpl_bonus.FixtureKey{SeasonID: e.GetSeasonId(), FixtureID: item.GetFixture()})
+			if f == nil || !f.Confirmed {
+				continue
+			}
+
+			official, _ := fpl_bonus.OfficialBonus(item)
+			provisional, wrong := f.Resolve(e.GetPlayerId(), official)
+			if !wrong {
+				continue
+			}
+
+			msg := &fpl.BonusCorrectionMessage{
+				SeasonId:         e.GetSeasonId(),
+				Event:            e.GetEvent(),
+				FixtureId:        item.GetFixture(),
+				PlayerId:         e.GetPlayerId(),
+				Bps:              f.BPS[e.GetPlayerId()],
+				ProvisionalBonus: provisional,
+				ConfirmedBonus:   official,
+			}
+			key := []byte(fmt.Sprintf("%d-%d", item.GetFixture(), e.GetPlayerId()))
+			if err := h.producer.PublishWithProcess(ctx, msg, topic, key); err != nil {
+				log.Printf("Error publishing bonus correction for player %d: %v", e.GetPlayerId(), err)
+			}
+		}
+	}
+}
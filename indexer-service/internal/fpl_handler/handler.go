@@ -7,10 +7,13 @@ import (
 	"time"
 
 	"github.com/imadeddine-belkat/indexer-service/config"
+	"github.com/imadeddine-belkat/indexer-service/internal/fpl_bonus"
 	"github.com/imadeddine-belkat/indexer-service/internal/fpl_repositories"
+	"github.com/imadeddine-belkat/indexer-service/internal/metrics"
 	kafka "github.com/imadeddine-belkat/tactify-kafka"
 	kafkaConfig "github.com/imadeddine-belkat/tactify-kafka/config"
 	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
 )
 
 type Handler struct {
@@ -21,6 +24,12 @@ type Handler struct {
 	teamRepo    *fpl_repositories.TeamRepo
 	fixtureRepo *fpl_repositories.FixtureRepo
 	managerRepo *fpl_repositories.ManagerRepo
+	bonusRepo   *fpl_repositories.BonusRepo
+	bonus       *fpl_bonus.Calculator
+	producer    *kafka.Producer
+
+	availabilityRepo *fpl_repositories.AvailabilityRepo
+	snapshotRepo     *fpl_repositories.SnapshotRepo
 }
 
 func NewHandler(
@@ -30,6 +39,10 @@ func NewHandler(
 	teamRepo *fpl_repositories.TeamRepo,
 	fixtureRepo *fpl_repositories.FixtureRepo,
 	managerRepo *fpl_repositories.ManagerRepo,
+	bonusRepo *fpl_repositories.BonusRepo,
+	availabilityRepo *fpl_repositories.AvailabilityRepo,
+	snapshotRepo *fpl_repositories.SnapshotRepo,
+	producer *kafka.Producer,
 ) *Handler {
 	h := &Handler{
 		config:      config,
@@ -38,7 +51,13 @@ func NewHandler(
 		teamRepo:    teamRepo,
 		fixtureRepo: fixtureRepo,
 		managerRepo: managerRepo,
+		bonusRepo:   bonusRepo,
+		bonus:       fpl_bonus.NewCalculator(),
+		producer:    producer,
 		consumers:   make(map[string]*kafka.Consumer),
+
+		availabilityRepo: availabilityRepo,
+		snapshotRepo:     snapshotRepo,
 	}
 
 	// Pre-create consumers only for non-nil repositories
@@ -116,6 +135,14 @@ func NewHandler(
 		)
 	}
 
+	if snapshotRepo != nil {
+		h.consumers[kafkaConfig.TopicsName.FplBootstrapSnapshots.Name] = kafka.NewConsumer(
+			kafkaConfig,
+			kafkaConfig.TopicsName.FplBootstrapSnapshots.Name,
+			kafkaConfig.ConsumersGroupID.FplSnapshots,
+		)
+	}
+
 	return h
 }
 
@@ -134,6 +161,7 @@ func (h *Handler) Route(ctx context.Context, topic string) {
 		h.kafkaConfig.TopicsName.FplEntryPicks.Name:         h.handleManagerPicks,
 		h.kafkaConfig.TopicsName.FplEntryTransfers.Name:     h.handleManagerTransfers,
 		h.kafkaConfig.TopicsName.FplEntryHistory.Name:       h.handleManagerHistory,
+		h.kafkaConfig.TopicsName.FplBootstrapSnapshots.Name: h.handleSnapshots,
 	}
 
 	if fn, ok := handlers[topic]; ok {
@@ -149,10 +177,11 @@ func batchProcess[T any, K comparable](
 	flushInterval time.Duration,
 	topicName string,
 	getKey func(T) K,
-	process func(T) error,
+	process func(context.Context, T) error,
 ) {
 	messages, errors := consumer.Subscribe(ctx)
 	batch := make(map[K]T)
+	contexts := make(map[K]context.Context)
 	flushTicker := time.NewTicker(flushInterval)
 	defer flushTicker.Stop()
 
@@ -161,12 +190,18 @@ func batchProcess[T any, K comparable](
 			return
 		}
 
-		for _, item := range batch {
-			if err := process(item); err != nil {
+		start := time.Now()
+		for key, item := range batch {
+			itemCtx, span := tracer.Start(contexts[key], "process "+topicName)
+			err := process(itemCtx, item)
+			if err != nil {
 				log.Printf("Error %s for %s: %v\n", logContext, topicName, err)
 			}
+			tracing.End(span, err)
 		}
+		metrics.ObserveFlush(topicName, len(batch), start)
 		batch = make(map[K]T)
+		contexts = make(map[K]context.Context)
 	}
 
 	for {
@@ -177,7 +212,9 @@ func batchProcess[T any, K comparable](
 				log.Printf("Error unmarshaling %s message: %v, raw: %s\n", topicName, err, string(msg.Value))
 				continue
 			}
-			batch[getKey(item)] = item
+			key := getKey(item)
+			batch[key] = item
+			contexts[key] = kafka.ContextFromMessage(ctx, msg)
 
 			if len(batch) >= batchSize {
 				flushBatch("inserting")
@@ -206,10 +243,11 @@ func batchProcessWithSlice[T any, K comparable](
 	flushInterval time.Duration,
 	topicName string,
 	getKey func(T) K,
-	processBatch func([]T) error,
+	processBatch func(context.Context, []T) error,
 ) {
 	messages, errors := consumer.Subscribe(ctx)
 	batch := make(map[K]T)
+	contexts := make(map[K]context.Context)
 	flushTicker := time.NewTicker(flushInterval)
 	defer flushTicker.Stop()
 
@@ -223,10 +261,16 @@ func batchProcessWithSlice[T any, K comparable](
 			items = append(items, item)
 		}
 
-		if err := processBatch(items); err != nil {
+		batchCtx, span := startBatchSpan(ctx, topicName, contexts)
+		start := time.Now()
+		err := processBatch(batchCtx, items)
+		if err != nil {
 			log.Printf("Error %s batch for %s: %v\n", logContext, topicName, err)
 		}
+		metrics.ObserveFlush(topicName, len(items), start)
+		tracing.End(span, err)
 		batch = make(map[K]T)
+		contexts = make(map[K]context.Context)
 	}
 
 	for {
@@ -237,7 +281,9 @@ func batchProcessWithSlice[T any, K comparable](
 				log.Printf("Error unmarshaling %s message: %v, raw: %s\n", topicName, err, string(msg.Value))
 				continue
 			}
-			batch[getKey(item)] = item
+			key := getKey(item)
+			batch[key] = item
+			contexts[key] = kafka.ContextFromMessage(ctx, msg)
 
 			if len(batch) >= batchSize {
 				flushBatch("inserting")
@@ -287,6 +333,7 @@ func (h *Handler) handlePlayerBootstrap(ctx context.Context) {
 	consumer := h.consumers[h.kafkaConfig.TopicsName.FplPlayersBootstrap.Name]
 	messages, errors := consumer.Subscribe(ctx)
 	batch := make(map[int]*fpl.PlayerBootstrapMessage)
+	contexts := make(map[int]context.Context)
 
 	flushTicker := time.NewTicker(h.config.FlushInterval)
 	verifyTicker := time.NewTicker(30 * time.Second)
@@ -306,7 +353,13 @@ func (h *Handler) handlePlayerBootstrap(ctx context.Context) {
 			players = append(players, p)
 		}
 
-		if err := h.playerRepo.InsertPlayerBootstrapComplete(players); err != nil {
+		topic := h.kafkaConfig.TopicsName.FplPlayersBootstrap.Name
+		batchCtx, span := startBatchSpan(ctx, topic, contexts)
+		start := time.Now()
+		err := h.processPlayerBootstrap(batchCtx, players)
+		metrics.ObserveFlush(topic, len(players), start)
+		tracing.End(span, err)
+		if err != nil {
 			log.Printf("❌ Error %s player bootstrap batch: %v", logContext, err)
 		} else {
 			totalProcessed += len(players)
@@ -317,6 +370,7 @@ func (h *Handler) handlePlayerBootstrap(ctx context.Context) {
 			}
 		}
 		batch = make(map[int]*fpl.PlayerBootstrapMessage)
+		contexts = make(map[int]context.Context)
 	}
 
 	for {
@@ -328,6 +382,7 @@ func (h *Handler) handlePlayerBootstrap(ctx context.Context) {
 				continue
 			}
 			batch[int(player.Player.Id)] = player
+			contexts[int(player.Player.Id)] = kafka.ContextFromMessage(ctx, msg)
 			totalReceived++
 
 			if len(batch) >= h.config.BatchSize {
@@ -357,7 +412,7 @@ func (h *Handler) handlePlayerStats(ctx context.Context) {
 		h.config.FlushInterval,
 		h.kafkaConfig.TopicsName.FplPlayersStats.Name,
 		func(p *fpl.PlayerBootstrapMessage) int { return int(p.Player.Id) },
-		h.playerRepo.InsertPlayerBootstrapComplete,
+		h.processPlayerBootstrap,
 	)
 }
 
@@ -369,8 +424,8 @@ func (h *Handler) handlePlayerMatchStats(ctx context.Context) {
 		h.config.FlushInterval,
 		h.kafkaConfig.TopicsName.FplPlayerMatchStats.Name,
 		func(msg *fpl.PlayerHistoryMessage) int { return int(msg.PlayerId) },
-		func(histMsg *fpl.PlayerHistoryMessage) error {
-			return h.playerRepo.InsertPlayerGameweekStats(histMsg)
+		func(ctx context.Context, histMsg *fpl.PlayerHistoryMessage) error {
+			return h.playerRepo.InsertPlayerGameweekStats(ctx, histMsg)
 		},
 	)
 }
@@ -396,7 +451,7 @@ func (h *Handler) handlePlayerExplain(ctx context.Context) {
 		h.config.FlushInterval,
 		h.kafkaConfig.TopicsName.FplLiveEvent.Name,
 		func(p *fpl.LiveEventMessage) int { return int(p.PlayerId) },
-		h.playerRepo.InsertPlayerGameweekExplain,
+		h.processLiveEvent,
 	)
 }
 
@@ -408,8 +463,8 @@ func (h *Handler) handleManagers(ctx context.Context) {
 		h.config.FlushInterval,
 		h.kafkaConfig.TopicsName.FplEntry.Name,
 		func(e *fpl.EntryMessage) int { return int(e.Entry.Id) },
-		func(e *fpl.EntryMessage) error {
-			return h.managerRepo.InsertManagerInfo(e)
+		func(ctx context.Context, e *fpl.EntryMessage) error {
+			return h.managerRepo.InsertManagerInfo(ctx, e)
 		},
 	)
 }
@@ -422,8 +477,8 @@ func (h *Handler) handleManagerPicks(ctx context.Context) {
 		h.config.FlushInterval,
 		h.kafkaConfig.TopicsName.FplEntryPicks.Name,
 		func(p *fpl.EntryEventPicksMessage) int { return int(p.EntryId) },
-		func(p *fpl.EntryEventPicksMessage) error {
-			return h.managerRepo.InsertManagerPicks(p)
+		func(ctx context.Context, p *fpl.EntryEventPicksMessage) error {
+			return h.managerRepo.InsertManagerPicks(ctx, p)
 		},
 	)
 }
@@ -436,8 +491,8 @@ func (h *Handler) handleManagerTransfers(ctx context.Context) {
 		h.config.FlushInterval,
 		h.kafkaConfig.TopicsName.FplEntryTransfers.Name,
 		func(t *fpl.EntryTransfersMessage) int { return int(t.EntryId) },
-		func(t *fpl.EntryTransfersMessage) error {
-			return h.managerRepo.InsertManagerTransfers(t)
+		func(ctx context.Context, t *fpl.EntryTransfersMessage) error {
+			return h.managerRepo.InsertManagerTransfers(ctx, t)
 		},
 	)
 }
@@ -450,8 +505,20 @@ func (h *Handler) handleManagerHistory(ctx context.Context) {
 		h.config.FlushInterval,
 		h.kafkaConfig.TopicsName.FplEntryHistory.Name,
 		func(hi *fpl.EntryHistoryMessage) int { return int(hi.EntryId) },
-		func(hi *fpl.EntryHistoryMessage) error {
-			return h.managerRepo.InsertManagerFullHistory(hi)
+		func(ctx context.Context, hi *fpl.EntryHistoryMessage) error {
+			return h.managerRepo.InsertManagerFullHistory(ctx, hi)
 		},
 	)
 }
+
+func (h *Handler) handleSnapshots(ctx context.Context) {
+	batchProcess(
+		ctx,
+		h.consumers[h.kafkaConfig.TopicsName.FplBootstrapSnapshots.Name],
+		1,
+		h.config.FlushInterval,
+		h.kafkaConfig.TopicsName.FplBootstrapSnapshots.Name,
+		func(s *fpl.BootstrapSnapshotMessage) string { return s.Sha256 },
+		h.snapshotRepo.InsertSnapshot,
+	)
+}
@@ -0,0 +1,35 @@
+package fpl_handler
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/imadeddine-belkat/indexer-service/internal/fpl_handler")
+
+// startBatchSpan starts the span of a batch write. A batch usually mixes
+// messages from several traces, so rather than joining one of them the span
+// starts a trace of its own linked to each message's; a batch of a single
+// traced message just continues its trace.
+func startBatchSpan[K comparable](ctx context.Context, topic string, contexts map[K]context.Context) (context.Context, trace.Span) {
+	links := make([]trace.Link, 0, len(contexts))
+	var parent context.Context
+	for _, c := range contexts {
+		if link := trace.LinkFromContext(c); link.SpanContext.IsValid() {
+			links = append(links, link)
+			parent = c
+		}
+	}
+
+	attrs := trace.WithAttributes(
+		attribute.String("messaging.destination.name", topic),
+		attribute.Int("messaging.batch.message_count", len(contexts)),
+	)
+	if len(links) == 1 {
+		return tracer.Start(parent, "flush "+topic, attrs)
+	}
+	return tracer.Start(ctx, "flush "+topic, attrs, trace.WithNewRoot(), trace.WithLinks(links...))
+}
@@ -0,0 +1,71 @@
+package fpl_handler
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTracing(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	otel.SetTracerProvider(tp)
+	return recorder
+}
+
+func messageContext(t *testing.T, name string) (context.Context, trace.SpanContext) {
+	t.Helper()
+	ctx, span := otel.Tracer("test").Start(context.Background(), name)
+	span.End()
+	return ctx, span.SpanContext()
+}
+
+func TestStartBatchSpanSingleMessageContinuesTrace(t *testing.T) {
+	setupTracing(t)
+	msgCtx, msgSpan := messageContext(t, "receive")
+
+	_, span := startBatchSpan(context.Background(), "fpl.players", map[int32]context.Context{
+		1: msgCtx,
+		2: context.Background(),
+	})
+	span.End()
+
+	got := span.(sdktrace.ReadOnlySpan)
+	if got.SpanContext().TraceID() != msgSpan.TraceID() || got.Parent().SpanID() != msgSpan.SpanID() {
+		t.Fatalf("got trace %s under %s, want a child of %v", got.SpanContext().TraceID(), got.Parent().SpanID(), msgSpan)
+	}
+	if len(got.Links()) != 0 {
+		t.Fatalf("a continued trace should not link, got %v", got.Links())
+	}
+}
+
+func TestStartBatchSpanLinksMixedTraces(t *testing.T) {
+	setupTracing(t)
+	ctxA, spanA := messageContext(t, "receive a")
+	ctxB, spanB := messageContext(t, "receive b")
+
+	_, span := startBatchSpan(context.Background(), "fpl.players", map[int32]context.Context{1: ctxA, 2: ctxB})
+	span.End()
+
+	got := span.(sdktrace.ReadOnlySpan)
+	if got.Parent().IsValid() {
+		t.Fatalf("a mixed batch should start a new trace, got parent %v", got.Parent())
+	}
+	if got.SpanContext().TraceID() == spanA.TraceID() || got.SpanContext().TraceID() == spanB.TraceID() {
+		t.Fatalf("a mixed batch should not join a message's trace")
+	}
+
+	linked := map[trace.SpanID]bool{}
+	for _, link := range got.Links() {
+		linked[link.SpanContext.SpanID()] = true
+	}
+	if len(linked) != 2 || !linked[spanA.SpanID()] || !linked[spanB.SpanID()] {
+		t.Fatalf("got links %v, want both messages", got.Links())
+	}
+}
@@ -0,0 +1,168 @@
+package fpl_repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// AvailabilityRepo keeps the history of players' status, news and chance of
+// playing in player_availability_changes. The players table only holds the
+// latest of each.
+type AvailabilityRepo struct {
+	db                      *sql.DB
+	AvailabilityChangeModel *fpl.PlayerAvailabilityChangeMessage
+}
+
+func NewAvailabilityRepo(db *sql.DB, availabilityChangeModel *fpl.PlayerAvailabilityChangeMessage) *AvailabilityRepo {
+	return &AvailabilityRepo{
+		db:                      db,
+		AvailabilityChangeModel: availabilityChangeModel,
+	}
+}
+
+type availability struct {
+	status     string
+	news       string
+	chanceNext *int32
+}
+
+func (a availability) differs(b availability) bool {
+	if a.status != b.status || a.news != b.news {
+		return true
+	}
+	if a.chanceNext == nil || b.chanceNext == nil {
+		return a.chanceNext != b.chanceNext
+	}
+	return *a.chanceNext != *b.chanceNext
+}
+
+// RecordChanges compares a batch of players with the stored ones, records
+// each whose status, news or chance of playing next round changed, and moves
+// their stored availability on so the change is only seen once. Players not
+// stored yet have no history to change. The chance of playing this round is
+// left out as it moves to next round's at every deadline.
+func (r *AvailabilityRepo) RecordChanges(ctx context.Context, players []*fpl.PlayerBootstrapMessage) (_ []*fpl.PlayerAvailabilityChangeMessage, err error) {
+	ctx, span := helpers.StartSpan(ctx, "AvailabilityRepo.RecordChanges", attribute.Int("db.operation.batch.size", len(players)))
+	defer func() { tracing.End(span, err) }()
+
+	if len(players) == 0 {
+		return nil, nil
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	bySeason := make(map[int32][]int32)
+	for _, p := range players {
+		bySeason[p.SeasonId] = append(bySeason[p.SeasonId], p.Player.Id)
+	}
+
+	type key struct{ seasonID, playerID int32 }
+	stored := make(map[key]availability)
+	for seasonID, ids := range bySeason {
+		// Locking the rows, in a fixed order, keeps concurrent batches of the
+		// same players from recording a change twice.
+		rows, err := tx.QueryContext(ctx, `
+			SELECT player_id, COALESCE(status, ''), COALESCE(news, ''), chance_of_playing_next_round
+			FROM players
+			WHERE season_id = $1 AND player_id = ANY($2)
+			ORDER BY player_id
+			FOR UPDATE`,
+			seasonID, pq.Array(ids),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("querying players availability: %w", err)
+		}
+		for rows.Next() {
+			var playerID int32
+			var a availability
+			var chance sql.NullInt32
+			if err := rows.Scan(&playerID, &a.status, &a.news, &chance); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("scanning players availability: %w", err)
+			}
+			if chance.Valid {
+				a.chanceNext = &chance.Int32
+			}
+			stored[key{seasonID, playerID}] = a
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+	var changes []*fpl.PlayerAvailabilityChangeMessage
+	for _, p := range players {
+		old, ok := stored[key{p.SeasonId, p.Player.Id}]
+		if !ok {
+			continue
+		}
+		current := availability{status: p.Player.Status, news: p.Player.News, chanceNext: p.Player.ChanceOfPlayingNextRound}
+		if !old.differs(current) {
+			continue
+		}
+		changes = append(changes, &fpl.PlayerAvailabilityChangeMessage{
+			SeasonId:                    p.SeasonId,
+			PlayerId:                    p.Player.Id,
+			OldStatus:                   old.status,
+			NewStatus:                   current.status,
+			OldNews:                     old.news,
+			News:                        current.news,
+			NewsAdded:                   p.Player.NewsAdded,
+			OldChanceOfPlayingNextRound: old.chanceNext,
+			ChanceOfPlayingNextRound:    current.chanceNext,
+			ChangedAt:                   now.Format(time.RFC3339),
+		})
+		// Updated under the lock so that a batch of the same player waiting
+		// on it sees the new availability.
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE players
+			SET status = $3, news = $4, news_added = $5, chance_of_playing_next_round = $6, updated_at = CURRENT_TIMESTAMP
+			WHERE season_id = $1 AND player_id = $2`,
+			p.SeasonId, p.Player.Id, current.status, current.news, nullIfEmpty(p.Player.NewsAdded), current.chanceNext,
+		); err != nil {
+			return nil, fmt.Errorf("updating player %d availability: %w", p.Player.Id, err)
+		}
+	}
+
+	if len(changes) > 0 {
+		query := sq.Insert("player_availability_changes").Columns(
+			"player_id", "season_id", "old_status", "new_status", "old_news", "news", "news_added",
+			"old_chance_of_playing_next_round", "chance_of_playing_next_round", "changed_at",
+		).PlaceholderFormat(sq.Dollar)
+
+		for _, c := range changes {
+			query = query.Values(
+				c.PlayerId, c.SeasonId, c.OldStatus, c.NewStatus, c.OldNews, c.News, nullIfEmpty(c.NewsAdded),
+				c.OldChanceOfPlayingNextRound, c.ChanceOfPlayingNextRound, now,
+			)
+		}
+
+		sqlQuery, args, err := query.ToSql()
+		if err != nil {
+			return nil, fmt.Errorf("building player_availability_changes insert query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, sqlQuery, args...); err != nil {
+			return nil, fmt.Errorf("executing player_availability_changes insert: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
@@ -0,0 +1,158 @@
+package fpl_repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+)
+
+// BonusRepo stores the provisional bonus computed from live BPS as "bonus"
+// rows of player_gameweek_explain flagged provisional, until FPL's own bonus
+// row replaces them.
+type BonusRepo struct {
+	db                   *sql.DB
+	BonusCorrectionModel *fpl.BonusCorrectionMessage
+}
+
+func NewBonusRepo(db *sql.DB, bonusCorrectionModel *fpl.BonusCorrectionMessage) *BonusRepo {
+	return &BonusRepo{
+		db:                   db,
+		BonusCorrectionModel: bonusCorrectionModel,
+	}
+}
+
+// LoadFixtureBPS reads the per-player BPS of a fixture from fixture_stats.
+func (r *BonusRepo) LoadFixtureBPS(ctx context.Context, seasonID, fixtureID int32) (_ map[int32]int32, err error) {
+	ctx, span := helpers.StartSpan(ctx, "BonusRepo.LoadFixtureBPS")
+	defer func() { tracing.End(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id, value
+		FROM fixture_stats
+		WHERE season_id = $1 AND fixture_id = $2 AND identifier = 'bps'`,
+		seasonID, fixtureID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying fixture_stats bps: %w", err)
+	}
+	defer rows.Close()
+
+	bps := make(map[int32]int32)
+	for rows.Next() {
+		var playerID, value int32
+		if err := rows.Scan(&playerID, &value); err != nil {
+			return nil, fmt.Errorf("scanning fixture_stats bps: %w", err)
+		}
+		bps[playerID] = value
+	}
+	return bps, rows.Err()
+}
+
+// IsFixtureFinished reports whether FPL has marked a fixture finished, which
+// it does once the bonus is added.
+func (r *BonusRepo) IsFixtureFinished(ctx context.Context, seasonID, fixtureID int32) (_ bool, err error) {
+	ctx, span := helpers.StartSpan(ctx, "BonusRepo.IsFixtureFinished")
+	defer func() { tracing.End(span, err) }()
+
+	var finished sql.NullBool
+	err = r.db.QueryRowContext(ctx,
+		`SELECT finished FROM fixtures WHERE season_id = $1 AND fixture_id = $2`,
+		seasonID, fixtureID,
+	).Scan(&finished)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("querying fixture %d: %w", fixtureID, err)
+	}
+	return finished.Bool, nil
+}
+
+func (r *BonusRepo) LoadProvisionalBonus(ctx context.Context, seasonID, fixtureID int32) (_ map[int32]int32, err error) {
+	ctx, span := helpers.StartSpan(ctx, "BonusRepo.LoadProvisionalBonus")
+	defer func() { tracing.End(span, err) }()
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id, points
+		FROM player_gameweek_explain
+		WHERE season_id = $1 AND fixture_id = $2 AND identifier = 'bonus' AND provisional`,
+		seasonID, fixtureID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying provisional bonus: %w", err)
+	}
+	defer rows.Close()
+
+	bonus := make(map[int32]int32)
+	for rows.Next() {
+		var playerID, points int32
+		if err := rows.Scan(&playerID, &points); err != nil {
+			return nil, fmt.Errorf("scanning provisional bonus: %w", err)
+		}
+		bonus[playerID] = points
+	}
+	return bonus, rows.Err()
+}
+
+// ReplaceProvisionalBonus swaps a fixture's provisional bonus for a new one.
+// Official bonus rows are never overwritten.
+func (r *BonusRepo) ReplaceProvisionalBonus(ctx context.Context, seasonID, event, fixtureID int32, bonus map[int32]int32) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "BonusRepo.ReplaceProvisionalBonus")
+	defer func() { tracing.End(span, err) }()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM player_gameweek_explain
+		WHERE season_id = $1 AND fixture_id = $2 AND identifier = 'bonus' AND provisional`,
+		seasonID, fixtureID,
+	); err != nil {
+		return fmt.Errorf("deleting provisional bonus: %w", err)
+	}
+
+	if len(bonus) > 0 {
+		query := sq.Insert("player_gameweek_explain").Columns(
+			"player_id", "fixture_id", "season_id", "event", "points",
+			"identifier", "value", "points_modification", "provisional",
+		).Suffix("ON CONFLICT (player_id, season_id, fixture_id, identifier) DO NOTHING").
+			PlaceholderFormat(sq.Dollar)
+
+		for playerID, points := range bonus {
+			query = query.Values(playerID, fixtureID, seasonID, event, points, "bonus", points, 0, true)
+		}
+
+		sqlQuery, args, err := query.ToSql()
+		if err != nil {
+			return fmt.Errorf("building provisional bonus insert query: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, sqlQuery, args...); err != nil {
+			return fmt.Errorf("executing provisional bonus insert: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *BonusRepo) DeleteProvisionalBonus(ctx context.Context, seasonID, fixtureID int32) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "BonusRepo.DeleteProvisionalBonus")
+	defer func() { tracing.End(span, err) }()
+
+	_, err = r.db.ExecContext(ctx, `
+		DELETE FROM player_gameweek_explain
+		WHERE season_id = $1 AND fixture_id = $2 AND identifier = 'bonus' AND provisional`,
+		seasonID, fixtureID,
+	)
+	if err != nil {
+		return fmt.Errorf("deleting provisional bonus: %w", err)
+	}
+	return nil
+}
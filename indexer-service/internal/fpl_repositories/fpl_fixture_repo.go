@@ -1,11 +1,15 @@
 package fpl_repositories
 
 import (
+	"context"
 	"database/sql"
 	"log"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
 	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type FixtureRepo struct {
@@ -21,14 +25,17 @@ func NewFixtureRepo(db *sql.DB, fixtureModel *fpl.Fixture) *FixtureRepo {
 }
 
 // In fpl_fixture_repo.go
-func (r *FixtureRepo) InsertFixtures(fixtures []*fpl.FixtureMessage) error {
+func (r *FixtureRepo) InsertFixtures(ctx context.Context, fixtures []*fpl.FixtureMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "FixtureRepo.InsertFixtures", attribute.Int("db.operation.batch.size", len(fixtures)))
+	defer func() { tracing.End(span, err) }()
+
 	if len(fixtures) == 0 {
 		return nil
 	}
 
 	fixtureInsert := sq.Insert("fixtures").
 		Columns("fixture_id", "season_id", "fixture_code", "event", "team_h", "team_a",
-			"kickoff_time", "team_h_score", "team_a_score", "finished", "minutes",
+			"kickoff_time", "team_h_score", "team_a_score", "finished", "finished_provisional", "minutes",
 			"provisional_start_time", "team_h_difficulty", "team_a_difficulty", "pulse_id").
 		Suffix(`ON CONFLICT (fixture_id, season_id)
                 DO UPDATE SET
@@ -40,6 +47,7 @@ func (r *FixtureRepo) InsertFixtures(fixtures []*fpl.FixtureMessage) error {
                 team_h_score = EXCLUDED.team_h_score,
                 team_a_score = EXCLUDED.team_a_score,
                 finished = EXCLUDED.finished,
+                finished_provisional = EXCLUDED.finished_provisional,
                 minutes = EXCLUDED.minutes,
                 provisional_start_time = EXCLUDED.provisional_start_time,
                 team_h_difficulty = EXCLUDED.team_h_difficulty,
@@ -63,7 +71,7 @@ func (r *FixtureRepo) InsertFixtures(fixtures []*fpl.FixtureMessage) error {
 			fixtureMsg.Fixture.Event, fixtureMsg.Fixture.TeamH, fixtureMsg.Fixture.TeamA,
 			nullIfEmpty(fixtureMsg.Fixture.KickoffTime), fixtureMsg.Fixture.TeamHScore,
 			fixtureMsg.Fixture.TeamAScore, fixtureMsg.Fixture.Finished,
-			fixtureMsg.Fixture.Minutes, fixtureMsg.Fixture.ProvisionalStartTime,
+			fixtureMsg.Fixture.FinishedProvisional, fixtureMsg.Fixture.Minutes, fixtureMsg.Fixture.ProvisionalStartTime,
 			fixtureMsg.Fixture.TeamHDifficulty, fixtureMsg.Fixture.TeamADifficulty,
 			fixtureMsg.Fixture.PulseId,
 		)
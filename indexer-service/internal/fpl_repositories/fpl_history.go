@@ -0,0 +1,80 @@
+package fpl_repositories
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// attributeHistory is a slowly-changing (type 2) history table: one row per
+// version of some attributes, valid over [valid_from, valid_to), the current
+// version having no valid_to. It is kept from a query of the current values.
+type attributeHistory struct {
+	table string
+	keys  []string
+	attrs []string
+	// current selects keys then attrs, in order, from the current-state
+	// tables; its placeholders are the args of apply.
+	current string
+}
+
+// apply closes the current version of each row of h.current whose attributes
+// changed and opens a new one for it, and for rows seen for the first time,
+// from now.
+func (h attributeHistory) apply(db *sql.DB, args ...any) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Batches of the same rows come in on several topics; one at a time, a
+	// row can't get two current versions.
+	if _, err := tx.Exec(fmt.Sprintf("LOCK TABLE %s IN SHARE ROW EXCLUSIVE MODE", h.table)); err != nil {
+		return fmt.Errorf("locking %s: %w", h.table, err)
+	}
+
+	now := fmt.Sprintf("$%d::timestamp", len(args)+1)
+	args = append(args, time.Now().UTC())
+
+	keysMatch := make([]string, len(h.keys))
+	for i, k := range h.keys {
+		keysMatch[i] = fmt.Sprintf("h.%[1]s = c.%[1]s", k)
+	}
+	prefixed := func(alias string, cols []string) string {
+		out := make([]string, len(cols))
+		for i, c := range cols {
+			out[i] = alias + "." + c
+		}
+		return strings.Join(out, ", ")
+	}
+
+	closeChanged := fmt.Sprintf(`
+		UPDATE %s h SET valid_to = %s
+		FROM (%s) c
+		WHERE %s AND h.valid_to IS NULL AND (%s) IS DISTINCT FROM (%s)`,
+		h.table, now, h.current,
+		strings.Join(keysMatch, " AND "), prefixed("h", h.attrs), prefixed("c", h.attrs),
+	)
+	if _, err := tx.Exec(closeChanged, args...); err != nil {
+		return fmt.Errorf("closing %s versions: %w", h.table, err)
+	}
+
+	cols := append(append([]string{}, h.keys...), h.attrs...)
+	openNew := fmt.Sprintf(`
+		INSERT INTO %s (%s, valid_from)
+		SELECT %s, %s
+		FROM (%s) c
+		WHERE NOT EXISTS (SELECT 1 FROM %s h WHERE %s AND h.valid_to IS NULL)`,
+		h.table, strings.Join(cols, ", "),
+		prefixed("c", cols), now,
+		h.current,
+		h.table, strings.Join(keysMatch, " AND "),
+	)
+	if _, err := tx.Exec(openNew, args...); err != nil {
+		return fmt.Errorf("opening %s versions: %w", h.table, err)
+	}
+
+	return tx.Commit()
+}
@@ -1,12 +1,15 @@
 package fpl_repositories
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
 	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
 )
 
 type ManagerRepo struct {
@@ -29,7 +32,10 @@ func NewManagerRepo(db *sql.DB, entry *fpl.EntryMessage, entryPicks *fpl.EntryEv
 	}
 }
 
-func (r *ManagerRepo) InsertManagerInfo(entry *fpl.EntryMessage) error {
+func (r *ManagerRepo) InsertManagerInfo(ctx context.Context, entry *fpl.EntryMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "ManagerRepo.InsertManagerInfo")
+	defer func() { tracing.End(span, err) }()
+
 	if entry == nil {
 		return nil
 	}
@@ -89,7 +95,10 @@ func (r *ManagerRepo) InsertManagerInfo(entry *fpl.EntryMessage) error {
 	return err
 }
 
-func (r *ManagerRepo) InsertManagerPicks(entryPicks *fpl.EntryEventPicksMessage) error {
+func (r *ManagerRepo) InsertManagerPicks(ctx context.Context, entryPicks *fpl.EntryEventPicksMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "ManagerRepo.InsertManagerPicks")
+	defer func() { tracing.End(span, err) }()
+
 	if entryPicks == nil {
 		return nil
 	}
@@ -161,7 +170,10 @@ func (r *ManagerRepo) InsertManagerPicks(entryPicks *fpl.EntryEventPicksMessage)
 	return err
 }
 
-func (r *ManagerRepo) InsertManagerTransfers(entryTransfers *fpl.EntryTransfersMessage) error {
+func (r *ManagerRepo) InsertManagerTransfers(ctx context.Context, entryTransfers *fpl.EntryTransfersMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "ManagerRepo.InsertManagerTransfers")
+	defer func() { tracing.End(span, err) }()
+
 	if entryTransfers == nil {
 		return nil
 	}
@@ -200,7 +212,10 @@ func (r *ManagerRepo) InsertManagerTransfers(entryTransfers *fpl.EntryTransfersM
 	return err
 }
 
-func (r *ManagerRepo) InsertManagerFullHistory(entryHistory *fpl.EntryHistoryMessage) error {
+func (r *ManagerRepo) InsertManagerFullHistory(ctx context.Context, entryHistory *fpl.EntryHistoryMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "ManagerRepo.InsertManagerFullHistory")
+	defer func() { tracing.End(span, err) }()
+
 	if entryHistory == nil {
 		return nil
 	}
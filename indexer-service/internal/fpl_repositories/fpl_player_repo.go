@@ -1,12 +1,17 @@
 package fpl_repositories
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
 	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type PlayerRepo struct {
@@ -33,46 +38,44 @@ func nullIfEmpty(s string) interface{} {
 }
 
 // InsertPlayerBootstrapComplete handles all player bootstrap data at once
-func (r *PlayerRepo) InsertPlayerBootstrapComplete(players []*fpl.PlayerBootstrapMessage) error {
+func (r *PlayerRepo) InsertPlayerBootstrapComplete(ctx context.Context, players []*fpl.PlayerBootstrapMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayerBootstrapComplete", attribute.Int("db.operation.batch.size", len(players)))
+	defer func() { tracing.End(span, err) }()
+
 	log.Printf("Attempting to insert %d players...", len(players))
 
 	if err := r.InsertPlayers(players); err != nil {
-		log.Printf("❌ Error inserting players table: %v", err)
-		fmt.Errorf("inserting players: %w", err)
+		return err
 	}
 	log.Printf("Inserted %d players into players table", len(players))
 
 	if err := r.InsertPlayerCosts(players); err != nil {
-		log.Printf("❌ Error inserting player_costs: %v", err)
-		fmt.Errorf("inserting player costs: %w", err)
+		return err
 	}
 	log.Printf("Inserted %d records into player_costs", len(players))
 
 	if err := r.InsertPlayerSeasonStats(players); err != nil {
-		log.Printf("❌ Error inserting player_season_stats: %v", err)
-		fmt.Errorf("inserting player season stats: %w", err)
+		return err
 	}
 	log.Printf("Inserted %d records into player_season_stats", len(players))
 
 	if err := r.InsertPlayerICTStats(players); err != nil {
-		log.Printf("❌ Error inserting player_ict_stats: %v", err)
-		fmt.Errorf("inserting player ICT stats: %w", err)
+		return err
 	}
 	log.Printf("Inserted %d records into player_ict_stats", len(players))
 
 	if err := r.InsertPlayerExpectedStats(players); err != nil {
-		log.Printf("❌ Error inserting player_expected_stats: %v", err)
-		fmt.Errorf("inserting player expected stats: %w", err)
+		return err
 	}
 	log.Printf("Inserted %d records into player_expected_stats", len(players))
 
 	if err := r.InsertPlayerRankings(players); err != nil {
-		log.Printf("❌ Error inserting player_rankings: %v", err)
-		fmt.Errorf("inserting player rankings: %w", err)
+		return err
 	}
 	log.Printf("Inserted %d records into player_rankings", len(players))
 
-	return nil
+	// The history is versioned from the current-state tables written above.
+	return r.InsertPlayerHistory(players)
 }
 
 // InsertPlayers inserts/updates main player information
@@ -86,6 +89,7 @@ func (r *PlayerRepo) InsertPlayers(players []*fpl.PlayerBootstrapMessage) error
 		"team_id", "team_code", "element_type_id", "status", "photo",
 		"squad_number", "birth_date", "team_join_date", "region", "opta_code",
 		"can_transact", "can_select", "in_dreamteam", "dreamteam_count", "special", "removed", "unavailable",
+		"news", "news_added", "chance_of_playing_this_round", "chance_of_playing_next_round",
 	).Suffix("ON CONFLICT (player_id, season_id) DO UPDATE SET " +
 		"season_id = EXCLUDED.season_id, " +
 		"player_code = EXCLUDED.player_code, " +
@@ -109,6 +113,10 @@ func (r *PlayerRepo) InsertPlayers(players []*fpl.PlayerBootstrapMessage) error
 		"special = EXCLUDED.special, " +
 		"removed = EXCLUDED.removed, " +
 		"unavailable = EXCLUDED.unavailable, " +
+		"news = EXCLUDED.news, " +
+		"news_added = EXCLUDED.news_added, " +
+		"chance_of_playing_this_round = EXCLUDED.chance_of_playing_this_round, " +
+		"chance_of_playing_next_round = EXCLUDED.chance_of_playing_next_round, " +
 		"updated_at = CURRENT_TIMESTAMP").
 		PlaceholderFormat(sq.Dollar)
 
@@ -119,6 +127,7 @@ func (r *PlayerRepo) InsertPlayers(players []*fpl.PlayerBootstrapMessage) error
 			p.Player.Team, p.Player.TeamCode, p.Player.ElementType, p.Player.Status, p.Player.Photo,
 			p.Player.SquadNumber, nullIfEmpty(p.Player.BirthDate), nullIfEmpty(p.Player.TeamJoinDate), p.Player.Region, p.Player.OptaCode,
 			p.Player.CanTransact, p.Player.CanSelect, p.Player.InDreamteam, p.Player.DreamteamCount, p.Player.Special, p.Player.Removed, p.Player.Unavailable,
+			p.Player.News, nullIfEmpty(p.Player.NewsAdded), p.Player.ChanceOfPlayingThisRound, p.Player.ChanceOfPlayingNextRound,
 		)
 		successCount++
 	}
@@ -147,12 +156,17 @@ func (r *PlayerRepo) InsertPlayerCosts(players []*fpl.PlayerBootstrapMessage) er
 	query := sq.Insert("player_costs").Columns(
 		"player_id", "season_id", "now_cost", "cost_change_event", "cost_change_event_fall",
 		"cost_change_start", "cost_change_start_fall",
+		"transfers_in", "transfers_out", "transfers_in_event", "transfers_out_event",
 	).Suffix("ON CONFLICT (player_id, season_id) DO UPDATE SET " +
 		"now_cost = EXCLUDED.now_cost, " +
 		"cost_change_event = EXCLUDED.cost_change_event, " +
 		"cost_change_event_fall = EXCLUDED.cost_change_event_fall, " +
 		"cost_change_start = EXCLUDED.cost_change_start, " +
 		"cost_change_start_fall = EXCLUDED.cost_change_start_fall, " +
+		"transfers_in = EXCLUDED.transfers_in, " +
+		"transfers_out = EXCLUDED.transfers_out, " +
+		"transfers_in_event = EXCLUDED.transfers_in_event, " +
+		"transfers_out_event = EXCLUDED.transfers_out_event, " +
 		"updated_at = CURRENT_TIMESTAMP").
 		PlaceholderFormat(sq.Dollar)
 
@@ -160,6 +174,7 @@ func (r *PlayerRepo) InsertPlayerCosts(players []*fpl.PlayerBootstrapMessage) er
 		query = query.Values(
 			p.Player.Id, p.SeasonId, p.Player.NowCost, p.Player.CostChangeEvent, p.Player.CostChangeEventFall,
 			p.Player.CostChangeStart, p.Player.CostChangeStartFall,
+			p.Player.TransfersIn, p.Player.TransfersOut, p.Player.TransfersInEvent, p.Player.TransfersOutEvent,
 		)
 	}
 
@@ -324,6 +339,36 @@ func (r *PlayerRepo) InsertPlayerExpectedStats(players []*fpl.PlayerBootstrapMes
 	return nil
 }
 
+// playerHistory versions the player attributes worth knowing at a past
+// deadline. It reads the tables the rest of the bootstrap was just stored in.
+var playerHistory = attributeHistory{
+	table: "player_attribute_history",
+	keys:  []string{"player_id", "season_id"},
+	attrs: []string{"team_id", "element_type_id", "now_cost", "selected_rank"},
+	current: `
+		SELECT p.player_id, p.season_id, p.team_id, p.element_type_id, c.now_cost, r.selected_rank
+		FROM players p
+		LEFT JOIN player_costs c ON c.player_id = p.player_id AND c.season_id = p.season_id
+		LEFT JOIN player_rankings r ON r.player_id = p.player_id AND r.season_id = p.season_id
+		WHERE p.season_id = $1 AND p.player_id = ANY($2)`,
+}
+
+// InsertPlayerHistory records new versions of the batch's players' team,
+// position, price and ownership rank in player_attribute_history.
+func (r *PlayerRepo) InsertPlayerHistory(players []*fpl.PlayerBootstrapMessage) error {
+	bySeason := make(map[int32][]int32)
+	for _, p := range players {
+		bySeason[p.SeasonId] = append(bySeason[p.SeasonId], p.Player.Id)
+	}
+
+	for seasonID, ids := range bySeason {
+		if err := playerHistory.apply(r.db, seasonID, pq.Array(ids)); err != nil {
+			return fmt.Errorf("updating player_attribute_history: %w", err)
+		}
+	}
+	return nil
+}
+
 // InsertPlayerRankings inserts/updates player rankings
 func (r *PlayerRepo) InsertPlayerRankings(players []*fpl.PlayerBootstrapMessage) error {
 	query := sq.Insert("player_rankings").Columns(
@@ -363,7 +408,10 @@ func (r *PlayerRepo) InsertPlayerRankings(players []*fpl.PlayerBootstrapMessage)
 }
 
 // InsertPlayerGameweekStats inserts/updates player gameweek performance stats
-func (r *PlayerRepo) InsertPlayerGameweekStats(history *fpl.PlayerHistoryMessage) error {
+func (r *PlayerRepo) InsertPlayerGameweekStats(ctx context.Context, history *fpl.PlayerHistoryMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayerGameweekStats")
+	defer func() { tracing.End(span, err) }()
+
 	playerHistory := history.History
 
 	if len(playerHistory) == 0 {
@@ -451,7 +499,10 @@ func (r *PlayerRepo) InsertPlayerGameweekStats(history *fpl.PlayerHistoryMessage
 }
 
 // InsertPlayerPastSeasons inserts/updates player past season history
-func (r *PlayerRepo) InsertPlayerPastSeasons(pastHistory []*fpl.PlayerPastHistoryMessage) error {
+func (r *PlayerRepo) InsertPlayerPastSeasons(ctx context.Context, pastHistory []*fpl.PlayerPastHistoryMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayerPastSeasons", attribute.Int("db.operation.batch.size", len(pastHistory)))
+	defer func() { tracing.End(span, err) }()
+
 	if len(pastHistory) == 0 {
 		return nil
 	}
@@ -523,7 +574,10 @@ func (r *PlayerRepo) InsertPlayerPastSeasons(pastHistory []*fpl.PlayerPastHistor
 	return nil
 }
 
-func (r *PlayerRepo) InsertPlayerGameweekExplain(explain []*fpl.LiveEventMessage) error {
+func (r *PlayerRepo) InsertPlayerGameweekExplain(ctx context.Context, explain []*fpl.LiveEventMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayerGameweekExplain", attribute.Int("db.operation.batch.size", len(explain)))
+	defer func() { tracing.End(span, err) }()
+
 	if len(explain) == 0 {
 		return nil
 	}
@@ -536,6 +590,7 @@ func (r *PlayerRepo) InsertPlayerGameweekExplain(explain []*fpl.LiveEventMessage
 		"points = EXCLUDED.points," +
 		"value = EXCLUDED.value," +
 		"points_modification = EXCLUDED.points_modification," +
+		"provisional = FALSE," +
 		"updated_at = CURRENT_TIMESTAMP",
 	).PlaceholderFormat(sq.Dollar)
 
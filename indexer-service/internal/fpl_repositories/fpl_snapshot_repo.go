@@ -0,0 +1,70 @@
+package fpl_repositories
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+)
+
+// SnapshotRepo stores the bootstrap-static snapshots taken before each
+// deadline, compressed as published, in bootstrap_snapshots.
+type SnapshotRepo struct {
+	db            *sql.DB
+	SnapshotModel *fpl.BootstrapSnapshotMessage
+}
+
+func NewSnapshotRepo(db *sql.DB, snapshotModel *fpl.BootstrapSnapshotMessage) *SnapshotRepo {
+	return &SnapshotRepo{
+		db:            db,
+		SnapshotModel: snapshotModel,
+	}
+}
+
+// InsertSnapshot stores a snapshot as the next version of its gameweek. A
+// snapshot identical to a stored one of the same gameweek is dropped.
+func (r *SnapshotRepo) InsertSnapshot(ctx context.Context, s *fpl.BootstrapSnapshotMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "SnapshotRepo.InsertSnapshot")
+	defer func() { tracing.End(span, err) }()
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec("LOCK TABLE bootstrap_snapshots IN SHARE ROW EXCLUSIVE MODE"); err != nil {
+		return fmt.Errorf("locking bootstrap_snapshots: %w", err)
+	}
+
+	var version int32
+	var duplicate bool
+	err = tx.QueryRow(`
+		SELECT COALESCE(MAX(version), 0), COALESCE(bool_or(sha256 = $3), FALSE)
+		FROM bootstrap_snapshots
+		WHERE season_id = $1 AND event = $2`,
+		s.SeasonId, s.Event, s.Sha256,
+	).Scan(&version, &duplicate)
+	if err != nil {
+		return fmt.Errorf("querying GW%d snapshots: %w", s.Event, err)
+	}
+	if duplicate {
+		return nil
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO bootstrap_snapshots (
+			season_id, event, version, deadline_time, captured_at, format_version, sha256, payload
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		s.SeasonId, s.Event, version+1, nullIfEmpty(s.DeadlineTime), nullIfEmpty(s.CapturedAt),
+		s.FormatVersion, s.Sha256, s.Payload,
+	)
+	if err != nil {
+		return fmt.Errorf("inserting GW%d snapshot: %w", s.Event, err)
+	}
+
+	return tx.Commit()
+}
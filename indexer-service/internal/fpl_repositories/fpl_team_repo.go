@@ -1,10 +1,16 @@
 package fpl_repositories
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
 	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type TeamRepo struct {
@@ -19,7 +25,10 @@ func NewTeamRepo(db *sql.DB, teamModel *fpl.Team) *TeamRepo {
 	}
 }
 
-func (r *TeamRepo) InsertTeams(teams []*fpl.TeamMessage) error {
+func (r *TeamRepo) InsertTeams(ctx context.Context, teams []*fpl.TeamMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "TeamRepo.InsertTeams", attribute.Int("db.operation.batch.size", len(teams)))
+	defer func() { tracing.End(span, err) }()
+
 	query := sq.Insert("teams").Columns("season_id", "team_id", "team_code", "name", "short_name", "strength",
 		"form", "position", "points", "played", "win", "draw", "loss", "team_division", "unavailable",
 		"pulse_id", "strength_overall_home", "strength_overall_away", "strength_attack_home", "strength_attack_away",
@@ -54,5 +63,36 @@ func (r *TeamRepo) InsertTeams(teams []*fpl.TeamMessage) error {
 		return err
 	}
 
+	return r.InsertTeamHistory(teams)
+}
+
+// teamHistory versions the strengths FPL rates teams' fixtures by.
+var teamHistory = attributeHistory{
+	table: "team_attribute_history",
+	keys:  []string{"team_id", "season_id"},
+	attrs: []string{
+		"strength", "strength_overall_home", "strength_overall_away", "strength_attack_home",
+		"strength_attack_away", "strength_defence_home", "strength_defence_away",
+	},
+	current: `
+		SELECT team_id, season_id, strength, strength_overall_home, strength_overall_away, strength_attack_home,
+		       strength_attack_away, strength_defence_home, strength_defence_away
+		FROM teams
+		WHERE season_id = $1 AND team_id = ANY($2)`,
+}
+
+// InsertTeamHistory records new versions of the batch's teams' strengths in
+// team_attribute_history.
+func (r *TeamRepo) InsertTeamHistory(teams []*fpl.TeamMessage) error {
+	bySeason := make(map[int32][]int32)
+	for _, t := range teams {
+		bySeason[t.SeasonId] = append(bySeason[t.SeasonId], t.Team.Id)
+	}
+
+	for seasonID, ids := range bySeason {
+		if err := teamHistory.apply(r.db, seasonID, pq.Array(ids)); err != nil {
+			return fmt.Errorf("updating team_attribute_history: %w", err)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	batchSize = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tactify",
+		Subsystem: "indexer",
+		Name:      "batch_size",
+		Help:      "Messages per flushed batch, by topic.",
+		Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+	}, []string{"topic"})
+
+	flushDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tactify",
+		Subsystem: "indexer",
+		Name:      "flush_duration_seconds",
+		Help:      "Time taken to write a flushed batch, by topic.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"topic"})
+)
+
+// ObserveFlush records a batch of size messages from topic whose write
+// started at start.
+func ObserveFlush(topic string, size int, start time.Time) {
+	batchSize.WithLabelValues(topic).Observe(float64(size))
+	flushDuration.WithLabelValues(topic).Observe(time.Since(start).Seconds())
+}
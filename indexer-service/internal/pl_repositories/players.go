@@ -6,6 +6,8 @@ import (
 
 	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
 	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type PlayerRepo struct {
@@ -14,7 +16,10 @@ type PlayerRepo struct {
 }
 
 // InsertPlayers inserts/updates main player information
-func (r *PlayerRepo) InsertPlayers(ctx context.Context, players []*fpl.PlayerBootstrapMessage) error {
+func (r *PlayerRepo) InsertPlayers(ctx context.Context, players []*fpl.PlayerBootstrapMessage) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayers", attribute.Int("db.operation.batch.size", len(players)))
+	defer func() { tracing.End(span, err) }()
+
 	rows := make([][]any, len(players))
 	for i, p := range players {
 		rows[i] = []any{
@@ -35,7 +40,10 @@ func (r *PlayerRepo) InsertPlayers(ctx context.Context, players []*fpl.PlayerBoo
 	})
 }
 
-func (r *PlayerRepo) InsertPlayerSeasons(ctx context.Context, players []*fpl.PlayerBootstrapMessage) error {
+func (r *PlayerRepo) InsertPlayerSeasons(ctx context.Context, players []*fpl.PlayerBootstrapMessage) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayerSeasons", attribute.Int("db.operation.batch.size", len(players)))
+	defer func() { tracing.End(span, err) }()
+
 	rows := make([][]any, len(players))
 	for i, p := range players {
 		rows[i] = []any{
@@ -8,10 +8,14 @@ import (
 	"time"
 
 	"github.com/imadeddine-belkat/indexer-service/config"
+	"github.com/imadeddine-belkat/indexer-service/internal/metrics"
 	"github.com/imadeddine-belkat/indexer-service/internal/sofascore_repositories"
 	kafka "github.com/imadeddine-belkat/tactify-kafka"
 	kafkaConfig "github.com/imadeddine-belkat/tactify-kafka/config"
 	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
 type Handler struct {
@@ -60,6 +64,12 @@ func NewHandler(
 			kafkaCfg.TopicsName.SofascoreTeamMatchStats.Name,
 			kafkaCfg.ConsumersGroupID.SofascoreTeamMatchStats,
 		)
+
+		h.consumers[kafkaCfg.TopicsName.SofascoreTopTeamsStats.Name] = kafka.NewConsumer(
+			kafkaCfg,
+			kafkaCfg.TopicsName.SofascoreTopTeamsStats.Name,
+			kafkaCfg.ConsumersGroupID.SofascoreTopTeamsStats,
+		)
 	}
 
 	if playerRepo != nil {
@@ -68,6 +78,24 @@ func NewHandler(
 			kafkaCfg.TopicsName.SofascorePlayerInfo.Name,
 			kafkaCfg.ConsumersGroupID.SofascorePlayerInfo,
 		)
+
+		h.consumers[kafkaCfg.TopicsName.SofascorePlayerMatchStats.Name] = kafka.NewConsumer(
+			kafkaCfg,
+			kafkaCfg.TopicsName.SofascorePlayerMatchStats.Name,
+			kafkaCfg.ConsumersGroupID.SofascorePlayerMatchStats,
+		)
+
+		h.consumers[kafkaCfg.TopicsName.SofascorePlayerSeasonsStats.Name] = kafka.NewConsumer(
+			kafkaCfg,
+			kafkaCfg.TopicsName.SofascorePlayerSeasonsStats.Name,
+			kafkaCfg.ConsumersGroupID.SofascorePlayerSeasonsStats,
+		)
+
+		h.consumers[kafkaCfg.TopicsName.SofascorePlayerAttributes.Name] = kafka.NewConsumer(
+			kafkaCfg,
+			kafkaCfg.TopicsName.SofascorePlayerAttributes.Name,
+			kafkaCfg.ConsumersGroupID.SofascorePlayerAttributes,
+		)
 	}
 
 	if matchRepo != nil {
@@ -76,6 +104,24 @@ func NewHandler(
 			kafkaCfg.TopicsName.SofascoreLeagueRoundMatches.Name,
 			kafkaCfg.ConsumersGroupID.SofascoreLeagueRoundMatches,
 		)
+
+		h.consumers[kafkaCfg.TopicsName.SofascoreMatchLineups.Name] = kafka.NewConsumer(
+			kafkaCfg,
+			kafkaCfg.TopicsName.SofascoreMatchLineups.Name,
+			kafkaCfg.ConsumersGroupID.SofascoreMatchLineups,
+		)
+
+		h.consumers[kafkaCfg.TopicsName.SofascoreMatchH2hHistory.Name] = kafka.NewConsumer(
+			kafkaCfg,
+			kafkaCfg.TopicsName.SofascoreMatchH2hHistory.Name,
+			kafkaCfg.ConsumersGroupID.SofascoreMatchH2hHistory,
+		)
+
+		h.consumers[kafkaCfg.TopicsName.SofascoreMatchBestPlayers.Name] = kafka.NewConsumer(
+			kafkaCfg,
+			kafkaCfg.TopicsName.SofascoreMatchBestPlayers.Name,
+			kafkaCfg.ConsumersGroupID.SofascoreMatchBestPlayers,
+		)
 	}
 
 	if leagueRepo != nil {
@@ -106,6 +152,13 @@ func (h *Handler) Route(ctx context.Context, topic string) {
 		h.kafkaConfig.TopicsName.SofascoreLeagueRoundMatches.Name: h.handleLeagueRoundMatches,
 		h.kafkaConfig.TopicsName.SofascoreLeagueIDs.Name:          h.handleLeagueInfo,
 		h.kafkaConfig.TopicsName.SofascoreLeagueSeasons.Name:      h.handleLeagueSeasonsInfo,
+		h.kafkaConfig.TopicsName.SofascoreMatchLineups.Name:       h.handleMatchLineups,
+		h.kafkaConfig.TopicsName.SofascorePlayerMatchStats.Name:   h.handlePlayerMatchStats,
+		h.kafkaConfig.TopicsName.SofascoreTopTeamsStats.Name:      h.handleTopTeamsStats,
+		h.kafkaConfig.TopicsName.SofascorePlayerSeasonsStats.Name: h.handlePlayerSeasonsStats,
+		h.kafkaConfig.TopicsName.SofascorePlayerAttributes.Name:   h.handlePlayerAttributes,
+		h.kafkaConfig.TopicsName.SofascoreMatchH2hHistory.Name:    h.handleMatchH2h,
+		h.kafkaConfig.TopicsName.SofascoreMatchBestPlayers.Name:   h.handleMatchBestPlayers,
 	}
 
 	if fn, ok := handlers[topic]; ok {
@@ -123,11 +176,12 @@ func batchProcess[T any, K comparable](
 	flushInterval time.Duration,
 	topicName string,
 	getKey func(T) K,
-	process func(T) error,
+	process func(context.Context, T) error,
 ) {
 
 	messages, errors := consumer.Subscribe(ctx)
 	batch := make(map[K]T)
+	contexts := make(map[K]context.Context)
 	flushTicker := time.NewTicker(flushInterval)
 	defer flushTicker.Stop()
 
@@ -136,24 +190,33 @@ func batchProcess[T any, K comparable](
 			return
 		}
 
-		for _, item := range batch {
-			if err := process(item); err != nil {
+		start := time.Now()
+		for key, item := range batch {
+			itemCtx, span := tracer.Start(contexts[key], "process "+topicName)
+			err := process(itemCtx, item)
+			if err != nil {
 				log.Printf("Error %s for %s: %v\n", logContext, topicName, err)
 			}
+			tracing.End(span, err)
 		}
+		metrics.ObserveFlush(topicName, len(batch), start)
 		batch = make(map[K]T)
+		contexts = make(map[K]context.Context)
 	}
 
 	for {
 		select {
 		case msg := <-messages:
 
-			var item T
-			if err := json.Unmarshal(msg.Value, &item); err != nil {
+			item, err := decode[T](msg.Value)
+			if err != nil {
+				log.Printf("Error decoding %s message: %v\n", topicName, err)
 				continue
 			}
 
-			batch[getKey(item)] = item
+			key := getKey(item)
+			batch[key] = item
+			contexts[key] = kafka.ContextFromMessage(ctx, msg)
 
 			if len(batch) >= batchSize {
 				flushBatch("inserting")
@@ -174,6 +237,25 @@ func batchProcess[T any, K comparable](
 	}
 }
 
+// decode uses protojson for proto messages, since the producer marshals with it and
+// encodes int64 fields (timestamps) as strings that encoding/json rejects.
+func decode[T any](data []byte) (T, error) {
+	var item T
+
+	if m, ok := any(item).(proto.Message); ok {
+		m = m.ProtoReflect().New().Interface()
+		if err := protoUnmarshaler.Unmarshal(data, m); err != nil {
+			return item, err
+		}
+		return m.(T), nil
+	}
+
+	err := json.Unmarshal(data, &item)
+	return item, err
+}
+
+var protoUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+
 func (h *Handler) handleTeamsInfo(ctx context.Context) {
 	batchProcess(
 		ctx,
@@ -271,3 +353,116 @@ func (h *Handler) handleLeagueSeasonsInfo(ctx context.Context) {
 		h.leagueRepo.InsertLeagueSeasonsInfo,
 	)
 }
+
+func (h *Handler) handleMatchLineups(ctx context.Context) {
+	batchProcess(
+		ctx,
+		h.consumers[h.kafkaConfig.TopicsName.SofascoreMatchLineups.Name],
+		1,
+		h.config.FlushInterval,
+		h.kafkaConfig.TopicsName.SofascoreMatchLineups.Name,
+		func(l *sofascore.MatchLineupMessage) int32 {
+			return l.GetMatchId()
+		},
+		func(ctx context.Context, l *sofascore.MatchLineupMessage) error {
+			return h.matchRepo.InsertMatchLineup(ctx, l)
+		},
+	)
+}
+
+func (h *Handler) handlePlayerMatchStats(ctx context.Context) {
+	batchProcess(
+		ctx,
+		h.consumers[h.kafkaConfig.TopicsName.SofascorePlayerMatchStats.Name],
+		h.config.BatchSize,
+		h.config.FlushInterval,
+		h.kafkaConfig.TopicsName.SofascorePlayerMatchStats.Name,
+		func(p *sofascore.PlayerMatchStatsMessage) string {
+			return fmt.Sprintf("%d-%d", p.GetMatchId(), p.GetPlayer().GetPlayer().GetId())
+		},
+		func(ctx context.Context, p *sofascore.PlayerMatchStatsMessage) error {
+			return h.playerRepo.InsertPlayerMatchStats(ctx, p)
+		},
+	)
+}
+
+func (h *Handler) handleTopTeamsStats(ctx context.Context) {
+	batchProcess(
+		ctx,
+		h.consumers[h.kafkaConfig.TopicsName.SofascoreTopTeamsStats.Name],
+		h.config.BatchSize,
+		h.config.FlushInterval,
+		h.kafkaConfig.TopicsName.SofascoreTopTeamsStats.Name,
+		func(t *sofascore.TopTeamStatItem) string {
+			return fmt.Sprintf("%d-%d-%s-%d", t.GetSeasonId(), t.GetLeagueId(), t.GetStatLabel(), t.GetTeam().GetId())
+		},
+		func(ctx context.Context, t *sofascore.TopTeamStatItem) error {
+			return h.teamRepo.InsertTopTeamStat(ctx, t)
+		},
+	)
+}
+
+func (h *Handler) handlePlayerSeasonsStats(ctx context.Context) {
+	batchProcess(
+		ctx,
+		h.consumers[h.kafkaConfig.TopicsName.SofascorePlayerSeasonsStats.Name],
+		h.config.BatchSize,
+		h.config.FlushInterval,
+		h.kafkaConfig.TopicsName.SofascorePlayerSeasonsStats.Name,
+		func(p *sofascore.PlayerSeasonStatsMessage) string {
+			s := p.GetStats()
+			return fmt.Sprintf("%d-%d-%d-%d-%s", p.GetPlayerId(), s.GetUniqueTournament().GetId(), s.GetSeason().GetId(), s.GetTeam().GetId(), s.GetStatistics().GetType())
+		},
+		func(ctx context.Context, p *sofascore.PlayerSeasonStatsMessage) error {
+			return h.playerRepo.InsertPlayerSeasonStats(ctx, p)
+		},
+	)
+}
+
+func (h *Handler) handlePlayerAttributes(ctx context.Context) {
+	batchProcess(
+		ctx,
+		h.consumers[h.kafkaConfig.TopicsName.SofascorePlayerAttributes.Name],
+		h.config.BatchSize,
+		h.config.FlushInterval,
+		h.kafkaConfig.TopicsName.SofascorePlayerAttributes.Name,
+		func(p *sofascore.PlayerAttributesMessage) int32 {
+			return p.GetPlayerId()
+		},
+		func(ctx context.Context, p *sofascore.PlayerAttributesMessage) error {
+			return h.playerRepo.InsertPlayerAttributes(ctx, p)
+		},
+	)
+}
+
+func (h *Handler) handleMatchH2h(ctx context.Context) {
+	batchProcess(
+		ctx,
+		h.consumers[h.kafkaConfig.TopicsName.SofascoreMatchH2hHistory.Name],
+		h.config.BatchSize,
+		h.config.FlushInterval,
+		h.kafkaConfig.TopicsName.SofascoreMatchH2hHistory.Name,
+		func(m *sofascore.MatchH2HMessage) int32 {
+			return m.GetMatchId()
+		},
+		func(ctx context.Context, m *sofascore.MatchH2HMessage) error {
+			return h.matchRepo.InsertMatchH2h(ctx, m)
+		},
+	)
+}
+
+func (h *Handler) handleMatchBestPlayers(ctx context.Context) {
+	batchProcess(
+		ctx,
+		h.consumers[h.kafkaConfig.TopicsName.SofascoreMatchBestPlayers.Name],
+		h.config.BatchSize,
+		h.config.FlushInterval,
+		h.kafkaConfig.TopicsName.SofascoreMatchBestPlayers.Name,
+		func(m *sofascore.MatchBestPlayersMessage) int32 {
+			return m.GetMatchId()
+		},
+		func(ctx context.Context, m *sofascore.MatchBestPlayersMessage) error {
+			return h.matchRepo.InsertMatchBestPlayers(ctx, m)
+		},
+	)
+}
@@ -0,0 +1,5 @@
+package sofascore_handler
+
+import "go.opentelemetry.io/otel"
+
+var tracer = otel.Tracer("github.com/imadeddine-belkat/indexer-service/internal/sofascore_handler")
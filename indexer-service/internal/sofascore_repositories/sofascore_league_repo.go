@@ -1,10 +1,14 @@
 package sofascore_repositories
 
 import (
+	"context"
 	"database/sql"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
 	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type LeagueRepo struct {
@@ -22,7 +26,10 @@ func NewLeagueRepo(
 	}
 }
 
-func (l *LeagueRepo) InsertLeagueInfo(uniqueTournament *sofascore.LeagueUniqueTournaments) error {
+func (l *LeagueRepo) InsertLeagueInfo(ctx context.Context, uniqueTournament *sofascore.LeagueUniqueTournaments) (err error) {
+	_, span := helpers.StartSpan(ctx, "LeagueRepo.InsertLeagueInfo")
+	defer func() { tracing.End(span, err) }()
+
 	query := sq.Insert("leagues").
 		Columns(
 			"league_id", "name", "country").
@@ -52,7 +59,10 @@ func (l *LeagueRepo) InsertLeagueInfo(uniqueTournament *sofascore.LeagueUniqueTo
 	return nil
 }
 
-func (l *LeagueRepo) InsertLeagueSeasonsInfo(seasons []*sofascore.Season) error {
+func (l *LeagueRepo) InsertLeagueSeasonsInfo(ctx context.Context, seasons []*sofascore.Season) (err error) {
+	_, span := helpers.StartSpan(ctx, "LeagueRepo.InsertLeagueSeasonsInfo", attribute.Int("db.operation.batch.size", len(seasons)))
+	defer func() { tracing.End(span, err) }()
+
 	query := sq.Insert("seasons").
 		Columns(
 			"season_id", "league_id", "name", "year", "is_current").
@@ -1,32 +1,51 @@
 package sofascore_repositories
 
 import (
+	"context"
 	"database/sql"
+	"fmt"
+	"strconv"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
 	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"github.com/lib/pq"
 )
 
 type MatchRepo struct {
-	db    *sql.DB
-	Event *sofascore.Event
+	db          *sql.DB
+	Event       *sofascore.Event
+	Lineup      *sofascore.MatchLineupMessage
+	H2H         *sofascore.MatchH2HMessage
+	BestPlayers *sofascore.MatchBestPlayersMessage
 }
 
 func NewMatchRepo(
 	db *sql.DB,
 	event *sofascore.Event,
+	lineup *sofascore.MatchLineupMessage,
+	h2h *sofascore.MatchH2HMessage,
+	bestPlayers *sofascore.MatchBestPlayersMessage,
 ) *MatchRepo {
 	return &MatchRepo{
-		db:    db,
-		Event: event,
+		db:          db,
+		Event:       event,
+		Lineup:      lineup,
+		H2H:         h2h,
+		BestPlayers: bestPlayers,
 	}
 }
 
-func (m *MatchRepo) InsertLeagueRoundMatches(match *sofascore.Event) error {
+func (m *MatchRepo) InsertLeagueRoundMatches(ctx context.Context, match *sofascore.Event) (err error) {
+	_, span := helpers.StartSpan(ctx, "MatchRepo.InsertLeagueRoundMatches")
+	defer func() { tracing.End(span, err) }()
+
 	query := sq.Insert("matches").Columns(
 		"match_id", "season_id", "league_id", "home_team_id", "away_team_id",
-		"home_team_name", "away_team_name", "start_time", "round", "status", "status_description").
+		"home_team_name", "away_team_name", "start_time", "round", "status", "status_description",
+		"home_score", "away_score").
 		Suffix("ON CONFLICT (match_id, season_id, league_id) DO UPDATE SET " +
 			"home_team_id = EXCLUDED.home_team_id, " +
 			"away_team_id = EXCLUDED.away_team_id, " +
@@ -36,20 +55,30 @@ func (m *MatchRepo) InsertLeagueRoundMatches(match *sofascore.Event) error {
 			"round = EXCLUDED.round," +
 			"status = EXCLUDED.status," +
 			"status_description = EXCLUDED.status_description," +
+			"home_score = EXCLUDED.home_score," +
+			"away_score = EXCLUDED.away_score," +
 			"updated_at = CURRENT_TIMESTAMP").
 		PlaceholderFormat(sq.Dollar)
 
-	query = query.Values(match.Id,
-		match.Season.Id,
-		match.Tournament.UniqueTournament.Id,
-		match.HomeTeam.Id,
-		match.AwayTeam.Id,
-		match.HomeTeam.Name,
-		match.AwayTeam.Name,
-		time.Unix(match.StartTimestamp, 0),
-		match.RoundInfo.Round,
-		match.Status.Code,
-		match.Status.Description,
+	// Cup-style rounds have no meaningful number, store their name instead.
+	round := fmt.Sprintf("%d", match.GetRoundInfo().GetRound())
+	if name := match.GetRoundInfo().GetName(); name != "" {
+		round = name
+	}
+
+	query = query.Values(match.GetId(),
+		match.GetSeason().GetId(),
+		match.GetTournament().GetUniqueTournament().GetId(),
+		match.GetHomeTeam().GetId(),
+		match.GetAwayTeam().GetId(),
+		match.GetHomeTeam().GetName(),
+		match.GetAwayTeam().GetName(),
+		time.Unix(match.GetStartTimestamp(), 0),
+		round,
+		match.GetStatus().GetCode(),
+		match.GetStatus().GetDescription(),
+		normalTimeScore(match.GetHomeScore()),
+		normalTimeScore(match.GetAwayScore()),
 	)
 
 	sqlQuery, args, err := query.ToSql()
@@ -64,3 +93,210 @@ func (m *MatchRepo) InsertLeagueRoundMatches(match *sofascore.Event) error {
 
 	return nil
 }
+
+// normalTimeScore is a side's goals after 90 minutes, nil before the match
+// has a score. Older events only carry the current score.
+func normalTimeScore(score *sofascore.Score) any {
+	switch {
+	case score == nil:
+		return nil
+	case score.Normaltime != nil:
+		return score.GetNormaltime()
+	case score.Current != nil:
+		return score.GetCurrent()
+	}
+	return nil
+}
+
+// InsertMatchLineup stores both teams' lineups, their players and missing players
+// in one transaction so a re-published lineup (e.g. once confirmed) replaces the
+// previous one atomically.
+func (m *MatchRepo) InsertMatchLineup(ctx context.Context, msg *sofascore.MatchLineupMessage) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "MatchRepo.InsertMatchLineup")
+	defer func() { tracing.End(span, err) }()
+
+	lineup := msg.GetLineup()
+	now := time.Now()
+
+	sides := []struct {
+		teamID int32
+		isHome bool
+		team   *sofascore.TeamLineup
+	}{
+		{msg.GetHomeTeamId(), true, lineup.GetHome()},
+		{msg.GetAwayTeamId(), false, lineup.GetAway()},
+	}
+
+	var lineupRows, playerRows, missingRows [][]any
+	var teamIDs []int32
+	for _, side := range sides {
+		if side.team == nil {
+			continue
+		}
+		teamIDs = append(teamIDs, side.teamID)
+
+		lineupRows = append(lineupRows, []any{
+			msg.GetMatchId(), side.teamID, msg.GetSeasonId(), msg.GetLeagueId(), msg.GetRound(),
+			side.isHome, side.team.GetFormation(), lineup.GetConfirmed(), now,
+		})
+
+		for _, p := range side.team.GetPlayers() {
+			playerRows = append(playerRows, []any{
+				msg.GetMatchId(), side.teamID, p.GetPlayer().GetId(), p.GetPlayer().GetName(),
+				p.GetPosition(), p.GetShirtNumber(), p.GetSubstitute(), p.GetCaptain(), now,
+			})
+		}
+
+		for _, p := range side.team.GetMissingPlayers() {
+			missingRows = append(missingRows, []any{
+				msg.GetMatchId(), side.teamID, p.GetPlayer().GetId(), p.GetPlayer().GetName(),
+				p.GetType(), p.GetReason(), p.GetDescription(), p.GetExpectedEndDate(), now,
+			})
+		}
+	}
+
+	if len(lineupRows) == 0 {
+		return nil
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning lineup tx for match %d: %w", msg.GetMatchId(), err)
+	}
+	defer tx.Rollback()
+
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table: "match_lineups",
+		Columns: []string{"match_id", "team_id", "season_id", "league_id", "round",
+			"is_home", "formation", "confirmed", "updated_at"},
+		ConflictCols: []string{"match_id", "team_id"},
+		Rows:         lineupRows,
+	}); err != nil {
+		return fmt.Errorf("upserting match_lineups: %w", err)
+	}
+
+	// Players dropped from a provisional lineup must not linger once it is
+	// confirmed. Only the sides in this message are replaced, so a message
+	// carrying one team's lineup keeps the other's.
+	for _, table := range []string{"match_lineup_players", "match_missing_players"} {
+		if _, err = tx.ExecContext(ctx, "DELETE FROM "+table+" WHERE match_id = $1 AND team_id = ANY($2)",
+			msg.GetMatchId(), pq.Array(teamIDs)); err != nil {
+			return fmt.Errorf("clearing %s: %w", table, err)
+		}
+	}
+
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table: "match_lineup_players",
+		Columns: []string{"match_id", "team_id", "player_id", "player_name",
+			"position", "shirt_number", "substitute", "captain", "updated_at"},
+		ConflictCols: []string{"match_id", "player_id"},
+		Rows:         playerRows,
+	}); err != nil {
+		return fmt.Errorf("upserting match_lineup_players: %w", err)
+	}
+
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table: "match_missing_players",
+		Columns: []string{"match_id", "team_id", "player_id", "player_name",
+			"type", "reason", "description", "expected_end_date", "updated_at"},
+		ConflictCols: []string{"match_id", "player_id"},
+		Rows:         missingRows,
+	}); err != nil {
+		return fmt.Errorf("upserting match_missing_players: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+func (m *MatchRepo) InsertMatchH2h(ctx context.Context, msg *sofascore.MatchH2HMessage) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "MatchRepo.InsertMatchH2h")
+	defer func() { tracing.End(span, err) }()
+
+	team := msg.GetH2H().GetTeamDuel()
+	manager := msg.GetH2H().GetManagerDuel()
+
+	// A missing manager duel is stored as NULLs, not as a 0-0-0 record.
+	var managerHomeWins, managerAwayWins, managerDraws any
+	if manager != nil {
+		managerHomeWins, managerAwayWins, managerDraws = manager.GetHomeWins(), manager.GetAwayWins(), manager.GetDraws()
+	}
+
+	return helpers.BatchUpsert(ctx, m.db, helpers.UpsertOpts{
+		Table: "match_h2h",
+		Columns: []string{"match_id", "season_id", "league_id", "home_team_id", "away_team_id",
+			"team_home_wins", "team_away_wins", "team_draws",
+			"manager_home_wins", "manager_away_wins", "manager_draws", "updated_at"},
+		ConflictCols: []string{"match_id"},
+		Rows: [][]any{{
+			msg.GetMatchId(), msg.GetSeasonId(), msg.GetLeagueId(), msg.GetHomeTeamId(), msg.GetAwayTeamId(),
+			team.GetHomeWins(), team.GetAwayWins(), team.GetDraws(),
+			managerHomeWins, managerAwayWins, managerDraws, time.Now(),
+		}},
+	})
+}
+
+func (m *MatchRepo) InsertMatchBestPlayers(ctx context.Context, msg *sofascore.MatchBestPlayersMessage) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "MatchRepo.InsertMatchBestPlayers")
+	defer func() { tracing.End(span, err) }()
+
+	best := msg.GetBestPlayers()
+	now := time.Now()
+
+	sides := []struct {
+		teamID  int32
+		isHome  bool
+		players []*sofascore.BestPlayer
+	}{
+		{msg.GetHomeTeamId(), true, best.GetBestHomeTeamPlayers()},
+		{msg.GetAwayTeamId(), false, best.GetBestAwayTeamPlayers()},
+	}
+
+	motmID := best.GetPlayerOfTheMatch().GetPlayer().GetId()
+
+	var rows [][]any
+	for _, side := range sides {
+		for i, p := range side.players {
+			rows = append(rows, []any{
+				msg.GetMatchId(), p.GetPlayer().GetId(), side.teamID, msg.GetSeasonId(), msg.GetLeagueId(),
+				p.GetPlayer().GetName(), side.isHome, i + 1, p.GetLabel(), parseBestPlayerValue(p.GetValue()),
+				motmID != 0 && p.GetPlayer().GetId() == motmID, now,
+			})
+		}
+	}
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning best players tx for match %d: %w", msg.GetMatchId(), err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM match_best_players WHERE match_id = $1", msg.GetMatchId()); err != nil {
+		return fmt.Errorf("clearing match_best_players: %w", err)
+	}
+
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table: "match_best_players",
+		Columns: []string{"match_id", "player_id", "team_id", "season_id", "league_id",
+			"player_name", "is_home", "rank", "label", "value", "player_of_the_match", "updated_at"},
+		ConflictCols: []string{"match_id", "player_id"},
+		Rows:         rows,
+	}); err != nil {
+		return fmt.Errorf("upserting match_best_players: %w", err)
+	}
+
+	return tx.Commit()
+}
+
+// parseBestPlayerValue turns Sofascore's string rating into a number, or NULL
+// when it is empty or not numeric.
+func parseBestPlayerValue(value string) any {
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil
+	}
+	return v
+}
@@ -1,28 +1,44 @@
 package sofascore_repositories
 
 import (
+	"context"
 	"database/sql"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
 	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
 )
 
 type PlayerRepo struct {
-	db     *sql.DB
-	Player *sofascore.PlayerMessage
+	db          *sql.DB
+	Player      *sofascore.PlayerMessage
+	MatchStats  *sofascore.PlayerMatchStatsMessage
+	SeasonStats *sofascore.PlayerSeasonStatsMessage
+	Attributes  *sofascore.PlayerAttributesMessage
 }
 
 func NewPlayerRepo(
 	db *sql.DB,
 	Player *sofascore.PlayerMessage,
+	MatchStats *sofascore.PlayerMatchStatsMessage,
+	SeasonStats *sofascore.PlayerSeasonStatsMessage,
+	Attributes *sofascore.PlayerAttributesMessage,
 ) *PlayerRepo {
 	return &PlayerRepo{
-		db:     db,
-		Player: Player,
+		db:          db,
+		Player:      Player,
+		MatchStats:  MatchStats,
+		SeasonStats: SeasonStats,
+		Attributes:  Attributes,
 	}
 }
 
-func (p *PlayerRepo) InsertPlayerInfo(playerMessage *sofascore.PlayerMessage) error {
+func (p *PlayerRepo) InsertPlayerInfo(ctx context.Context, playerMessage *sofascore.PlayerMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayerInfo")
+	defer func() { tracing.End(span, err) }()
+
 	query := sq.Insert("players").Columns("player_id", "season_id", "team_id", "team_name", "league_id", "player_name", "player_short_name",
 		"position", "height", "preferred_foot",
 	).Suffix("ON CONFLICT (player_id, season_id, team_id, league_id) DO UPDATE SET " +
@@ -63,3 +79,163 @@ func (p *PlayerRepo) InsertPlayerInfo(playerMessage *sofascore.PlayerMessage) er
 
 	return nil
 }
+
+var playerMatchStatsColumns = []string{
+	"match_id", "player_id", "team_id", "season_id", "league_id", "round",
+	"player_name", "position", "substitute", "captain",
+	"minutes_played", "rating", "rating_original", "rating_alternative", "touches",
+	"total_pass", "accurate_pass", "total_long_balls", "accurate_long_balls",
+	"accurate_own_half_passes", "total_own_half_passes",
+	"accurate_opposition_half_passes", "total_opposition_half_passes",
+	"total_cross", "accurate_cross", "key_pass", "goal_assist", "big_chance_created",
+	"total_shots", "shot_off_target", "on_target_scoring_attempt", "blocked_scoring_attempt",
+	"hit_woodwork", "goals", "big_chance_missed",
+	"expected_goals", "expected_goals_on_target", "expected_assists",
+	"total_tackle", "won_tackle", "total_clearance", "interception_won", "outfielder_block",
+	"ball_recovery", "error_lead_to_a_shot", "error_lead_to_a_goal",
+	"duel_lost", "duel_won", "aerial_lost", "aerial_won", "total_contest", "won_contest",
+	"challenge_lost", "possession_lost_ctrl", "unsuccessful_touch", "dispossessed",
+	"fouls", "was_fouled", "total_offside",
+	"saves", "total_keeper_sweeper", "accurate_keeper_sweeper", "keeper_save_value", "goals_prevented",
+	"shot_value_normalized", "pass_value_normalized", "dribble_value_normalized",
+	"defensive_value_normalized", "goalkeeper_value_normalized",
+	"updated_at",
+}
+
+func (p *PlayerRepo) InsertPlayerMatchStats(ctx context.Context, msg *sofascore.PlayerMatchStatsMessage) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayerMatchStats")
+	defer func() { tracing.End(span, err) }()
+
+	mp := msg.GetPlayer()
+	s := mp.GetStatistics()
+
+	row := []any{
+		msg.GetMatchId(), mp.GetPlayer().GetId(), mp.GetTeamId(), msg.GetSeasonId(), msg.GetLeagueId(), msg.GetRound(),
+		mp.GetPlayer().GetName(), mp.GetPosition(), mp.GetSubstitute(), mp.GetCaptain(),
+		s.GetMinutesPlayed(), s.GetRating(), s.GetRatingVersions().GetOriginal(), s.GetRatingVersions().GetAlternative(), s.GetTouches(),
+		s.GetTotalPass(), s.GetAccuratePass(), s.GetTotalLongBalls(), s.GetAccurateLongBalls(),
+		s.GetAccurateOwnHalfPasses(), s.GetTotalOwnHalfPasses(),
+		s.GetAccurateOppositionHalfPasses(), s.GetTotalOppositionHalfPasses(),
+		s.GetTotalCross(), s.GetAccurateCross(), s.GetKeyPass(), s.GetGoalAssist(), s.GetBigChanceCreated(),
+		s.GetTotalShots(), s.GetShotOffTarget(), s.GetOnTargetScoringAttempt(), s.GetBlockedScoringAttempt(),
+		s.GetHitWoodwork(), s.GetGoals(), s.GetBigChanceMissed(),
+		s.GetExpectedGoals(), s.GetExpectedGoalsOnTarget(), s.GetExpectedAssists(),
+		s.GetTotalTackle(), s.GetWonTackle(), s.GetTotalClearance(), s.GetInterceptionWon(), s.GetOutfielderBlock(),
+		s.GetBallRecovery(), s.GetErrorLeadToAShot(), s.GetErrorLeadToAGoal(),
+		s.GetDuelLost(), s.GetDuelWon(), s.GetAerialLost(), s.GetAerialWon(), s.GetTotalContest(), s.GetWonContest(),
+		s.GetChallengeLost(), s.GetPossessionLostCtrl(), s.GetUnsuccessfulTouch(), s.GetDispossessed(),
+		s.GetFouls(), s.GetWasFouled(), s.GetTotalOffside(),
+		s.GetSaves(), s.GetTotalKeeperSweeper(), s.GetAccurateKeeperSweeper(), s.GetKeeperSaveValue(), s.GetGoalsPrevented(),
+		s.GetShotValueNormalized(), s.GetPassValueNormalized(), s.GetDribbleValueNormalized(),
+		s.GetDefensiveValueNormalized(), s.GetGoalkeeperValueNormalized(),
+		time.Now(),
+	}
+
+	return helpers.BatchUpsert(ctx, p.db, helpers.UpsertOpts{
+		Table:        "player_match_stats",
+		Columns:      playerMatchStatsColumns,
+		ConflictCols: []string{"match_id", "player_id"},
+		Rows:         [][]any{row},
+	})
+}
+
+var playerSeasonStatsColumns = []string{
+	"player_id", "unique_tournament_id", "season_id", "team_id", "stat_type",
+	"unique_tournament_name", "season_name", "season_year", "start_year", "end_year", "team_name",
+	"appearances", "minutes_played", "rating", "total_rating", "count_rating",
+	"goals", "assists", "goals_assists_sum", "expected_goals", "expected_assists",
+	"big_chances_created", "big_chances_missed",
+	"total_shots", "shots_on_target", "shots_from_inside_the_box",
+	"key_passes", "pass_to_assist", "total_passes", "accurate_passes", "accurate_passes_percentage",
+	"total_long_balls", "accurate_long_balls", "accurate_long_balls_percentage",
+	"total_cross", "accurate_crosses", "accurate_crosses_percentage",
+	"successful_dribbles", "dribbled_past",
+	"tackles", "interceptions", "blocked_shots", "outfielder_blocks", "aerial_duels_won", "error_lead_to_goal",
+	"clean_sheet", "goals_conceded", "saves",
+	"yellow_cards", "red_cards",
+	"updated_at",
+}
+
+func (p *PlayerRepo) InsertPlayerSeasonStats(ctx context.Context, msg *sofascore.PlayerSeasonStatsMessage) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayerSeasonStats")
+	defer func() { tracing.End(span, err) }()
+
+	ps := msg.GetStats()
+	s := ps.GetStatistics()
+
+	row := []any{
+		msg.GetPlayerId(), ps.GetUniqueTournament().GetId(), ps.GetSeason().GetId(), ps.GetTeam().GetId(), s.GetType(),
+		ps.GetUniqueTournament().GetName(), ps.GetSeason().GetName(), ps.GetYear(), ps.GetStartYear(), ps.GetEndYear(), ps.GetTeam().GetName(),
+		s.GetAppearances(), s.GetMinutesPlayed(), s.GetRating(), s.GetTotalRating(), s.GetCountRating(),
+		s.GetGoals(), s.GetAssists(), s.GetGoalsAssistsSum(), s.GetExpectedGoals(), s.GetExpectedAssists(),
+		s.GetBigChancesCreated(), s.GetBigChancesMissed(),
+		s.GetTotalShots(), s.GetShotsOnTarget(), s.GetShotsFromInsideTheBox(),
+		s.GetKeyPasses(), s.GetPassToAssist(), s.GetTotalPasses(), s.GetAccuratePasses(), s.GetAccuratePassesPercentage(),
+		s.GetTotalLongBalls(), s.GetAccurateLongBalls(), s.GetAccurateLongBallsPercentage(),
+		s.GetTotalCross(), s.GetAccurateCrosses(), s.GetAccurateCrossesPercentage(),
+		s.GetSuccessfulDribbles(), s.GetDribbledPast(),
+		s.GetTackles(), s.GetInterceptions(), s.GetBlockedShots(), s.GetOutfielderBlocks(), s.GetAerialDuelsWon(), s.GetErrorLeadToGoal(),
+		s.GetCleanSheet(), s.GetGoalsConceded(), s.GetSaves(),
+		s.GetYellowCards(), s.GetRedCards(),
+		time.Now(),
+	}
+
+	return helpers.BatchUpsert(ctx, p.db, helpers.UpsertOpts{
+		Table:        "player_season_stats",
+		Columns:      playerSeasonStatsColumns,
+		ConflictCols: []string{"player_id", "unique_tournament_id", "season_id", "team_id", "stat_type"},
+		Rows:         [][]any{row},
+	})
+}
+
+// InsertPlayerAttributes replaces the attribute profile of a player. The rows
+// are keyed by year_shift, which is relative to the current year, so older
+// rows are dropped rather than left behind under a stale shift.
+func (p *PlayerRepo) InsertPlayerAttributes(ctx context.Context, msg *sofascore.PlayerAttributesMessage) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "PlayerRepo.InsertPlayerAttributes")
+	defer func() { tracing.End(span, err) }()
+
+	playerId := msg.GetPlayerId()
+	now := time.Now()
+
+	var rows [][]any
+	for kind, overviews := range map[string][]*sofascore.PlayerAttributeOverview{
+		"player":  msg.GetAttributes().GetPlayerAttributeOverviews(),
+		"average": msg.GetAttributes().GetAverageAttributeOverviews(),
+	} {
+		for _, o := range overviews {
+			rows = append(rows, []any{
+				playerId, kind, o.GetYearShift(), o.GetPosition(),
+				o.GetAttacking(), o.GetTechnical(), o.GetTactical(), o.GetDefending(), o.GetCreativity(),
+				now,
+			})
+		}
+	}
+
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM player_attributes WHERE player_id = $1", playerId); err != nil {
+		return err
+	}
+
+	if len(rows) > 0 {
+		if err := helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+			Table: "player_attributes",
+			Columns: []string{
+				"player_id", "kind", "year_shift", "position",
+				"attacking", "technical", "tactical", "defending", "creativity",
+				"updated_at",
+			},
+			ConflictCols: []string{"player_id", "kind", "year_shift"},
+			Rows:         rows,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
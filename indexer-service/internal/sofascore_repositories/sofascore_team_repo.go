@@ -1,13 +1,17 @@
 package sofascore_repositories
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/imadeddine-belkat/indexer-service/internal/db/helpers"
 	"github.com/imadeddine-belkat/indexer-service/internal/sofascore_helper"
 	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
 )
 
 type TeamRepo struct {
@@ -16,22 +20,28 @@ type TeamRepo struct {
 	LeagueStanding   *sofascore.StandingMessage
 	TeamOverallStats *sofascore.TeamOverallStatsMessage
 	MatchStats       *sofascore.MatchStatsMessage
+	TopTeamStat      *sofascore.TopTeamStatItem
 }
 
 func NewTeamRepo(
 	db *sql.DB,
 	leagueStanding *sofascore.StandingMessage,
 	ovrStats *sofascore.TeamOverallStatsMessage,
-	MatchStats *sofascore.MatchStatsMessage) *TeamRepo {
+	MatchStats *sofascore.MatchStatsMessage,
+	topTeamStat *sofascore.TopTeamStatItem) *TeamRepo {
 	return &TeamRepo{
 		db:               db,
 		LeagueStanding:   leagueStanding,
 		TeamOverallStats: ovrStats,
 		MatchStats:       MatchStats,
+		TopTeamStat:      topTeamStat,
 	}
 }
 
-func (t *TeamRepo) InsertTeamInfo(standing *sofascore.StandingMessage) error {
+func (t *TeamRepo) InsertTeamInfo(ctx context.Context, standing *sofascore.StandingMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "TeamRepo.InsertTeamInfo")
+	defer func() { tracing.End(span, err) }()
+
 	query := sq.Insert("teams").
 		Columns(
 			"team_id", "league_id", "name", "primary_color", "secondary_color").
@@ -60,7 +70,10 @@ func (t *TeamRepo) InsertTeamInfo(standing *sofascore.StandingMessage) error {
 	return nil
 }
 
-func (r *TeamRepo) InsertTeamOverallStats(ovrStats *sofascore.TeamOverallStatsMessage) error {
+func (r *TeamRepo) InsertTeamOverallStats(ctx context.Context, ovrStats *sofascore.TeamOverallStatsMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "TeamRepo.InsertTeamOverallStats")
+	defer func() { tracing.End(span, err) }()
+
 	stats := ovrStats.Statistics
 
 	query := sq.Insert("team_overall_stats").
@@ -499,7 +512,10 @@ func (r *TeamRepo) InsertTeamOverallStats(ovrStats *sofascore.TeamOverallStatsMe
 	return err
 }
 
-func (r *TeamRepo) InsertTeamMatchStats(matchStat *sofascore.MatchStatsMessage) error {
+func (r *TeamRepo) InsertTeamMatchStats(ctx context.Context, matchStat *sofascore.MatchStatsMessage) (err error) {
+	_, span := helpers.StartSpan(ctx, "TeamRepo.InsertTeamMatchStats")
+	defer func() { tracing.End(span, err) }()
+
 	var tableName string
 	columnMap := make(map[string]interface{})
 
@@ -571,3 +587,50 @@ func (r *TeamRepo) InsertTeamMatchStats(matchStat *sofascore.MatchStatsMessage)
 	_, err = r.db.Exec(sqlQuery, args...)
 	return err
 }
+
+// InsertTopTeamStat upserts a team's current position on a leaderboard and
+// records a history snapshot per capture date, so a rank that moves because
+// of other teams' results is kept even when the team has not played.
+func (r *TeamRepo) InsertTopTeamStat(ctx context.Context, item *sofascore.TopTeamStatItem) (err error) {
+	ctx, span := helpers.StartSpan(ctx, "TeamRepo.InsertTopTeamStat")
+	defer func() { tracing.End(span, err) }()
+
+	if item.GetStatLabel() == "" || item.GetTeam().GetId() == 0 {
+		return fmt.Errorf("top team stat missing label or team: %q/%d", item.GetStatLabel(), item.GetTeam().GetId())
+	}
+
+	now := time.Now()
+	matches := item.GetStatistics().GetMatches()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginning top team stat tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table:        "top_teams_leaderboard",
+		Columns:      []string{"season_id", "league_id", "stat_label", "team_id", "value", "rank", "matches", "updated_at"},
+		ConflictCols: []string{"season_id", "league_id", "stat_label", "team_id"},
+		Rows: [][]any{{
+			item.GetSeasonId(), item.GetLeagueId(), item.GetStatLabel(), item.GetTeam().GetId(),
+			item.GetValue(), item.GetRank(), matches, now,
+		}},
+	}); err != nil {
+		return fmt.Errorf("upserting top_teams_leaderboard: %w", err)
+	}
+
+	if err = helpers.BatchUpsert(ctx, tx, helpers.UpsertOpts{
+		Table:        "top_teams_leaderboard_history",
+		Columns:      []string{"season_id", "league_id", "stat_label", "team_id", "recorded_on", "matches", "value", "rank", "recorded_at"},
+		ConflictCols: []string{"season_id", "league_id", "stat_label", "team_id", "recorded_on"},
+		Rows: [][]any{{
+			item.GetSeasonId(), item.GetLeagueId(), item.GetStatLabel(), item.GetTeam().GetId(),
+			now.Format(time.DateOnly), matches, item.GetValue(), item.GetRank(), now,
+		}},
+	}); err != nil {
+		return fmt.Errorf("upserting top_teams_leaderboard_history: %w", err)
+	}
+
+	return tx.Commit()
+}
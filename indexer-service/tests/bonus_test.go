@@ -0,0 +1,86 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/imadeddine-belkat/indexer-service/internal/fpl_bonus"
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+)
+
+func TestAwardBonusTies(t *testing.T) {
+	cases := []struct {
+		name string
+		bps  map[int32]int32
+		want map[int32]int32
+	}{
+		{"no ties", map[int32]int32{1: 40, 2: 30, 3: 20, 4: 10}, map[int32]int32{1: 3, 2: 2, 3: 1}},
+		{"tie for first", map[int32]int32{1: 40, 2: 40, 3: 30, 4: 20}, map[int32]int32{1: 3, 2: 3, 3: 1}},
+		{"tie for second", map[int32]int32{1: 40, 2: 30, 3: 30, 4: 20}, map[int32]int32{1: 3, 2: 2, 3: 2}},
+		{"tie for third", map[int32]int32{1: 40, 2: 30, 3: 20, 4: 20, 5: 10}, map[int32]int32{1: 3, 2: 2, 3: 1, 4: 1}},
+		{"three-way tie for first", map[int32]int32{1: 40, 2: 40, 3: 40, 4: 30}, map[int32]int32{1: 3, 2: 3, 3: 3}},
+	}
+
+	for _, c := range cases {
+		got := fpl_bonus.AwardBonus(c.bps)
+		if len(got) != len(c.want) {
+			t.Fatalf("%s: got %v, want %v", c.name, got, c.want)
+		}
+		for id, bonus := range c.want {
+			if got[id] != bonus {
+				t.Fatalf("%s: got %v, want %v", c.name, got, c.want)
+			}
+		}
+	}
+}
+
+func TestCalculatorSeedKeepsLiveBPS(t *testing.T) {
+	c := fpl_bonus.NewCalculator()
+	key := fpl_bonus.FixtureKey{SeasonID: 2025, FixtureID: 7}
+
+	// After a restart the stored BPS covers players the live topic has not
+	// repeated; the live message for player 1 is newer than what is stored.
+	c.Seed(key, 3, map[int32]int32{1: 10, 2: 30, 3: 25})
+	c.Add(liveMessage(1, 3, 7, 90, 40))
+
+	got := c.Fixture(key).Provisional()
+	want := map[int32]int32{1: 3, 2: 2, 3: 1}
+	for id, bonus := range want {
+		if got[id] != bonus {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	// Seeding a fixture already followed live changes nothing.
+	c.Seed(key, 3, map[int32]int32{4: 99})
+	if _, ok := c.Fixture(key).BPS[4]; ok {
+		t.Fatalf("seed should not overwrite a known fixture, got %v", c.Fixture(key).BPS)
+	}
+}
+
+func TestCalculatorEvictBefore(t *testing.T) {
+	c := fpl_bonus.NewCalculator()
+	c.Add(liveMessage(1, 3, 7, 90, 40))
+	c.Add(liveMessage(2, 4, 8, 90, 40))
+
+	c.EvictBefore(4)
+
+	if c.Fixture(fpl_bonus.FixtureKey{SeasonID: 2025, FixtureID: 7}) != nil {
+		t.Fatalf("fixture of gameweek 3 should be evicted")
+	}
+	if c.Fixture(fpl_bonus.FixtureKey{SeasonID: 2025, FixtureID: 8}) == nil {
+		t.Fatalf("fixture of gameweek 4 should be kept")
+	}
+}
+
+func liveMessage(playerID, event, fixtureID, minutes, bps int32) *fpl.LiveEventMessage {
+	return &fpl.LiveEventMessage{
+		PlayerId: playerID,
+		Event:    event,
+		SeasonId: 2025,
+		Stats:    &fpl.LiveStats{Minutes: minutes, Bps: bps},
+		Explain: []*fpl.ExplainItem{{
+			Fixture: fixtureID,
+			Stats:   []*fpl.ExplainStatItem{{Identifier: "minutes", Value: minutes}},
+		}},
+	}
+}
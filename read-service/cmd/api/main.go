@@ -1 +1,167 @@
-package api
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/imadeddine-belkat/read-service/config"
+	"github.com/imadeddine-belkat/read-service/internal/api"
+	"github.com/imadeddine-belkat/read-service/internal/availability"
+	"github.com/imadeddine-belkat/read-service/internal/db/connection"
+	"github.com/imadeddine-belkat/read-service/internal/live"
+	"github.com/imadeddine-belkat/read-service/internal/sse"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// The live topic is produced with protojson.
+var protoUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+
+func main() {
+	cfg := config.LoadConfig()
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "read-service")
+	if err != nil {
+		log.Fatalf("Error setting up tracing: %v", err)
+	}
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	repo := live.NewRepo(fplDb.DB())
+	tracker := live.NewTracker(repo, cfg.CurrentSeasonID)
+
+	event := cfg.Live.Event
+	if event == 0 {
+		if event, err = repo.LatestEvent(ctx, cfg.CurrentSeasonID); err != nil {
+			log.Fatalf("Error finding the live gameweek: %v", err)
+		}
+	}
+	if err := tracker.Load(ctx, event); err != nil {
+		log.Fatalf("Error loading GW%d: %v", event, err)
+	}
+	log.Printf("Tracking GW%d", event)
+
+	broker := sse.NewBroker(64)
+
+	consumer := kafka.NewConsumer(&cfg.Kafka, cfg.Kafka.TopicsName.FplLiveEvent.Name, cfg.Kafka.ConsumersGroupID.ReadLive)
+	defer consumer.Close()
+
+	go consume(ctx, consumer, tracker, cfg.Live.Event == 0)
+	go publish(ctx, tracker, broker, cfg.Live.PublishInterval)
+	go refresh(ctx, tracker, cfg.Live.RefreshInterval)
+
+	mux := http.NewServeMux()
+	(&api.LiveHandler{Tracker: tracker, Broker: broker}).Register(mux)
+	(&api.AvailabilityHandler{Repo: availability.NewRepo(fplDb.DB()), SeasonID: cfg.CurrentSeasonID}).Register(mux)
+	mux.Handle("GET /metrics", promhttp.Handler())
+
+	server := &http.Server{Addr: cfg.HTTP.Addr, Handler: mux}
+	go func() {
+		log.Printf("read-service listening on %s", cfg.HTTP.Addr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("Error serving HTTP: %v", err)
+		}
+	}()
+
+	<-ctx.Done()
+	log.Println("Shutting down...")
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		log.Printf("Error shutting down HTTP: %v", err)
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Printf("Error flushing traces: %v", err)
+	}
+}
+
+// consume feeds the live topic into the tracker and, unless the gameweek is
+// pinned, moves on to the next gameweek when its first message arrives.
+func consume(ctx context.Context, consumer *kafka.Consumer, tracker *live.Tracker, follow bool) {
+	messages, errs := consumer.Subscribe(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				continue
+			}
+			log.Printf("Error consuming live topic: %v", err)
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			liveMsg := &fpl.LiveEventMessage{}
+			if err := protoUnmarshaler.Unmarshal(msg.Value, liveMsg); err != nil {
+				log.Printf("Error decoding live message %s: %v", msg.Key, err)
+				continue
+			}
+			if !tracker.Apply(liveMsg) || !follow {
+				continue
+			}
+			event := int(liveMsg.GetEvent())
+			if err := tracker.Load(ctx, event); err != nil {
+				log.Printf("Error loading GW%d: %v", event, err)
+				continue
+			}
+			log.Printf("Tracking GW%d", event)
+			tracker.Apply(liveMsg)
+		}
+	}
+}
+
+func publish(ctx context.Context, tracker *live.Tracker, broker *sse.Broker, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, s := range tracker.Recompute() {
+				broker.Publish(sse.Event{Name: api.EventStanding, Data: s, Key: s.ManagerID})
+			}
+		}
+	}
+}
+
+func refresh(ctx context.Context, tracker *live.Tracker, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := tracker.Refresh(ctx); err != nil {
+				log.Printf("Error refreshing GW%d: %v", tracker.Event(), err)
+			}
+		}
+	}
+}
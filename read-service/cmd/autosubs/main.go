@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/imadeddine-belkat/read-service/config"
+	"github.com/imadeddine-belkat/read-service/internal/db/connection"
+	"github.com/imadeddine-belkat/read-service/internal/live"
+)
+
+func main() {
+	cfg := config.LoadConfig()
+
+	season := flag.Int("season", cfg.CurrentSeasonID, "FPL season id")
+	event := flag.Int("event", 0, "gameweek to simulate (0 = latest with picks)")
+	managerIDs := flag.String("managers", "", "comma-separated manager ids (default: every indexed manager)")
+	flag.Parse()
+
+	managers, err := parseIDs(*managerIDs)
+	if err != nil {
+		log.Fatalf("Invalid -managers: %v", err)
+	}
+
+	ctx := context.Background()
+
+	fplDb, err := connection.NewRepository(
+		cfg.Postgres.Host,
+		cfg.Postgres.Port,
+		cfg.Postgres.User,
+		cfg.Postgres.Password,
+		cfg.Postgres.FplDatabase,
+		cfg.Postgres.SSLMode,
+	)
+	if err != nil {
+		log.Fatal("Failed to connect to fpl database:", err)
+	}
+	defer fplDb.Close()
+
+	repo := live.NewRepo(fplDb.DB())
+	if *event == 0 {
+		if *event, err = repo.LatestEvent(ctx, *season); err != nil {
+			log.Fatalf("Error finding the gameweek: %v", err)
+		}
+	}
+
+	sim, err := live.Simulate(ctx, repo, *season, *event, managers)
+	if err != nil {
+		log.Fatalf("Error simulating GW%d: %v", *event, err)
+	}
+
+	log.Printf("GW%d final points with automatic subs", sim.Event)
+	for _, s := range sim.Standings {
+		log.Printf("%4d. %-25s %3d pts (-%d), total %4d, captain %d, subs %v",
+			s.Rank, s.Name, s.Points, s.TransferCost, s.Total, s.Captain, s.Subs)
+	}
+
+	mismatches := 0
+	for _, v := range sim.Verifications {
+		if v.Match() {
+			continue
+		}
+		mismatches++
+		log.Printf("Manager %d: ingested %v, expected %v (missing %v, unexpected %v)",
+			v.ManagerID, v.Ingested, v.Expected, v.Missing, v.Unexpected)
+	}
+	log.Printf("%d of %d managers' automatic subs differ from the simulation", mismatches, len(sim.Verifications))
+}
+
+func parseIDs(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	var ids []int
+	for _, part := range strings.Split(s, ",") {
+		id, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -2,14 +2,21 @@ package config
 
 import (
 	"log"
+	"time"
 
+	kafkaConfig "github.com/imadeddine-belkat/tactify-kafka/config"
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
 )
 
 type ReaderConfig struct {
+	Kafka       kafkaConfig.KafkaConfig
 	Postgres    PostgresConfig
+	HTTP        HTTPConfig
+	Live        LiveConfig
 	WorkerCount int
+
+	CurrentSeasonID int `envconfig:"FPL_CURRENT_SEASON_ID" required:"true"`
 }
 type PostgresConfig struct {
 	Host              string `envconfig:"DB_HOST" default:"localhost"`
@@ -18,6 +25,20 @@ type PostgresConfig struct {
 	SofascoreDatabase string `envconfig:"DB_SOFASCORE_NAME" default:"sofascore"`
 	User              string `envconfig:"DB_USER" default:"tactify"`
 	Password          string `envconfig:"DB_PASSWORD" default:"admin"`
+	SSLMode           string `envconfig:"DB_SSLMODE" default:"disable"`
+}
+
+type HTTPConfig struct {
+	Addr string `envconfig:"READ_HTTP_ADDR" default:":8081"`
+}
+
+type LiveConfig struct {
+	// Event pins the tracked gameweek; 0 follows the live topic.
+	Event int `envconfig:"LIVE_EVENT" default:"0"`
+	// PublishInterval is how often changed managers are recomputed and pushed.
+	PublishInterval time.Duration `envconfig:"LIVE_PUBLISH_INTERVAL" default:"2s"`
+	// RefreshInterval is how often picks and fixtures are reloaded.
+	RefreshInterval time.Duration `envconfig:"LIVE_REFRESH_INTERVAL" default:"5m"`
 }
 
 func LoadConfig() *ReaderConfig {
@@ -34,5 +55,7 @@ func LoadConfig() *ReaderConfig {
 		log.Fatalf("read-service: Unable to load config: %s", err)
 	}
 
+	config.Kafka = *kafkaConfig.LoadConfig()
+
 	return config
 }
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"log"
+	"net/http"
+
+	"github.com/imadeddine-belkat/read-service/internal/availability"
+)
+
+type AvailabilityHandler struct {
+	Repo     *availability.Repo
+	SeasonID int
+}
+
+func (h *AvailabilityHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /availability/changes", h.changes)
+}
+
+// changes lists the latest injury and availability changes, newest first,
+// optionally ?since= a time, for a ?player= or ?team=, up to ?limit=.
+func (h *AvailabilityHandler) changes(w http.ResponseWriter, r *http.Request) {
+	q, err := availability.ParseQuery(h.SeasonID, r.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	changes, err := h.Repo.Latest(r.Context(), q)
+	if err != nil {
+		log.Printf("Error reading availability changes: %v", err)
+		http.Error(w, "error reading availability changes", http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, http.StatusOK, changes)
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/imadeddine-belkat/read-service/internal/live"
+	"github.com/imadeddine-belkat/read-service/internal/sse"
+)
+
+// Server events.
+const (
+	EventSnapshot = "snapshot"
+	EventStanding = "standing"
+)
+
+const heartbeatInterval = 15 * time.Second
+
+type LiveHandler struct {
+	Tracker *live.Tracker
+	Broker  *sse.Broker
+}
+
+func (h *LiveHandler) Register(mux *http.ServeMux) {
+	mux.HandleFunc("GET /live/standings", h.standings)
+	mux.HandleFunc("GET /live/managers/{id}", h.manager)
+	mux.HandleFunc("GET /live/stream", h.stream)
+}
+
+// standings returns every tracked manager in rank order. There is no league
+// filter since league membership is not indexed; see live.Standing.
+func (h *LiveHandler) standings(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, h.Tracker.Standings())
+}
+
+func (h *LiveHandler) manager(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(r.PathValue("id"))
+	if err != nil {
+		http.Error(w, "invalid manager id", http.StatusBadRequest)
+		return
+	}
+	s, ok := h.Tracker.Standing(id)
+	if !ok {
+		http.Error(w, "manager not tracked", http.StatusNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, s)
+}
+
+// stream sends the current standings of the requested managers (all of them
+// without ?managers=) and then every change as it is computed.
+func (h *LiveHandler) stream(w http.ResponseWriter, r *http.Request) {
+	managers, err := parseIDs(r.URL.Query().Get("managers"))
+	if err != nil {
+		http.Error(w, "invalid managers", http.StatusBadRequest)
+		return
+	}
+	var filter func(int) bool
+	if len(managers) > 0 {
+		filter = func(id int) bool { return managers[id] }
+	}
+
+	events, unsubscribe := h.Broker.Subscribe(filter)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	var snapshot []live.Standing
+	for _, s := range h.Tracker.Standings() {
+		if filter == nil || filter(s.ManagerID) {
+			snapshot = append(snapshot, s)
+		}
+	}
+	if err := sse.Write(w, sse.Event{Name: EventSnapshot, Data: snapshot}); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case e := <-events:
+			if err := sse.Write(w, e); err != nil {
+				return
+			}
+		case <-heartbeat.C:
+			if _, err := w.Write([]byte(": heartbeat\n\n")); err != nil {
+				return
+			}
+			if f, ok := w.(http.Flusher); ok {
+				f.Flush()
+			}
+		}
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func parseIDs(s string) (map[int]bool, error) {
+	ids := make(map[int]bool)
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, err
+		}
+		ids[id] = true
+	}
+	return ids, nil
+}
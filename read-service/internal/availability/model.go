@@ -0,0 +1,78 @@
+package availability
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Feed sizes.
+const (
+	DefaultLimit = 50
+	MaxLimit     = 500
+)
+
+// Change is a change of a player's status, news or chance of playing next
+// round, as recorded by the indexer. Chances are nil when FPL has no doubt.
+type Change struct {
+	PlayerID    int       `json:"player_id"`
+	WebName     string    `json:"web_name"`
+	TeamID      int       `json:"team_id"`
+	ElementType int       `json:"element_type"`
+	OldStatus   string    `json:"old_status"`
+	NewStatus   string    `json:"new_status"`
+	OldNews     string    `json:"old_news,omitempty"`
+	News        string    `json:"news"`
+	NewsAdded   time.Time `json:"news_added,omitzero"`
+	OldChance   *int      `json:"old_chance_of_playing_next_round"`
+	Chance      *int      `json:"chance_of_playing_next_round"`
+	ChangedAt   time.Time `json:"changed_at"`
+}
+
+// Query selects the latest changes of a season, newest first. Zero fields
+// don't filter.
+type Query struct {
+	SeasonID int
+	Since    time.Time
+	PlayerID int
+	TeamID   int
+	Limit    int
+}
+
+// ParseQuery reads since (RFC 3339), player, team and limit from a request's
+// query string. The limit defaults to DefaultLimit and is capped at MaxLimit.
+func ParseQuery(seasonID int, v url.Values) (Query, error) {
+	q := Query{SeasonID: seasonID, Limit: DefaultLimit}
+
+	if s := v.Get("since"); s != "" {
+		since, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return q, fmt.Errorf("invalid since: %w", err)
+		}
+		q.Since = since
+	}
+
+	ints := []struct {
+		name string
+		dst  *int
+	}{
+		{"player", &q.PlayerID},
+		{"team", &q.TeamID},
+		{"limit", &q.Limit},
+	}
+	for _, i := range ints {
+		s := v.Get(i.name)
+		if s == "" {
+			continue
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			return q, fmt.Errorf("invalid %s: %q", i.name, s)
+		}
+		*i.dst = n
+	}
+
+	q.Limit = min(q.Limit, MaxLimit)
+	return q, nil
+}
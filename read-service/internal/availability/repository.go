@@ -0,0 +1,70 @@
+package availability
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// Latest returns the changes matching q, newest first.
+func (r *Repo) Latest(ctx context.Context, q Query) ([]Change, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.player_id, COALESCE(p.web_name, ''), COALESCE(p.team_id, 0), COALESCE(p.element_type_id, 0),
+		       COALESCE(c.old_status, ''), COALESCE(c.new_status, ''), COALESCE(c.old_news, ''), COALESCE(c.news, ''),
+		       c.news_added, c.old_chance_of_playing_next_round, c.chance_of_playing_next_round, c.changed_at
+		FROM player_availability_changes c
+		JOIN players p ON p.player_id = c.player_id AND p.season_id = c.season_id
+		WHERE c.season_id = $1
+		  AND ($2::timestamp IS NULL OR c.changed_at > $2)
+		  AND ($3 = 0 OR c.player_id = $3)
+		  AND ($4 = 0 OR p.team_id = $4)
+		ORDER BY c.changed_at DESC, c.id DESC
+		LIMIT $5`,
+		q.SeasonID, nullTime(q), q.PlayerID, q.TeamID, q.Limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying player_availability_changes: %w", err)
+	}
+	defer rows.Close()
+
+	changes := []Change{}
+	for rows.Next() {
+		var c Change
+		var newsAdded sql.NullTime
+		var oldChance, chance sql.NullInt64
+		if err := rows.Scan(&c.PlayerID, &c.WebName, &c.TeamID, &c.ElementType,
+			&c.OldStatus, &c.NewStatus, &c.OldNews, &c.News,
+			&newsAdded, &oldChance, &chance, &c.ChangedAt); err != nil {
+			return nil, fmt.Errorf("scanning player_availability_changes: %w", err)
+		}
+		c.NewsAdded = newsAdded.Time
+		c.OldChance = intOrNil(oldChance)
+		c.Chance = intOrNil(chance)
+		changes = append(changes, c)
+	}
+
+	return changes, rows.Err()
+}
+
+func nullTime(q Query) any {
+	if q.Since.IsZero() {
+		return nil
+	}
+	return q.Since.UTC()
+}
+
+func intOrNil(n sql.NullInt64) *int {
+	if !n.Valid {
+		return nil
+	}
+	v := int(n.Int64)
+	return &v
+}
@@ -0,0 +1,33 @@
+package live
+
+// ApplyProvisionalBonus sets the provisional bonus of every player from the
+// bonus the indexer computes from live BPS and stores as provisional, by
+// player and fixture. Fixtures confirmed since it was loaded are skipped: a
+// fixture is confirmed once FPL marks it finished or any of its players has a
+// bonus line in the explain.
+func ApplyProvisionalBonus(players map[int]*Player, fixtures map[int]Fixture, stored map[int]map[int]int) {
+	confirmed := make(map[int]bool)
+	for id, f := range fixtures {
+		confirmed[id] = f.Finished
+	}
+	for _, p := range players {
+		p.ProvisionalBonus = 0
+		for _, fs := range p.Fixtures {
+			if fs.BonusConfirmed {
+				confirmed[fs.FixtureID] = true
+			}
+		}
+	}
+
+	for playerID, byFixture := range stored {
+		p := players[playerID]
+		if p == nil {
+			continue
+		}
+		for fixtureID, bonus := range byFixture {
+			if !confirmed[fixtureID] {
+				p.ProvisionalBonus += bonus
+			}
+		}
+	}
+}
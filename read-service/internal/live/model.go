@@ -0,0 +1,89 @@
+package live
+
+import (
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+)
+
+// Explain identifiers used by the live computation.
+const (
+	explainMinutes = "minutes"
+	explainBonus   = "bonus"
+)
+
+type Fixture struct {
+	FixtureID int
+	TeamH     int
+	TeamA     int
+	// Minutes is the match clock; FinishedProvisional is set at the final
+	// whistle and Finished once FPL confirms the result and bonus.
+	Minutes             int
+	FinishedProvisional bool
+	Finished            bool
+}
+
+func (f Fixture) Started() bool {
+	return f.Finished || f.FinishedProvisional || f.Minutes > 0
+}
+
+// Done reports whether the match is over, confirmed or not. The clock alone
+// is not enough: it reaches 90 before stoppage time is played.
+func (f Fixture) Done() bool {
+	return f.Finished || f.FinishedProvisional
+}
+
+// FixtureStats is a player's line in one fixture of the gameweek.
+type FixtureStats struct {
+	FixtureID int
+	Minutes   int
+	// Bonus is set when the confirmed bonus is in the explain.
+	Bonus          int
+	BonusConfirmed bool
+}
+
+// Player is a player's live gameweek from the live topic, with the club and
+// position the picks need.
+type Player struct {
+	PlayerID    int
+	TeamID      int
+	ElementType int
+
+	Minutes int
+	// Points is the feed's total, confirmed bonus included.
+	Points   int
+	Fixtures []FixtureStats
+
+	ProvisionalBonus int
+}
+
+// LivePoints adds the provisional bonus of unconfirmed fixtures to the feed's
+// total.
+func (p *Player) LivePoints() int {
+	return p.Points + p.ProvisionalBonus
+}
+
+// PlayerFromMessage reads a live topic message. Club and position are not in
+// the message and are filled in by the tracker.
+func PlayerFromMessage(msg *fpl.LiveEventMessage) *Player {
+	stats := msg.GetStats()
+	p := &Player{
+		PlayerID: int(msg.GetPlayerId()),
+		Minutes:  int(stats.GetMinutes()),
+		Points:   int(stats.GetTotalPoints()),
+	}
+
+	for _, item := range msg.GetExplain() {
+		fs := FixtureStats{FixtureID: int(item.GetFixture())}
+		for _, stat := range item.GetStats() {
+			switch stat.GetIdentifier() {
+			case explainMinutes:
+				fs.Minutes = int(stat.GetValue())
+			case explainBonus:
+				fs.Bonus = int(stat.GetPoints())
+				fs.BonusConfirmed = true
+			}
+		}
+		p.Fixtures = append(p.Fixtures, fs)
+	}
+
+	return p
+}
@@ -0,0 +1,166 @@
+package live
+
+import (
+	"sort"
+)
+
+const goalkeeper = 1
+
+type Pick struct {
+	PlayerID      int  `json:"player_id"`
+	Position      int  `json:"position"`
+	ElementType   int  `json:"element_type"`
+	Multiplier    int  `json:"multiplier"`
+	IsCaptain     bool `json:"is_captain"`
+	IsViceCaptain bool `json:"is_vice_captain"`
+}
+
+type Sub struct {
+	Out int `json:"element_out"`
+	In  int `json:"element_in"`
+}
+
+// Rules are the line-up rules auto-subs must keep: the XI size
+// (GameSettings.squad_squadplay) and the minimum starters per element type
+// (element_types.squad_min_play).
+type Rules struct {
+	SquadPlay int
+	MinPlay   map[int]int
+}
+
+func DefaultRules() Rules {
+	return Rules{SquadPlay: 11, MinPlay: map[int]int{1: 1, 2: 3, 3: 2, 4: 1}}
+}
+
+// Gameweek is the live state of every player and fixture of a gameweek.
+type Gameweek struct {
+	Event    int
+	Players  map[int]*Player
+	Fixtures map[int]Fixture
+	// ProvisionalBonus is the bonus the indexer stored as provisional, by
+	// player and fixture.
+	ProvisionalBonus map[int]map[int]int
+}
+
+// Played reports whether a player has had minutes in the gameweek.
+func (g *Gameweek) Played(playerID int) bool {
+	p := g.Players[playerID]
+	return p != nil && p.Minutes > 0
+}
+
+// Out reports whether a player will not play: no minutes and every fixture of
+// the player's club over, or no fixture at all.
+func (g *Gameweek) Out(playerID int) bool {
+	if g.Played(playerID) {
+		return false
+	}
+	p := g.Players[playerID]
+	if p == nil {
+		return true
+	}
+	for _, f := range g.Fixtures {
+		if (f.TeamH == p.TeamID || f.TeamA == p.TeamID) && !f.Done() {
+			return false
+		}
+	}
+	return true
+}
+
+// Lineup is a manager's picks once the projected auto-subs and captaincy are
+// applied; Multiplier is what each pick scores with.
+type Lineup struct {
+	Picks   []Pick
+	Subs    []Sub
+	Captain int
+}
+
+// ApplySubs plays FPL's automatic substitutions on what is known so far. A
+// starter who is out is replaced by the first bench player, in bench order,
+// who has played and keeps the minimum formation; the goalkeeper can only be
+// replaced by the bench goalkeeper. A bench player whose match is still to come
+// holds the substitution until it is known whether they play, rather than
+// letting the next one in. If the captain is out the vice-captain takes the
+// armband. With Bench Boost every pick already scores and no one is subbed.
+func ApplySubs(picks []Pick, gw *Gameweek, rules Rules) Lineup {
+	lineup := Lineup{Picks: append([]Pick(nil), picks...)}
+	sort.Slice(lineup.Picks, func(i, j int) bool { return lineup.Picks[i].Position < lineup.Picks[j].Position })
+
+	// The armband passes with its multiplier, 3 under Triple Captain.
+	armband := 2
+	var starters, bench []int
+	benchBoost := false
+	for k, p := range lineup.Picks {
+		if p.IsCaptain {
+			armband = max(p.Multiplier, 2)
+		}
+		if p.Position <= rules.SquadPlay {
+			starters = append(starters, k)
+		} else {
+			bench = append(bench, k)
+			benchBoost = benchBoost || p.Multiplier > 0
+		}
+	}
+
+	if !benchBoost {
+		count := make(map[int]int)
+		for _, k := range starters {
+			count[lineup.Picks[k].ElementType]++
+		}
+
+		used := make(map[int]bool)
+		for _, s := range starters {
+			out := &lineup.Picks[s]
+			if !gw.Out(out.PlayerID) {
+				continue
+			}
+			for _, b := range bench {
+				in := &lineup.Picks[b]
+				if used[b] {
+					continue
+				}
+				if (out.ElementType == goalkeeper) != (in.ElementType == goalkeeper) {
+					continue
+				}
+				if in.ElementType != out.ElementType && count[out.ElementType]-1 < rules.MinPlay[out.ElementType] {
+					continue
+				}
+				if !gw.Played(in.PlayerID) {
+					if gw.Out(in.PlayerID) {
+						continue
+					}
+					break
+				}
+
+				used[b] = true
+				count[out.ElementType]--
+				count[in.ElementType]++
+				in.Multiplier, out.Multiplier = 1, 0
+				lineup.Subs = append(lineup.Subs, Sub{Out: out.PlayerID, In: in.PlayerID})
+				break
+			}
+		}
+	}
+
+	captain, vice := -1, -1
+	for k, p := range lineup.Picks {
+		if p.IsCaptain {
+			captain = k
+		}
+		if p.IsViceCaptain {
+			vice = k
+		}
+	}
+	if captain < 0 {
+		return lineup
+	}
+
+	lineup.Captain = lineup.Picks[captain].PlayerID
+	if gw.Out(lineup.Picks[captain].PlayerID) && vice >= 0 &&
+		lineup.Picks[vice].Multiplier > 0 && !gw.Out(lineup.Picks[vice].PlayerID) {
+		lineup.Picks[vice].Multiplier = armband
+		lineup.Picks[captain].Multiplier = 0
+		lineup.Captain = lineup.Picks[vice].PlayerID
+	}
+
+	return lineup
+}
@@ -0,0 +1,271 @@
+package live
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+type Repo struct {
+	db *sql.DB
+}
+
+func NewRepo(db *sql.DB) *Repo {
+	return &Repo{db: db}
+}
+
+// LatestEvent returns the last gameweek any manager has picks for.
+func (r *Repo) LatestEvent(ctx context.Context, seasonID int) (int, error) {
+	var latest sql.NullInt64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT MAX(event) FROM manager_picks WHERE season_id = $1`,
+		seasonID,
+	).Scan(&latest)
+	if err != nil {
+		return 0, fmt.Errorf("querying latest picks event: %w", err)
+	}
+	if !latest.Valid {
+		return 0, fmt.Errorf("no picks in season %d", seasonID)
+	}
+	return int(latest.Int64), nil
+}
+
+// LoadRules reads the minimum starters per position from element_types,
+// falling back to the defaults when the table is empty.
+func (r *Repo) LoadRules(ctx context.Context) (Rules, error) {
+	rules := DefaultRules()
+
+	rows, err := r.db.QueryContext(ctx, `SELECT id, squad_min_play FROM element_types`)
+	if err != nil {
+		return rules, fmt.Errorf("querying element_types: %w", err)
+	}
+	defer rows.Close()
+
+	minPlay := make(map[int]int)
+	for rows.Next() {
+		var elementType, n int
+		if err := rows.Scan(&elementType, &n); err != nil {
+			return rules, fmt.Errorf("scanning element_types: %w", err)
+		}
+		minPlay[elementType] = n
+	}
+	if len(minPlay) > 0 {
+		rules.MinPlay = minPlay
+	}
+
+	return rules, rows.Err()
+}
+
+// LoadPlayers returns every player of a season with no live stats yet.
+func (r *Repo) LoadPlayers(ctx context.Context, seasonID int) (map[int]*Player, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id, team_id, element_type_id
+		FROM players
+		WHERE season_id = $1`,
+		seasonID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying players: %w", err)
+	}
+	defer rows.Close()
+
+	players := make(map[int]*Player)
+	for rows.Next() {
+		p := &Player{}
+		if err := rows.Scan(&p.PlayerID, &p.TeamID, &p.ElementType); err != nil {
+			return nil, fmt.Errorf("scanning players: %w", err)
+		}
+		players[p.PlayerID] = p
+	}
+
+	return players, rows.Err()
+}
+
+func (r *Repo) LoadFixtures(ctx context.Context, seasonID, event int) (map[int]Fixture, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT fixture_id, team_h, team_a, COALESCE(minutes, 0),
+		       COALESCE(finished_provisional, FALSE), COALESCE(finished, FALSE)
+		FROM fixtures
+		WHERE season_id = $1 AND event = $2`,
+		seasonID, event,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying fixtures: %w", err)
+	}
+	defer rows.Close()
+
+	fixtures := make(map[int]Fixture)
+	for rows.Next() {
+		var f Fixture
+		if err := rows.Scan(&f.FixtureID, &f.TeamH, &f.TeamA, &f.Minutes, &f.FinishedProvisional, &f.Finished); err != nil {
+			return nil, fmt.Errorf("scanning fixtures: %w", err)
+		}
+		fixtures[f.FixtureID] = f
+	}
+
+	return fixtures, rows.Err()
+}
+
+// LoadManagers returns every manager with picks for a gameweek, with the
+// chip, hits and season total before it.
+func (r *Repo) LoadManagers(ctx context.Context, seasonID, event int) ([]Manager, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT m.manager_id, COALESCE(m.manager_name, ''), COALESCE(c.chip_name, ''),
+		       COALESCE(h.event_transfers_cost, 0), COALESCE(prev.total_points, 0)
+		FROM managers m
+		LEFT JOIN manager_chips c
+		       ON c.manager_id = m.manager_id AND c.season_id = m.season_id AND c.event = $2
+		LEFT JOIN manager_gameweek_history h
+		       ON h.manager_id = m.manager_id AND h.season_id = m.season_id AND h.event = $2
+		LEFT JOIN manager_gameweek_history prev
+		       ON prev.manager_id = m.manager_id AND prev.season_id = m.season_id AND prev.event = $2 - 1
+		WHERE m.season_id = $1
+		  AND EXISTS (SELECT 1 FROM manager_picks p
+		              WHERE p.manager_id = m.manager_id AND p.season_id = m.season_id AND p.event = $2)
+		ORDER BY m.manager_id`,
+		seasonID, event,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying managers: %w", err)
+	}
+	defer rows.Close()
+
+	var managers []Manager
+	index := make(map[int]int)
+	for rows.Next() {
+		var m Manager
+		if err := rows.Scan(&m.ManagerID, &m.Name, &m.Chip, &m.TransferCost, &m.PreviousTotal); err != nil {
+			return nil, fmt.Errorf("scanning managers: %w", err)
+		}
+		index[m.ManagerID] = len(managers)
+		managers = append(managers, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	picks, err := r.db.QueryContext(ctx, `
+		SELECT manager_id, player_id, COALESCE(position, 0), COALESCE(element_type, 0),
+		       COALESCE(multiplier, 0), COALESCE(is_captain, FALSE), COALESCE(is_vice_captain, FALSE)
+		FROM manager_picks
+		WHERE season_id = $1 AND event = $2
+		ORDER BY manager_id, position`,
+		seasonID, event,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying manager_picks: %w", err)
+	}
+	defer picks.Close()
+
+	for picks.Next() {
+		var managerID int
+		var p Pick
+		if err := picks.Scan(&managerID, &p.PlayerID, &p.Position, &p.ElementType,
+			&p.Multiplier, &p.IsCaptain, &p.IsViceCaptain); err != nil {
+			return nil, fmt.Errorf("scanning manager_picks: %w", err)
+		}
+		if k, ok := index[managerID]; ok {
+			managers[k].Picks = append(managers[k].Picks, p)
+		}
+	}
+
+	return managers, picks.Err()
+}
+
+// LoadGameweekStats fills in players' minutes and points for a gameweek from
+// player_gameweek_explain, for gameweeks the tracker did not follow live. The
+// provisional bonus stored by the indexer is kept apart from the confirmed
+// points.
+func (r *Repo) LoadGameweekStats(ctx context.Context, seasonID, event int, players map[int]*Player) error {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id, fixture_id,
+		       COALESCE(SUM(points) FILTER (WHERE NOT COALESCE(provisional, FALSE)), 0),
+		       COALESCE(SUM(points) FILTER (WHERE provisional), 0),
+		       COALESCE(MAX(value) FILTER (WHERE identifier = 'minutes'), 0)
+		FROM player_gameweek_explain
+		WHERE season_id = $1 AND event = $2
+		GROUP BY player_id, fixture_id
+		ORDER BY player_id, fixture_id`,
+		seasonID, event,
+	)
+	if err != nil {
+		return fmt.Errorf("querying player_gameweek_explain: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var playerID, points, provisional int
+		var fs FixtureStats
+		if err := rows.Scan(&playerID, &fs.FixtureID, &points, &provisional, &fs.Minutes); err != nil {
+			return fmt.Errorf("scanning player_gameweek_explain: %w", err)
+		}
+		p := players[playerID]
+		if p == nil {
+			p = &Player{PlayerID: playerID}
+			players[playerID] = p
+		}
+		p.Minutes += fs.Minutes
+		p.Points += points
+		p.ProvisionalBonus += provisional
+		p.Fixtures = append(p.Fixtures, fs)
+	}
+
+	return rows.Err()
+}
+
+// LoadProvisionalBonus returns the provisional bonus the indexer computed
+// from live BPS for a gameweek, by player and fixture.
+func (r *Repo) LoadProvisionalBonus(ctx context.Context, seasonID, event int) (map[int]map[int]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT player_id, fixture_id, points
+		FROM player_gameweek_explain
+		WHERE season_id = $1 AND event = $2 AND identifier = 'bonus' AND provisional`,
+		seasonID, event,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying provisional bonus: %w", err)
+	}
+	defer rows.Close()
+
+	bonus := make(map[int]map[int]int)
+	for rows.Next() {
+		var playerID, fixtureID, points int
+		if err := rows.Scan(&playerID, &fixtureID, &points); err != nil {
+			return nil, fmt.Errorf("scanning provisional bonus: %w", err)
+		}
+		if bonus[playerID] == nil {
+			bonus[playerID] = make(map[int]int)
+		}
+		bonus[playerID][fixtureID] = points
+	}
+
+	return bonus, rows.Err()
+}
+
+// LoadAutomaticSubs returns the automatic substitutions FPL made in a
+// gameweek, by manager.
+func (r *Repo) LoadAutomaticSubs(ctx context.Context, seasonID, event int) (map[int][]Sub, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT manager_id, player_out_id, player_in_id
+		FROM manager_automatic_subs
+		WHERE season_id = $1 AND event = $2
+		ORDER BY manager_id, player_out_id`,
+		seasonID, event,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("querying manager_automatic_subs: %w", err)
+	}
+	defer rows.Close()
+
+	subs := make(map[int][]Sub)
+	for rows.Next() {
+		var managerID int
+		var s Sub
+		if err := rows.Scan(&managerID, &s.Out, &s.In); err != nil {
+			return nil, fmt.Errorf("scanning manager_automatic_subs: %w", err)
+		}
+		subs[managerID] = append(subs[managerID], s)
+	}
+
+	return subs, rows.Err()
+}
@@ -0,0 +1,186 @@
+package live
+
+import (
+	"context"
+	"sort"
+)
+
+// AssumeFinished returns a copy of the gameweek in which every match is over,
+// so that anyone without minutes is out. Scoring it gives a manager's final
+// points before FPL processes the automatic substitutions.
+func (g *Gameweek) AssumeFinished() *Gameweek {
+	final := &Gameweek{Event: g.Event, Players: g.Players, Fixtures: make(map[int]Fixture, len(g.Fixtures))}
+	for id, f := range g.Fixtures {
+		f.FinishedProvisional = true
+		final.Fixtures[id] = f
+	}
+	return final
+}
+
+// Verification compares the substitutions FPL made for a manager with the
+// ones the rules give.
+type Verification struct {
+	ManagerID  int   `json:"manager_id"`
+	Expected   []Sub `json:"expected"`
+	Ingested   []Sub `json:"ingested"`
+	Missing    []Sub `json:"missing"`
+	Unexpected []Sub `json:"unexpected"`
+}
+
+func (v Verification) Match() bool {
+	return len(v.Missing) == 0 && len(v.Unexpected) == 0
+}
+
+// VerifySubs checks ingested automatic substitutions against a simulation of
+// the finished gameweek. Order does not matter.
+func VerifySubs(m Manager, gw *Gameweek, rules Rules, ingested []Sub) Verification {
+	picks := RestorePicks(m.Picks, ingested, rules)
+	lineup := ApplySubs(picks, gw.AssumeFinished(), rules)
+
+	v := Verification{ManagerID: m.ManagerID, Expected: lineup.Subs, Ingested: ingested}
+	expected := make(map[Sub]bool, len(lineup.Subs))
+	for _, s := range lineup.Subs {
+		expected[s] = true
+	}
+	got := make(map[Sub]bool, len(ingested))
+	for _, s := range ingested {
+		got[s] = true
+		if !expected[s] {
+			v.Unexpected = append(v.Unexpected, s)
+		}
+	}
+	for _, s := range lineup.Subs {
+		if !got[s] {
+			v.Missing = append(v.Missing, s)
+		}
+	}
+
+	return v
+}
+
+// SimulateFinal scores and ranks every manager as if the gameweek were over.
+func SimulateFinal(managers []Manager, gw *Gameweek, rules Rules) []Standing {
+	final := gw.AssumeFinished()
+	standings := make([]Standing, 0, len(managers))
+	for _, m := range managers {
+		standings = append(standings, Score(m, final, rules))
+	}
+	RankStandings(standings)
+	return standings
+}
+
+// RestorePicks undoes automatic substitutions already applied to picks. Once
+// FPL has processed subs, the picks it returns have the player who came on in
+// the starter's position and the armband moved to the vice-captain; the
+// simulation needs the team as it was at the deadline.
+func RestorePicks(picks []Pick, subs []Sub, rules Rules) []Pick {
+	restored := append([]Pick(nil), picks...)
+	index := make(map[int]int, len(restored))
+	for k, p := range restored {
+		index[p.PlayerID] = k
+	}
+
+	changed := false
+	for k := len(subs) - 1; k >= 0; k-- {
+		out, okOut := index[subs[k].Out]
+		in, okIn := index[subs[k].In]
+		if !okOut || !okIn {
+			continue
+		}
+		o, i := &restored[out], &restored[in]
+		if i.Position <= rules.SquadPlay && o.Position > rules.SquadPlay {
+			o.Position, i.Position = i.Position, o.Position
+			changed = true
+		}
+	}
+
+	armband, benchBoost := 2, false
+	for _, p := range restored {
+		armband = max(armband, p.Multiplier)
+		if p.IsCaptain && p.Multiplier == 0 {
+			changed = true
+		}
+		// FPL makes no automatic subs under Bench Boost.
+		benchBoost = benchBoost || (len(subs) == 0 && p.Position > rules.SquadPlay && p.Multiplier > 0)
+	}
+	if !changed {
+		return restored
+	}
+
+	for k := range restored {
+		p := &restored[k]
+		switch {
+		case p.IsCaptain:
+			p.Multiplier = armband
+		case p.Position <= rules.SquadPlay || benchBoost:
+			p.Multiplier = 1
+		default:
+			p.Multiplier = 0
+		}
+	}
+	sort.Slice(restored, func(i, j int) bool { return restored[i].Position < restored[j].Position })
+
+	return restored
+}
+
+// Simulation is a gameweek's final standings and the check of the automatic
+// substitutions FPL made. Before FPL processes the gameweek every expected sub
+// shows as missing.
+type Simulation struct {
+	Event         int
+	Standings     []Standing
+	Verifications []Verification
+}
+
+// Simulate replays a gameweek from the database for the given managers, or
+// every manager with picks when managerIDs is empty.
+func Simulate(ctx context.Context, repo *Repo, seasonID, event int, managerIDs []int) (*Simulation, error) {
+	rules, err := repo.LoadRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	players, err := repo.LoadPlayers(ctx, seasonID)
+	if err != nil {
+		return nil, err
+	}
+	if err := repo.LoadGameweekStats(ctx, seasonID, event, players); err != nil {
+		return nil, err
+	}
+	fixtures, err := repo.LoadFixtures(ctx, seasonID, event)
+	if err != nil {
+		return nil, err
+	}
+	managers, err := repo.LoadManagers(ctx, seasonID, event)
+	if err != nil {
+		return nil, err
+	}
+	ingested, err := repo.LoadAutomaticSubs(ctx, seasonID, event)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(managerIDs) > 0 {
+		wanted := make(map[int]bool, len(managerIDs))
+		for _, id := range managerIDs {
+			wanted[id] = true
+		}
+		kept := managers[:0]
+		for _, m := range managers {
+			if wanted[m.ManagerID] {
+				kept = append(kept, m)
+			}
+		}
+		managers = kept
+	}
+
+	gw := &Gameweek{Event: event, Players: players, Fixtures: fixtures}
+	sim := &Simulation{Event: event}
+	for k := range managers {
+		subs := ingested[managers[k].ManagerID]
+		sim.Verifications = append(sim.Verifications, VerifySubs(managers[k], gw, rules, subs))
+		managers[k].Picks = RestorePicks(managers[k].Picks, subs, rules)
+	}
+	sim.Standings = SimulateFinal(managers, gw, rules)
+
+	return sim, nil
+}
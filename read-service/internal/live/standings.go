@@ -0,0 +1,91 @@
+package live
+
+import (
+	"sort"
+)
+
+// Manager is a tracked manager's picks for the gameweek and what they carry
+// into it.
+type Manager struct {
+	ManagerID     int
+	Name          string
+	Picks         []Pick
+	Chip          string
+	TransferCost  int
+	PreviousTotal int
+}
+
+type PickPoints struct {
+	PlayerID         int `json:"player_id"`
+	Position         int `json:"position"`
+	Multiplier       int `json:"multiplier"`
+	Minutes          int `json:"minutes"`
+	Points           int `json:"points"`
+	ProvisionalBonus int `json:"provisional_bonus"`
+}
+
+// Standing is a manager's live gameweek: points with projected auto-subs and
+// provisional bonus, hits, season total and live rank among the tracked
+// managers. The rank is not per league: nothing indexes league membership
+// (fpl-service does not fetch league standings and Entry carries no leagues),
+// so the tracked managers are the only league there is to rank within.
+type Standing struct {
+	ManagerID    int          `json:"manager_id"`
+	Name         string       `json:"name"`
+	Event        int          `json:"event"`
+	Chip         string       `json:"chip,omitempty"`
+	Points       int          `json:"points"`
+	TransferCost int          `json:"transfer_cost"`
+	Total        int          `json:"total"`
+	Rank         int          `json:"rank"`
+	Captain      int          `json:"captain"`
+	Subs         []Sub        `json:"subs"`
+	Picks        []PickPoints `json:"picks"`
+}
+
+// Score computes a manager's live standing, without the rank.
+func Score(m Manager, gw *Gameweek, rules Rules) Standing {
+	lineup := ApplySubs(m.Picks, gw, rules)
+
+	s := Standing{
+		ManagerID:    m.ManagerID,
+		Name:         m.Name,
+		Event:        gw.Event,
+		Chip:         m.Chip,
+		TransferCost: m.TransferCost,
+		Captain:      lineup.Captain,
+		Subs:         lineup.Subs,
+	}
+	for _, pick := range lineup.Picks {
+		pp := PickPoints{PlayerID: pick.PlayerID, Position: pick.Position, Multiplier: pick.Multiplier}
+		if p := gw.Players[pick.PlayerID]; p != nil {
+			pp.Minutes = p.Minutes
+			pp.Points = p.LivePoints()
+			pp.ProvisionalBonus = p.ProvisionalBonus
+		}
+		s.Points += pp.Multiplier * pp.Points
+		s.Picks = append(s.Picks, pp)
+	}
+	s.Total = m.PreviousTotal + s.Points - s.TransferCost
+
+	return s
+}
+
+// RankStandings orders standings by season total and sets their rank; level
+// managers share a rank.
+func RankStandings(standings []Standing) {
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Total != standings[j].Total {
+			return standings[i].Total > standings[j].Total
+		}
+		return standings[i].ManagerID < standings[j].ManagerID
+	})
+
+	for i := range standings {
+		if i == 0 || standings[i].Total < standings[i-1].Total {
+			standings[i].Rank = i + 1
+		} else {
+			standings[i].Rank = standings[i-1].Rank
+		}
+	}
+}
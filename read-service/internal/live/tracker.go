@@ -0,0 +1,188 @@
+package live
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	fpl "github.com/imadeddine-belkat/tactify-protos/go/fpl/v1"
+)
+
+// Tracker keeps the live standings of every tracked manager for the current
+// gameweek. Live messages only update the players; standings are recomputed
+// in batches by Recompute.
+type Tracker struct {
+	repo     *Repo
+	seasonID int
+
+	mu        sync.RWMutex
+	gw        *Gameweek
+	managers  []Manager
+	rules     Rules
+	standings map[int]Standing
+	ranked    []Standing
+	dirty     bool
+}
+
+func NewTracker(repo *Repo, seasonID int) *Tracker {
+	return &Tracker{
+		repo:      repo,
+		seasonID:  seasonID,
+		rules:     DefaultRules(),
+		standings: make(map[int]Standing),
+	}
+}
+
+// Event returns the gameweek being tracked, 0 before the first load.
+func (t *Tracker) Event() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.gw == nil {
+		return 0
+	}
+	return t.gw.Event
+}
+
+// Load starts tracking a gameweek from the database. Live stats already
+// received for the same gameweek are kept.
+func (t *Tracker) Load(ctx context.Context, event int) error {
+	rules, err := t.repo.LoadRules(ctx)
+	if err != nil {
+		return err
+	}
+	players, err := t.repo.LoadPlayers(ctx, t.seasonID)
+	if err != nil {
+		return err
+	}
+	fixtures, err := t.repo.LoadFixtures(ctx, t.seasonID, event)
+	if err != nil {
+		return err
+	}
+	bonus, err := t.repo.LoadProvisionalBonus(ctx, t.seasonID, event)
+	if err != nil {
+		return err
+	}
+	managers, err := t.repo.LoadManagers(ctx, t.seasonID, event)
+	if err != nil {
+		return err
+	}
+	processed, err := t.repo.LoadAutomaticSubs(ctx, t.seasonID, event)
+	if err != nil {
+		return err
+	}
+	for k := range managers {
+		managers[k].Picks = RestorePicks(managers[k].Picks, processed[managers[k].ManagerID], rules)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.gw != nil && t.gw.Event == event {
+		for id, p := range t.gw.Players {
+			if known := players[id]; known != nil {
+				p.TeamID, p.ElementType = known.TeamID, known.ElementType
+			}
+			players[id] = p
+		}
+	}
+	t.gw = &Gameweek{Event: event, Players: players, Fixtures: fixtures, ProvisionalBonus: bonus}
+	t.managers = managers
+	t.rules = rules
+	t.dirty = true
+
+	return nil
+}
+
+// Refresh reloads the tracked gameweek, picking up new managers, picks,
+// fixture clocks and the indexer's provisional bonus.
+func (t *Tracker) Refresh(ctx context.Context) error {
+	event := t.Event()
+	if event == 0 {
+		return fmt.Errorf("no gameweek loaded")
+	}
+	return t.Load(ctx, event)
+}
+
+// Apply records a live message. It reports whether the message belongs to a
+// later gameweek than the one tracked, in which case it is ignored and the
+// caller should Load the new gameweek.
+func (t *Tracker) Apply(msg *fpl.LiveEventMessage) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.gw == nil {
+		return false
+	}
+	event := int(msg.GetEvent())
+	if event > t.gw.Event {
+		return true
+	}
+	if event < t.gw.Event {
+		return false
+	}
+
+	p := PlayerFromMessage(msg)
+	if known := t.gw.Players[p.PlayerID]; known != nil {
+		p.TeamID, p.ElementType = known.TeamID, known.ElementType
+	}
+	t.gw.Players[p.PlayerID] = p
+
+	// The feed's minutes run ahead of the fixtures table between refreshes.
+	for _, fs := range p.Fixtures {
+		if f, ok := t.gw.Fixtures[fs.FixtureID]; ok && fs.Minutes > f.Minutes {
+			f.Minutes = fs.Minutes
+			t.gw.Fixtures[fs.FixtureID] = f
+		}
+	}
+	t.dirty = true
+
+	return false
+}
+
+// Recompute scores and ranks every manager if anything changed since the last
+// call and returns the standings that differ from before.
+func (t *Tracker) Recompute() []Standing {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.dirty || t.gw == nil {
+		return nil
+	}
+	t.dirty = false
+
+	ApplyProvisionalBonus(t.gw.Players, t.gw.Fixtures, t.gw.ProvisionalBonus)
+
+	ranked := make([]Standing, 0, len(t.managers))
+	for _, m := range t.managers {
+		ranked = append(ranked, Score(m, t.gw, t.rules))
+	}
+	RankStandings(ranked)
+
+	var changed []Standing
+	standings := make(map[int]Standing, len(ranked))
+	for _, s := range ranked {
+		standings[s.ManagerID] = s
+		if prev, ok := t.standings[s.ManagerID]; !ok || !reflect.DeepEqual(prev, s) {
+			changed = append(changed, s)
+		}
+	}
+	t.standings = standings
+	t.ranked = ranked
+
+	return changed
+}
+
+func (t *Tracker) Standing(managerID int) (Standing, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	s, ok := t.standings[managerID]
+	return s, ok
+}
+
+// Standings returns every standing in rank order.
+func (t *Tracker) Standings() []Standing {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return append([]Standing(nil), t.ranked...)
+}
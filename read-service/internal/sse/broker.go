@@ -0,0 +1,79 @@
+package sse
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// Event is one server-sent event.
+type Event struct {
+	Name string
+	Data any
+	// Key lets subscribers filter events, e.g. by manager id.
+	Key int
+}
+
+type subscriber struct {
+	ch     chan Event
+	filter func(key int) bool
+}
+
+// Broker fans events out to connected clients. A client too slow to keep up
+// misses events rather than blocking the others.
+type Broker struct {
+	mu      sync.Mutex
+	subs    map[*subscriber]struct{}
+	bufSize int
+}
+
+func NewBroker(bufSize int) *Broker {
+	return &Broker{subs: make(map[*subscriber]struct{}), bufSize: bufSize}
+}
+
+// Subscribe registers a client; a nil filter receives every event. The
+// returned function unsubscribes and must be called once the client is gone.
+func (b *Broker) Subscribe(filter func(key int) bool) (<-chan Event, func()) {
+	s := &subscriber{ch: make(chan Event, b.bufSize), filter: filter}
+
+	b.mu.Lock()
+	b.subs[s] = struct{}{}
+	b.mu.Unlock()
+
+	return s.ch, func() {
+		b.mu.Lock()
+		delete(b.subs, s)
+		b.mu.Unlock()
+	}
+}
+
+func (b *Broker) Publish(e Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for s := range b.subs {
+		if s.filter != nil && !s.filter(e.Key) {
+			continue
+		}
+		select {
+		case s.ch <- e:
+		default:
+		}
+	}
+}
+
+// Write sends an event as JSON and flushes it to the client.
+func Write(w http.ResponseWriter, e Event) error {
+	data, err := json.Marshal(e.Data)
+	if err != nil {
+		return fmt.Errorf("marshalling %s event: %w", e.Name, err)
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Name, data); err != nil {
+		return err
+	}
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
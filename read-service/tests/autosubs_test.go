@@ -0,0 +1,112 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/imadeddine-belkat/read-service/internal/live"
+)
+
+func TestApplySubsKeepsFormationAndGoalkeeper(t *testing.T) {
+	gw := subsGameweek()
+	// The GK and a defender blank: the bench GK replaces the GK, and the bench
+	// defender replaces the defender since the forward ahead of them on the
+	// bench would break the back three.
+	gw.Players[1].Minutes = 0
+	gw.Players[2].Minutes = 0
+
+	lineup := live.ApplySubs(subsPicks(), gw, live.DefaultRules())
+
+	want := []live.Sub{{Out: 1, In: 12}, {Out: 2, In: 14}}
+	if len(lineup.Subs) != len(want) {
+		t.Fatalf("got subs %v, want %v", lineup.Subs, want)
+	}
+	for i := range want {
+		if lineup.Subs[i] != want[i] {
+			t.Fatalf("got subs %v, want %v", lineup.Subs, want)
+		}
+	}
+}
+
+func TestApplySubsPromotesViceCaptain(t *testing.T) {
+	gw := subsGameweek()
+	gw.Players[9].Minutes = 0
+
+	lineup := live.ApplySubs(subsPicks(), gw, live.DefaultRules())
+
+	if lineup.Captain != 10 {
+		t.Fatalf("vice-captain should take the armband, got captain %d", lineup.Captain)
+	}
+	for _, p := range lineup.Picks {
+		switch p.PlayerID {
+		case 10:
+			if p.Multiplier != 2 {
+				t.Fatalf("vice-captain should score double, got %d", p.Multiplier)
+			}
+		case 13:
+			if p.Multiplier != 1 {
+				t.Fatalf("bench forward should come on for the captain, got %d", p.Multiplier)
+			}
+		}
+	}
+}
+
+func TestAssumeFinishedSubsPlayersStillToPlay(t *testing.T) {
+	gw := subsGameweek()
+	gw.Players[2].Minutes = 0
+	gw.Fixtures[1] = live.Fixture{FixtureID: 1, TeamH: 2, TeamA: 99}
+
+	if subs := live.ApplySubs(subsPicks(), gw, live.DefaultRules()).Subs; len(subs) != 0 {
+		t.Fatalf("live projection should wait for the match, got %v", subs)
+	}
+
+	lineup := live.ApplySubs(subsPicks(), gw.AssumeFinished(), live.DefaultRules())
+	if len(lineup.Subs) != 1 || lineup.Subs[0] != (live.Sub{Out: 2, In: 14}) {
+		t.Fatalf("final projection should bring on the bench defender, got %v", lineup.Subs)
+	}
+}
+
+func TestRestorePicksUndoesProcessedSubs(t *testing.T) {
+	// FPL's picks once it has processed the captain (9) being replaced by the
+	// bench forward (13): positions swapped and the armband on the vice (10).
+	processed := subsPicks()
+	processed[8].Position, processed[12].Position = 13, 9
+	processed[8].Multiplier, processed[12].Multiplier = 0, 1
+	processed[9].Multiplier = 2
+
+	restored := live.RestorePicks(processed, []live.Sub{{Out: 9, In: 13}}, live.DefaultRules())
+
+	want := subsPicks()
+	for k := range want {
+		if restored[k] != want[k] {
+			t.Fatalf("pick %d: got %+v, want %+v", k, restored[k], want[k])
+		}
+	}
+}
+
+func TestVerifySubs(t *testing.T) {
+	gw := subsGameweek()
+	gw.Players[9].Minutes = 0
+	m := live.Manager{ManagerID: 7, Picks: subsPicks()}
+
+	if v := live.VerifySubs(m, gw, live.DefaultRules(), []live.Sub{{Out: 9, In: 13}}); !v.Match() {
+		t.Fatalf("ingested subs should match, got %+v", v)
+	}
+
+	v := live.VerifySubs(m, gw, live.DefaultRules(), []live.Sub{{Out: 9, In: 15}})
+	if v.Match() || len(v.Missing) != 1 || len(v.Unexpected) != 1 {
+		t.Fatalf("wrong sub should be reported, got %+v", v)
+	}
+}
+
+func TestSimulateFinalScoresAfterSubs(t *testing.T) {
+	gw := subsGameweek()
+	gw.Players[9].Minutes = 0
+	gw.Players[9].Points = 0
+
+	standings := live.SimulateFinal([]live.Manager{{ManagerID: 1, Picks: subsPicks()}}, gw, live.DefaultRules())
+
+	// Ten starters and the bench forward score 2 each and the vice doubles.
+	if len(standings) != 1 || standings[0].Points != 24 || standings[0].Captain != 10 {
+		t.Fatalf("got %+v", standings)
+	}
+}
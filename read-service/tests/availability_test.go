@@ -0,0 +1,38 @@
+package tests
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/imadeddine-belkat/read-service/internal/availability"
+)
+
+func TestAvailabilityParseQuery(t *testing.T) {
+	q, err := availability.ParseQuery(2025, url.Values{})
+	if err != nil || q.SeasonID != 2025 || q.Limit != availability.DefaultLimit || !q.Since.IsZero() {
+		t.Fatalf("defaults: got %+v, %v", q, err)
+	}
+
+	q, err = availability.ParseQuery(2025, url.Values{
+		"since":  {"2025-09-20T10:00:00Z"},
+		"team":   {"7"},
+		"player": {"311"},
+		"limit":  {"10000"},
+	})
+	if err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+	if !q.Since.Equal(time.Date(2025, 9, 20, 10, 0, 0, 0, time.UTC)) || q.TeamID != 7 || q.PlayerID != 311 {
+		t.Fatalf("filters: got %+v", q)
+	}
+	if q.Limit != availability.MaxLimit {
+		t.Fatalf("limit: got %d, want %d", q.Limit, availability.MaxLimit)
+	}
+
+	for _, bad := range []url.Values{{"since": {"yesterday"}}, {"team": {"x"}}, {"limit": {"0"}}} {
+		if _, err := availability.ParseQuery(2025, bad); err == nil {
+			t.Fatalf("%v: want an error", bad)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/imadeddine-belkat/read-service/internal/live"
+)
+
+func TestProvisionalBonusSkipsConfirmedFixtures(t *testing.T) {
+	fixtures := map[int]live.Fixture{
+		1: {FixtureID: 1, TeamH: 1, TeamA: 2, Minutes: 70},
+		2: {FixtureID: 2, TeamH: 3, TeamA: 4, Minutes: 90, Finished: true},
+		3: {FixtureID: 3, TeamH: 5, TeamA: 6, Minutes: 90, FinishedProvisional: true},
+	}
+	players := map[int]*live.Player{
+		10: livePlayer(10, 1, 70, 1),
+		11: livePlayer(11, 2, 70, 1),
+		20: livePlayer(20, 3, 90, 2),
+		30: livePlayer(30, 5, 90, 3),
+	}
+	// The live feed has confirmed fixture 3 since the bonus was stored.
+	players[30].Fixtures[0].Bonus, players[30].Fixtures[0].BonusConfirmed = 3, true
+	stored := map[int]map[int]int{10: {1: 3}, 11: {1: 2}, 20: {2: 3}, 30: {3: 3}}
+
+	live.ApplyProvisionalBonus(players, fixtures, stored)
+
+	if players[10].ProvisionalBonus != 3 || players[11].ProvisionalBonus != 2 {
+		t.Fatalf("open fixture should award 3 and 2, got %d and %d",
+			players[10].ProvisionalBonus, players[11].ProvisionalBonus)
+	}
+	if players[20].ProvisionalBonus != 0 || players[30].ProvisionalBonus != 0 {
+		t.Fatalf("confirmed fixtures should not award provisional bonus, got %d and %d",
+			players[20].ProvisionalBonus, players[30].ProvisionalBonus)
+	}
+}
+
+func TestApplySubsFollowsBenchOrder(t *testing.T) {
+	gw := subsGameweek()
+	// A forward and the bench GK blank: the bench forward behind the GK
+	// comes on.
+	gw.Players[10].Minutes = 0
+	gw.Players[12].Minutes = 0
+
+	lineup := live.ApplySubs(subsPicks(), gw, live.DefaultRules())
+
+	if len(lineup.Subs) != 1 || lineup.Subs[0] != (live.Sub{Out: 10, In: 13}) {
+		t.Fatalf("got subs %v, want [{10 13}]", lineup.Subs)
+	}
+}
+
+func TestApplySubsWaitsForUnplayedFixtures(t *testing.T) {
+	gw := subsGameweek()
+	gw.Players[2].Minutes = 0
+	gw.Fixtures[1] = live.Fixture{FixtureID: 1, TeamH: 2, TeamA: 99, Minutes: 0}
+
+	lineup := live.ApplySubs(subsPicks(), gw, live.DefaultRules())
+
+	if len(lineup.Subs) != 0 {
+		t.Fatalf("a player whose match is still to come should not be subbed, got %v", lineup.Subs)
+	}
+}
+
+func TestApplySubsWaitsForPendingBenchPlayer(t *testing.T) {
+	gw := subsGameweek()
+	// A midfielder blanks; the first outfield sub (13) is still to play while
+	// the second (14) has played.
+	gw.Players[5].Minutes = 0
+	gw.Players[13].Minutes = 0
+	gw.Fixtures[113] = live.Fixture{FixtureID: 113, TeamH: 13, TeamA: 63, Minutes: 0}
+
+	lineup := live.ApplySubs(subsPicks(), gw, live.DefaultRules())
+	if len(lineup.Subs) != 0 {
+		t.Fatalf("the second sub should not jump the first while they can still play, got %v", lineup.Subs)
+	}
+
+	gw.Fixtures[113] = live.Fixture{FixtureID: 113, TeamH: 13, TeamA: 63, Minutes: 90, FinishedProvisional: true}
+	lineup = live.ApplySubs(subsPicks(), gw, live.DefaultRules())
+	if len(lineup.Subs) != 1 || lineup.Subs[0] != (live.Sub{Out: 5, In: 14}) {
+		t.Fatalf("once the first sub is out the second comes on, got %v", lineup.Subs)
+	}
+}
+
+func TestFixtureDoneIgnoresClock(t *testing.T) {
+	cases := []struct {
+		fixture live.Fixture
+		want    bool
+	}{
+		{live.Fixture{Minutes: 90}, false},
+		{live.Fixture{Minutes: 97}, false},
+		{live.Fixture{Minutes: 90, FinishedProvisional: true}, true},
+		{live.Fixture{Minutes: 90, Finished: true}, true},
+	}
+
+	for _, c := range cases {
+		if got := c.fixture.Done(); got != c.want {
+			t.Fatalf("%+v: got %v, want %v", c.fixture, got, c.want)
+		}
+	}
+}
+
+func TestApplySubsBenchBoost(t *testing.T) {
+	gw := subsGameweek()
+	gw.Players[1].Minutes = 0
+	picks := subsPicks()
+	for i := range picks {
+		if picks[i].Position > 11 {
+			picks[i].Multiplier = 1
+		}
+	}
+
+	lineup := live.ApplySubs(picks, gw, live.DefaultRules())
+
+	if len(lineup.Subs) != 0 {
+		t.Fatalf("bench boost should not sub anyone, got %v", lineup.Subs)
+	}
+}
+
+func TestScoreAndRankStandings(t *testing.T) {
+	gw := subsGameweek()
+	rules := live.DefaultRules()
+
+	a := live.Manager{ManagerID: 1, Picks: subsPicks(), PreviousTotal: 100}
+	b := live.Manager{ManagerID: 2, Picks: subsPicks(), PreviousTotal: 104, TransferCost: 4}
+	c := live.Manager{ManagerID: 3, Picks: subsPicks(), PreviousTotal: 90}
+
+	standings := []live.Standing{live.Score(c, gw, rules), live.Score(b, gw, rules), live.Score(a, gw, rules)}
+
+	// Every starter scores 2 and the captain doubles: 12 * 2 = 24.
+	if standings[2].Points != 24 || standings[2].Total != 124 {
+		t.Fatalf("got %d points and %d total, want 24 and 124", standings[2].Points, standings[2].Total)
+	}
+
+	live.RankStandings(standings)
+
+	if standings[0].ManagerID != 1 || standings[1].ManagerID != 2 || standings[2].ManagerID != 3 {
+		t.Fatalf("unexpected order %+v", standings)
+	}
+	if standings[0].Rank != 1 || standings[1].Rank != 1 || standings[2].Rank != 3 {
+		t.Fatalf("level managers should share a rank, got %d %d %d",
+			standings[0].Rank, standings[1].Rank, standings[2].Rank)
+	}
+}
+
+func livePlayer(id, team, minutes, fixtureID int) *live.Player {
+	return &live.Player{
+		PlayerID: id,
+		TeamID:   team,
+		Minutes:  minutes,
+		Fixtures: []live.FixtureStats{{FixtureID: fixtureID, Minutes: minutes}},
+	}
+}
+
+// pickTypes is a 3-5-2 with a bench of GK, FWD, DEF and MID, indexed by
+// position. Player ids are their positions and their club ids.
+var pickTypes = []int{0, 1, 2, 2, 2, 3, 3, 3, 3, 3, 4, 4, 1, 4, 2, 3}
+
+// subsPicks has player 9 as captain and 10 as vice-captain.
+func subsPicks() []live.Pick {
+	picks := make([]live.Pick, 0, 15)
+	for pos := 1; pos <= 15; pos++ {
+		p := live.Pick{PlayerID: pos, Position: pos, ElementType: pickTypes[pos], Multiplier: 1}
+		if pos > 11 {
+			p.Multiplier = 0
+		}
+		picks = append(picks, p)
+	}
+	picks[8].IsCaptain, picks[8].Multiplier = true, 2
+	picks[9].IsViceCaptain = true
+	return picks
+}
+
+// subsGameweek has every pick playing in a finished fixture and scoring 2.
+func subsGameweek() *live.Gameweek {
+	gw := &live.Gameweek{Event: 1, Players: map[int]*live.Player{}, Fixtures: map[int]live.Fixture{}}
+	for id := 1; id <= 15; id++ {
+		gw.Players[id] = &live.Player{PlayerID: id, TeamID: id, ElementType: pickTypes[id], Minutes: 90, Points: 2}
+		gw.Fixtures[100+id] = live.Fixture{FixtureID: 100 + id, TeamH: id, TeamA: 50 + id, Minutes: 90, Finished: true}
+	}
+	return gw
+}
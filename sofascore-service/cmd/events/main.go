@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/imadeddine-belkat/sofascore-service/config"
+	sofascore_api "github.com/imadeddine-belkat/sofascore-service/internal/api"
+	"github.com/imadeddine-belkat/sofascore-service/internal/services"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+)
+
+func main() {
+	league := flag.String("league", "", "LALIGA or PREMIERLEAGUE (default both)")
+	year := flag.String("season", "", "season year, e.g. 2425 (default every configured season)")
+	flag.Parse()
+
+	if err := run(*league, *year); err != nil {
+		log.Fatalf("Error publishing events: %v", err)
+	}
+}
+
+func run(league, year string) error {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	cfg := config.LoadConfig()
+
+	shutdownTracing, err := tracing.Setup(ctx, "sofascore-events")
+	if err != nil {
+		return fmt.Errorf("setting up tracing: %w", err)
+	}
+	defer shutdownTracing(context.Background())
+
+	producer := kafka.NewProducer()
+	defer producer.Close()
+
+	service := &services.EventsService{
+		Config:   cfg,
+		Client:   sofascore_api.NewSofascoreApiClient(cfg),
+		Producer: producer,
+	}
+
+	leagues := map[string]int{
+		"LALIGA":        cfg.SofascoreApi.LeaguesID.LaLiga,
+		"PREMIERLEAGUE": cfg.SofascoreApi.LeaguesID.PremierLeague,
+	}
+
+	// A failed season does not stop the others.
+	var errs []error
+	for name, leagueID := range leagues {
+		if league != "" && !strings.EqualFold(league, name) {
+			continue
+		}
+
+		seasons := cfg.AllSeasons(name)
+		if year != "" {
+			seasonID, err := cfg.GetSeasonID(name, year)
+			if err != nil {
+				return err
+			}
+			seasons = []int{seasonID}
+		}
+
+		for _, seasonID := range seasons {
+			if err := service.UpdateSeasonMatches(ctx, seasonID, leagueID); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				errs = append(errs, err)
+				continue
+			}
+			log.Printf("Published %s season %d events", name, seasonID)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -53,6 +53,8 @@ type LeagueEndpoints struct {
 	LeagueCountryLeagueIDs string `envconfig:"SOFASCOREAPI_COUNTRY_LEAGUES_ENDPOINT"`
 	LeagueSeasonStandings  string `envconfig:"SOFASCOREAPI_LEAGUE_SEASON_STANDINGS_ENDPOINT"`
 	LeagueRoundMatches     string `envconfig:"SOFASCOREAPI_LEAGUE_ROUND_MATCHES_ENDPOINT"`
+	LeagueLastMatches      string `envconfig:"SOFASCOREAPI_LEAGUE_LAST_MATCHES_ENDPOINT"`
+	LeagueNextMatches      string `envconfig:"SOFASCOREAPI_LEAGUE_NEXT_MATCHES_ENDPOINT"`
 }
 
 type MatchEndpoints struct {
@@ -13,13 +13,20 @@ import (
 
 	"github.com/chromedp/chromedp"
 	"github.com/imadeddine-belkat/sofascore-service/config"
+	httpx "github.com/imadeddine-belkat/tactify-http"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 )
 
+var protoUnmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+
 type SofascoreApiClient struct {
 	Config         config.SofascoreConfig
 	HttpClient     *http.Client
 	UserAgent      string
 	UseBrowserOnly bool
+	Retry          *httpx.RetryPolicies
 }
 
 func NewSofascoreApiClient(cfg *config.SofascoreConfig) *SofascoreApiClient {
@@ -29,54 +36,124 @@ func NewSofascoreApiClient(cfg *config.SofascoreConfig) *SofascoreApiClient {
 		HttpClient: &http.Client{
 			Timeout: 15 * time.Second,
 		},
+		// Sofascore rate limits aggressively; back off further than the FPL client does.
+		Retry: httpx.NewRetryPolicies(httpx.RetryPolicy{
+			MaxAttempts: 4,
+			BaseDelay:   time.Second,
+			MaxDelay:    30 * time.Second,
+		}),
 	}
 
+	// Lineups 404 until they are announced and each attempt may spin up a browser,
+	// so don't hammer the endpoint.
+	client.Retry.Set(cfg.SofascoreApi.MatchLineups, httpx.RetryPolicy{
+		MaxAttempts: 2,
+		BaseDelay:   2 * time.Second,
+		MaxDelay:    5 * time.Second,
+	})
+
 	log.Println("✓ Initialized Direct Client (Using local IP)")
 	return client
 }
 
+// Get fetches endpoint, retrying according to the policy registered for it.
+// Failures are returned as the typed errors from tactify-http.
 func (c *SofascoreApiClient) Get(ctx context.Context, endpoint string) ([]byte, error) {
 	baseURL := strings.TrimRight(c.Config.SofascoreApi.BaseURL, "/")
 	url := fmt.Sprintf("%s%s", baseURL, endpoint)
 
+	var body []byte
+
+	err := c.Retry.For(endpoint).Do(ctx, func(ctx context.Context) error {
+		var err error
+		body, err = c.get(ctx, endpoint, url)
+		return err
+	})
+
+	return body, err
+}
+
+func (c *SofascoreApiClient) get(ctx context.Context, endpoint, url string) ([]byte, error) {
 	// If browser-only mode is forced in config
 	if c.UseBrowserOnly {
-		return c.getWithBrowser(ctx, url)
+		return c.observeBrowser(ctx, endpoint, url)
 	}
 
 	// 1. Try Standard HTTP Request
+	body, fallback, err := c.observeHTTP(ctx, endpoint, url)
+	if !fallback {
+		return body, err
+	}
+
+	// 2. If we got blocked or the request failed, switch to Browser
+	log.Printf("⚠ HTTP Request failed (%v). Falling back to Browser...", err)
+	body, browserErr := c.observeBrowser(ctx, endpoint, url)
+	if browserErr != nil {
+		return nil, fmt.Errorf("%w (browser fallback: %w)", err, browserErr)
+	}
+	return body, nil
+}
+
+// observeHTTP fetches url with a plain request in its own span. fallback
+// reports a failure the browser may get past: a transport error, or a block
+// (403/429) or service unavailable (503) response.
+func (c *SofascoreApiClient) observeHTTP(ctx context.Context, endpoint, url string) (_ []byte, fallback bool, err error) {
+	ctx, span := httpx.StartRequest(ctx, "sofascore", "GET", endpoint, url)
+	defer func() { tracing.End(span, err) }()
+
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return nil, fmt.Errorf("creating request: %w", err)
+		return nil, false, fmt.Errorf("creating request: %w", err)
 	}
 
 	c.setHeaders(req)
 
+	start := time.Now()
 	resp, err := c.HttpClient.Do(req)
+	httpx.ObserveRequest("sofascore", endpoint, resp, start)
+	httpx.RecordStatus(span, resp)
+	if err != nil {
+		return nil, true, err
+	}
+	defer resp.Body.Close()
 
-	// 2. Check for blocks or errors
-	// If we get blocked (403/429) or service unavailable (503), switch to Browser
-	if err != nil || resp.StatusCode == http.StatusForbidden ||
+	if resp.StatusCode == http.StatusForbidden ||
 		resp.StatusCode == http.StatusTooManyRequests ||
 		resp.StatusCode == http.StatusServiceUnavailable {
+		return nil, true, httpx.CheckResponse(url, resp, nil)
+	}
 
-		if resp != nil {
-			log.Printf("⚠ HTTP Request blocked (Status: %d). Falling back to Browser...", resp.StatusCode)
-			resp.Body.Close()
-		} else {
-			log.Printf("⚠ HTTP Request failed (%v). Falling back to Browser...", err)
-		}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, false, fmt.Errorf("reading response body: %w", err)
+	}
 
-		return c.getWithBrowser(ctx, url)
+	if err = httpx.CheckResponse(url, resp, body); err != nil {
+		return nil, false, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("api status %d: %s", resp.StatusCode, string(body))
+	if err = httpx.CheckErrorEnvelope(url, body); err != nil {
+		return nil, false, err
 	}
 
-	return io.ReadAll(resp.Body)
+	return body, false, nil
+}
+
+// observeBrowser fetches url with the browser, recording the attempt under
+// the status "browser" or "browser_error" and in its own span.
+func (c *SofascoreApiClient) observeBrowser(ctx context.Context, endpoint, url string) (_ []byte, err error) {
+	ctx, span := httpx.StartRequest(ctx, "sofascore", "BROWSER", endpoint, url)
+	defer func() { tracing.End(span, err) }()
+
+	start := time.Now()
+	body, err := c.getWithBrowser(ctx, url)
+
+	status := "browser"
+	if err != nil {
+		status = "browser_error"
+	}
+	httpx.ObserveRequestStatus("sofascore", endpoint, status, start)
+	return body, err
 }
 
 func (c *SofascoreApiClient) getWithBrowser(ctx context.Context, url string) ([]byte, error) {
@@ -112,7 +189,14 @@ func (c *SofascoreApiClient) getWithBrowser(ctx context.Context, url string) ([]
 		return nil, fmt.Errorf("browser error: %w", err)
 	}
 
-	return []byte(strings.TrimSpace(responseBody)), nil
+	body := []byte(strings.TrimSpace(responseBody))
+
+	// The browser gives us no status code, only the JSON error envelope.
+	if err = httpx.CheckErrorEnvelope(url, body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
 }
 
 func (c *SofascoreApiClient) setHeaders(req *http.Request) {
@@ -132,5 +216,24 @@ func (c *SofascoreApiClient) GetAndUnmarshal(ctx context.Context, endpoint strin
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(data, target)
+
+	if err = json.Unmarshal(data, target); err != nil {
+		return &httpx.DecodeError{URL: endpoint, Err: err}
+	}
+	return nil
+}
+
+// GetAndUnmarshalProto decodes with protojson, which understands Sofascore's
+// camelCase field names (hasNextPage, homeTeam, ...) as well as the proto ones.
+// Unknown fields are dropped.
+func (c *SofascoreApiClient) GetAndUnmarshalProto(ctx context.Context, endpoint string, target proto.Message) error {
+	data, err := c.Get(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+
+	if err = protoUnmarshaler.Unmarshal(data, target); err != nil {
+		return &httpx.DecodeError{URL: endpoint, Err: err}
+	}
+	return nil
 }
@@ -4,13 +4,20 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 
 	"github.com/imadeddine-belkat/sofascore-service/config"
 	sofascore_api "github.com/imadeddine-belkat/sofascore-service/internal/api"
+	httpx "github.com/imadeddine-belkat/tactify-http"
 	kafka "github.com/imadeddine-belkat/tactify-kafka"
 	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	"golang.org/x/sync/errgroup"
 )
 
+// maxEventPages bounds the last/next walk in case the API keeps reporting
+// has_next_page. A 38-round league is ~13 pages of 30 events each way.
+const maxEventPages = 100
+
 type EventsService struct {
 	Config   *config.SofascoreConfig
 	Client   *sofascore_api.SofascoreApiClient
@@ -34,24 +41,110 @@ func (e *EventsService) UpdateRoundMatches(ctx context.Context, seasonId, league
 }
 
 func (e *EventsService) GetRoundMatches(ctx context.Context, seasonId, leagueId, round int) ([]*sofascore.Event, error) {
-	var events []*sofascore.Event
+	page := &sofascore.EventsPage{}
 	leagueRoundMatches := e.Config.SofascoreApi.LeagueEndpoints.LeagueRoundMatches //unique-tournament/%d/seasonId/%d/events/round/%d
-	log.Printf("leagueRoundMatches raw: %q", leagueRoundMatches)
 
 	endpoint := fmt.Sprintf(leagueRoundMatches, leagueId, seasonId, round)
-	log.Println(endpoint)
 
-	if err := e.Client.GetAndUnmarshal(ctx, endpoint, events); err != nil {
+	if err := e.Client.GetAndUnmarshalProto(ctx, endpoint, page); err != nil {
 		return nil, fmt.Errorf("fetching events data: %w", err)
 	}
 
+	return page.Events, nil
+}
+
+// UpdateSeasonMatches discovers every event of a season and publishes each one
+// once, keyed by event id, so re-runs overwrite rather than duplicate.
+func (e *EventsService) UpdateSeasonMatches(ctx context.Context, seasonId, leagueId int) error {
+	events, err := e.GetSeasonMatches(ctx, seasonId, leagueId)
+	if err != nil {
+		return fmt.Errorf("error discovering season %d events for league %d: %w", seasonId, leagueId, err)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(e.Config.PublishWorkerCount)
+
+	for _, event := range events {
+		event := event
+		g.Go(func() error {
+			return e.publishRoundMatches(ctx, event)
+		})
+	}
+
+	return g.Wait()
+}
+
+// GetSeasonMatches walks the paginated last and next events endpoints of a
+// season. Unlike iterating round numbers this also returns postponed and
+// rescheduled events and cup-style rounds that have no numeric round. Events
+// are de-duplicated by id and returned in kickoff order.
+func (e *EventsService) GetSeasonMatches(ctx context.Context, seasonId, leagueId int) ([]*sofascore.Event, error) {
+	seen := make(map[int32]*sofascore.Event)
+
+	for _, endpoint := range []string{
+		e.Config.SofascoreApi.LeagueEndpoints.LeagueLastMatches, //unique-tournament/%d/season/%d/events/last/%d
+		e.Config.SofascoreApi.LeagueEndpoints.LeagueNextMatches, //unique-tournament/%d/season/%d/events/next/%d
+	} {
+		if err := e.collectEventPages(ctx, endpoint, seasonId, leagueId, seen); err != nil {
+			return nil, err
+		}
+	}
+
+	events := make([]*sofascore.Event, 0, len(seen))
+	for _, event := range seen {
+		events = append(events, event)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].StartTimestamp != events[j].StartTimestamp {
+			return events[i].StartTimestamp < events[j].StartTimestamp
+		}
+		return events[i].Id < events[j].Id
+	})
+
 	return events, nil
 }
 
+func (e *EventsService) collectEventPages(ctx context.Context, endpoint string, seasonId, leagueId int, seen map[int32]*sofascore.Event) error {
+	for page := 0; page < maxEventPages; page++ {
+		eventsPage, err := e.GetEventsPage(ctx, endpoint, seasonId, leagueId, page)
+		if err != nil {
+			// Sofascore answers 404 once there is nothing (more) in that direction,
+			// e.g. "next" for a finished season.
+			if httpx.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("fetching events page %d: %w", page, err)
+		}
+
+		for _, event := range eventsPage.Events {
+			seen[event.Id] = event
+		}
+
+		if !eventsPage.HasNextPage {
+			return nil
+		}
+	}
+
+	log.Printf("Warning: stopped after %d pages of %s for season %d, league %d", maxEventPages, endpoint, seasonId, leagueId)
+	return nil
+}
+
+func (e *EventsService) GetEventsPage(ctx context.Context, endpoint string, seasonId, leagueId, page int) (*sofascore.EventsPage, error) {
+	eventsPage := &sofascore.EventsPage{}
+
+	if err := e.Client.GetAndUnmarshalProto(ctx, fmt.Sprintf(endpoint, leagueId, seasonId, page), eventsPage); err != nil {
+		return nil, err
+	}
+
+	return eventsPage, nil
+}
+
 func (e *EventsService) publishRoundMatches(ctx context.Context, event *sofascore.Event) error {
 	roundMatchesTopic := e.Config.KafkaConfig.TopicsName.SofascoreLeagueRoundMatches.Name
 
-	key := []byte(fmt.Sprintf("%d-%s-%s", event.Id, event.Season.Name, event.Tournament.Name))
+	// The event id is the only stable identity: names change and rounds move when fixtures are rescheduled.
+	key := []byte(fmt.Sprintf("%d", event.Id))
 
 	if err := e.Producer.PublishWithProcess(ctx, event, roundMatchesTopic, key); err != nil {
 		return fmt.Errorf("error publishing event: %w", err)
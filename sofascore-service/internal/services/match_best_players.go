@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/sofascore-service/config"
+	sofascore_api "github.com/imadeddine-belkat/sofascore-service/internal/api"
+	httpx "github.com/imadeddine-belkat/tactify-http"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	"golang.org/x/sync/errgroup"
+)
+
+// finishedStatus is the Status.Type Sofascore uses for played matches.
+const finishedStatus = "finished"
+
+type MatchBestPlayersService struct {
+	Event    *EventsService
+	Config   *config.SofascoreConfig
+	Client   *sofascore_api.SofascoreApiClient
+	Producer *kafka.Producer
+}
+
+func (b *MatchBestPlayersService) GetMatchBestPlayers(ctx context.Context, matchId int) (*sofascore.MatchBestPlayers, error) {
+	bestPlayers := &sofascore.MatchBestPlayers{}
+
+	endpoint := fmt.Sprintf(b.Config.SofascoreApi.MatchEndpoints.MatchBestPlayers, matchId) //event/%d/best-players/summary
+
+	if err := b.Client.GetAndUnmarshalProto(ctx, endpoint, bestPlayers); err != nil {
+		return nil, err
+	}
+
+	return bestPlayers, nil
+}
+
+// UpdateSeasonMatchesBestPlayers publishes the best players of every finished
+// event in a season. Unplayed events are skipped, they have no ratings yet.
+func (b *MatchBestPlayersService) UpdateSeasonMatchesBestPlayers(ctx context.Context, seasonId, leagueId int) error {
+	events, err := b.Event.GetSeasonMatches(ctx, seasonId, leagueId)
+	if err != nil {
+		return fmt.Errorf("failed to get season matches for season %d, league %d: %w", seasonId, leagueId, err)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(b.Config.FetchWorkerCount)
+
+	for _, event := range events {
+		if event.GetStatus().GetType() != finishedStatus {
+			continue
+		}
+
+		event := event
+		g.Go(func() error {
+			return b.UpdateMatchBestPlayers(ctx, seasonId, leagueId, event)
+		})
+	}
+
+	return g.Wait()
+}
+
+func (b *MatchBestPlayersService) UpdateMatchBestPlayers(ctx context.Context, seasonId, leagueId int, event *sofascore.Event) error {
+	bestPlayers, err := b.GetMatchBestPlayers(ctx, int(event.GetId()))
+	if err != nil {
+		if httpx.IsNotFound(err) {
+			log.Printf("Warning: best players not found for match %d", event.GetId())
+			return nil
+		}
+		return fmt.Errorf("failed to get best players for match %d: %w", event.GetId(), err)
+	}
+
+	msg := &sofascore.MatchBestPlayersMessage{
+		SeasonId:    int32(seasonId),
+		LeagueId:    int32(leagueId),
+		MatchId:     event.GetId(),
+		HomeTeamId:  event.GetHomeTeam().GetId(),
+		AwayTeamId:  event.GetAwayTeam().GetId(),
+		BestPlayers: bestPlayers,
+	}
+
+	topic := b.Config.KafkaConfig.TopicsName.SofascoreMatchBestPlayers.Name
+	key := []byte(fmt.Sprintf("%d", event.GetId()))
+
+	if err := b.Producer.PublishWithProcess(ctx, msg, topic, key); err != nil {
+		return fmt.Errorf("failed to publish best players for match %d: %w", event.GetId(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/sofascore-service/config"
+	sofascore_api "github.com/imadeddine-belkat/sofascore-service/internal/api"
+	httpx "github.com/imadeddine-belkat/tactify-http"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	"golang.org/x/sync/errgroup"
+)
+
+type MatchH2hService struct {
+	Event    *EventsService
+	Config   *config.SofascoreConfig
+	Client   *sofascore_api.SofascoreApiClient
+	Producer *kafka.Producer
+}
+
+func (h *MatchH2hService) GetMatchH2h(ctx context.Context, matchId int) (*sofascore.MatchH2H, error) {
+	h2h := &sofascore.MatchH2H{}
+
+	endpoint := fmt.Sprintf(h.Config.SofascoreApi.MatchEndpoints.MatchH2hHistory, matchId) //event/%d/h2h
+
+	if err := h.Client.GetAndUnmarshalProto(ctx, endpoint, h2h); err != nil {
+		return nil, err
+	}
+
+	return h2h, nil
+}
+
+// UpdateSeasonMatchesH2h publishes the head-to-head record of every event in a
+// season. H2H is known before kickoff, so upcoming matches are included.
+func (h *MatchH2hService) UpdateSeasonMatchesH2h(ctx context.Context, seasonId, leagueId int) error {
+	events, err := h.Event.GetSeasonMatches(ctx, seasonId, leagueId)
+	if err != nil {
+		return fmt.Errorf("failed to get season matches for season %d, league %d: %w", seasonId, leagueId, err)
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(h.Config.FetchWorkerCount)
+
+	for _, event := range events {
+		event := event
+		g.Go(func() error {
+			return h.UpdateMatchH2h(ctx, seasonId, leagueId, event)
+		})
+	}
+
+	return g.Wait()
+}
+
+func (h *MatchH2hService) UpdateMatchH2h(ctx context.Context, seasonId, leagueId int, event *sofascore.Event) error {
+	h2h, err := h.GetMatchH2h(ctx, int(event.GetId()))
+	if err != nil {
+		if httpx.IsNotFound(err) {
+			log.Printf("Warning: H2H not found for match %d", event.GetId())
+			return nil
+		}
+		return fmt.Errorf("failed to get h2h for match %d: %w", event.GetId(), err)
+	}
+
+	msg := &sofascore.MatchH2HMessage{
+		SeasonId:   int32(seasonId),
+		LeagueId:   int32(leagueId),
+		MatchId:    event.GetId(),
+		HomeTeamId: event.GetHomeTeam().GetId(),
+		AwayTeamId: event.GetAwayTeam().GetId(),
+		H2H:        h2h,
+	}
+
+	topic := h.Config.KafkaConfig.TopicsName.SofascoreMatchH2hHistory.Name
+	key := []byte(fmt.Sprintf("%d", event.GetId()))
+
+	if err := h.Producer.PublishWithProcess(ctx, msg, topic, key); err != nil {
+		return fmt.Errorf("failed to publish h2h for match %d: %w", event.GetId(), err)
+	}
+
+	return nil
+}
@@ -3,10 +3,10 @@ package services
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/imadeddine-belkat/sofascore-service/config"
 	sofascore_api "github.com/imadeddine-belkat/sofascore-service/internal/api"
+	httpx "github.com/imadeddine-belkat/tactify-http"
 	kafka "github.com/imadeddine-belkat/tactify-kafka"
 	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
 	"golang.org/x/sync/errgroup"
@@ -25,7 +25,7 @@ func (l *MatchLineupService) GetMatchLineup(ctx context.Context, matchID int) (*
 	lineup := l.Config.SofascoreApi.MatchEndpoints.MatchLineups //event/%d/lineups
 	endpoint := fmt.Sprintf(lineup, matchID)
 
-	if err := l.Client.GetAndUnmarshal(ctx, endpoint, matchLineup); err != nil {
+	if err := l.Client.GetAndUnmarshalProto(ctx, endpoint, matchLineup); err != nil {
 		return nil, err
 	}
 
@@ -45,13 +45,25 @@ func (l *MatchLineupService) UpdatePlayersStats(ctx context.Context, seasonId, l
 		g.Go(func() error {
 			matchLineup, err := l.GetMatchLineup(ctx, int(event.Id))
 			if err != nil {
-				if isNotFoundError(err) {
+				if httpx.IsNotFound(err) {
 					fmt.Printf("Warning: Lineup not found for match %d (this is normal for future/cancelled matches)\n", event.Id)
 					return nil
 				}
 				return fmt.Errorf("failed to get lineup for match %d: %w", event.Id, err)
 			}
 
+			if err := l.publishLineup(ctx, &sofascore.MatchLineupMessage{
+				SeasonId:   int32(seasonId),
+				LeagueId:   int32(leagueId),
+				MatchId:    event.Id,
+				Round:      int32(round),
+				Lineup:     matchLineup,
+				HomeTeamId: event.GetHomeTeam().GetId(),
+				AwayTeamId: event.GetAwayTeam().GetId(),
+			}); err != nil {
+				return fmt.Errorf("failed to publish lineup for match %d: %w", event.Id, err)
+			}
+
 			players, err := l.processMatchLineup(matchLineup, seasonId, leagueId, round, int(event.Id))
 			if err != nil {
 				return fmt.Errorf("failed to process match lineup for match %d: %w", event.Id, err)
@@ -73,11 +85,11 @@ func (l *MatchLineupService) UpdatePlayersStats(ctx context.Context, seasonId, l
 }
 
 func (l *MatchLineupService) processMatchLineup(lineup *sofascore.MatchLineup, seasonId, leagueId, round, eventId int) ([]*sofascore.PlayerMatchStatsMessage, error) {
-	players := make([]*sofascore.PlayerMatchStatsMessage, 0, len(lineup.Home.Players)+len(lineup.Away.Players))
+	players := make([]*sofascore.PlayerMatchStatsMessage, 0, len(lineup.GetHome().GetPlayers())+len(lineup.GetAway().GetPlayers()))
 
-	for _, player := range lineup.Home.Players {
+	for _, player := range lineup.GetHome().GetPlayers() {
 		players = append(players, &sofascore.PlayerMatchStatsMessage{
-			PlayerName: player.Player.Name,
+			PlayerName: player.GetPlayer().GetName(),
 			SeasonId:   int32(seasonId),
 			LeagueId:   int32(leagueId),
 			MatchId:    int32(eventId),
@@ -86,9 +98,9 @@ func (l *MatchLineupService) processMatchLineup(lineup *sofascore.MatchLineup, s
 		})
 	}
 
-	for _, player := range lineup.Away.Players {
+	for _, player := range lineup.GetAway().GetPlayers() {
 		players = append(players, &sofascore.PlayerMatchStatsMessage{
-			PlayerName: player.Player.Name,
+			PlayerName: player.GetPlayer().GetName(),
 			SeasonId:   int32(seasonId),
 			LeagueId:   int32(leagueId),
 			MatchId:    int32(eventId),
@@ -113,14 +125,10 @@ func (l *MatchLineupService) publishPlayer(ctx context.Context, player *sofascor
 	return l.Producer.PublishWithProcess(ctx, player, playersStatsTopic, key)
 }
 
-func isNotFoundError(err error) bool {
-	if err == nil {
-		return false
-	}
+func (l *MatchLineupService) publishLineup(ctx context.Context, lineup *sofascore.MatchLineupMessage) error {
+	lineupsTopic := l.Config.KafkaConfig.TopicsName.SofascoreMatchLineups.Name
 
-	errMsg := err.Error()
+	key := []byte(fmt.Sprintf("%d", lineup.MatchId))
 
-	// Check for the exact error format: {"error":{"code":404,"message":"Not Found"}}
-	return strings.Contains(errMsg, `"code":404`) ||
-		strings.Contains(errMsg, "404") && strings.Contains(errMsg, "Not Found")
+	return l.Producer.PublishWithProcess(ctx, lineup, lineupsTopic, key)
 }
@@ -1 +1,185 @@
 package services
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/imadeddine-belkat/sofascore-service/config"
+	sofascore_api "github.com/imadeddine-belkat/sofascore-service/internal/api"
+	httpx "github.com/imadeddine-belkat/tactify-http"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+	sofascore "github.com/imadeddine-belkat/tactify-protos/go/sofascore/v1"
+	"golang.org/x/sync/errgroup"
+)
+
+// PlayerOverallStatsService publishes the career-wide data of a player: one
+// message per season line of /player/{id}/statistics and one message with the
+// attribute overviews.
+type PlayerOverallStatsService struct {
+	Config   *config.SofascoreConfig
+	Client   *sofascore_api.SofascoreApiClient
+	Producer *kafka.Producer
+	Players  *PlayersService
+}
+
+// UpdateLeaguePlayersOverallStats refreshes season stats and attributes for
+// every player in the squads of a league season. Failures of a single player
+// are logged and do not stop the others.
+func (o *PlayerOverallStatsService) UpdateLeaguePlayersOverallStats(ctx context.Context, seasonId, leagueId int) error {
+	playerIds, err := o.GetLeaguePlayerIDs(ctx, seasonId, leagueId)
+	if err != nil {
+		return err
+	}
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(o.Config.FetchWorkerCount)
+
+	for _, playerId := range playerIds {
+		playerId := playerId
+		g.Go(func() error {
+			if err := o.UpdatePlayerSeasonsStats(ctx, playerId); err != nil {
+				log.Printf("failed to update season stats for PlayerID %d: %v", playerId, err)
+			}
+			if err := o.UpdatePlayerAttributes(ctx, playerId); err != nil {
+				log.Printf("failed to update attributes for PlayerID %d: %v", playerId, err)
+			}
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
+
+// GetLeaguePlayerIDs returns the ids of all players in the current squads of
+// the teams in a league season, without duplicates.
+func (o *PlayerOverallStatsService) GetLeaguePlayerIDs(ctx context.Context, seasonId, leagueId int) ([]int, error) {
+	teams, err := o.Players.GetTeamIDs(ctx, seasonId, leagueId)
+	if err != nil {
+		return nil, fmt.Errorf("error getting team ids for league %d, season %d: %w", leagueId, seasonId, err)
+	}
+
+	seen := make(map[int32]struct{})
+	var playerIds []int
+
+	for _, team := range teams {
+		squad, err := o.GetTeamPlayers(ctx, int(team.Id))
+		if err != nil {
+			return nil, fmt.Errorf("error getting squad for team %d: %w", team.Id, err)
+		}
+
+		for _, item := range squad.Players {
+			id := item.GetPlayer().GetId()
+			if id == 0 {
+				continue
+			}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			playerIds = append(playerIds, int(id))
+		}
+	}
+
+	return playerIds, nil
+}
+
+func (o *PlayerOverallStatsService) GetTeamPlayers(ctx context.Context, teamId int) (*sofascore.TeamPlayers, error) {
+	squad := &sofascore.TeamPlayers{}
+
+	endpoint := fmt.Sprintf(o.Config.SofascoreApi.PlayerEndpoints.PlayersInfo, teamId) //team/%d/players
+
+	if err := o.Client.GetAndUnmarshalProto(ctx, endpoint, squad); err != nil {
+		return nil, fmt.Errorf("fetching team players data: %w", err)
+	}
+
+	return squad, nil
+}
+
+func (o *PlayerOverallStatsService) GetPlayerSeasonsStats(ctx context.Context, playerId int) (*sofascore.PlayerSeasonsStats, error) {
+	seasons := &sofascore.PlayerSeasonsStats{}
+
+	endpoint := fmt.Sprintf(o.Config.SofascoreApi.PlayerEndpoints.PlayerSeasonsStats, playerId) //player/%d/statistics
+
+	if err := o.Client.GetAndUnmarshalProto(ctx, endpoint, seasons); err != nil {
+		return nil, fmt.Errorf("fetching player season stats data: %w", err)
+	}
+
+	return seasons, nil
+}
+
+// UpdatePlayerSeasonsStats publishes one message per (competition, season,
+// team, stat type) line. A player without any recorded statistics is skipped.
+func (o *PlayerOverallStatsService) UpdatePlayerSeasonsStats(ctx context.Context, playerId int) error {
+	topic := o.Config.KafkaConfig.TopicsName.SofascorePlayerSeasonsStats.Name
+
+	seasons, err := o.GetPlayerSeasonsStats(ctx, playerId)
+	if err != nil {
+		if httpx.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, stats := range seasons.Seasons {
+		stats.PlayerId = int32(playerId)
+
+		msg := &sofascore.PlayerSeasonStatsMessage{
+			PlayerId: int32(playerId),
+			Stats:    stats,
+		}
+
+		key := []byte(fmt.Sprintf("%d-%d-%d-%d-%s",
+			playerId,
+			stats.GetUniqueTournament().GetId(),
+			stats.GetSeason().GetId(),
+			stats.GetTeam().GetId(),
+			stats.GetStatistics().GetType(),
+		))
+
+		if err := o.Producer.PublishWithProcess(ctx, msg, topic, key); err != nil {
+			return fmt.Errorf("failed to publish season stats to Kafka for player: %d, season: %d: %w", playerId, stats.GetSeason().GetId(), err)
+		}
+	}
+
+	return nil
+}
+
+func (o *PlayerOverallStatsService) GetPlayerAttributes(ctx context.Context, playerId int) (*sofascore.PlayerAttributes, error) {
+	attributes := &sofascore.PlayerAttributes{}
+
+	endpoint := fmt.Sprintf(o.Config.SofascoreApi.PlayerEndpoints.PlayerAttributes, playerId) //player/%d/attribute-overviews
+
+	if err := o.Client.GetAndUnmarshalProto(ctx, endpoint, attributes); err != nil {
+		return nil, fmt.Errorf("fetching player attributes data: %w", err)
+	}
+
+	return attributes, nil
+}
+
+// UpdatePlayerAttributes publishes the attribute overviews of a player. Sofascore
+// only rates players with enough minutes, so a 404 is not an error.
+func (o *PlayerOverallStatsService) UpdatePlayerAttributes(ctx context.Context, playerId int) error {
+	topic := o.Config.KafkaConfig.TopicsName.SofascorePlayerAttributes.Name
+
+	attributes, err := o.GetPlayerAttributes(ctx, playerId)
+	if err != nil {
+		if httpx.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	msg := &sofascore.PlayerAttributesMessage{
+		PlayerId:   int32(playerId),
+		Attributes: attributes,
+	}
+
+	key := []byte(fmt.Sprintf("%d", playerId))
+
+	if err := o.Producer.PublishWithProcess(ctx, msg, topic, key); err != nil {
+		return fmt.Errorf("failed to publish attributes to Kafka for player: %d: %w", playerId, err)
+	}
+
+	return nil
+}
@@ -70,7 +70,7 @@ func (o *TeamOverallStatsService) UpdateAllTeamsOverallStats(ctx context.Context
 	for _, teamId := range teamsIds {
 		err = o.UpdateTeamOverallStats(ctx, teamId, leagueId, seasonId)
 		if err != nil {
-			log.Printf("failed to update teamOverallStats for TeamID %d, LeagueID %d, SeasonID %d: %w", teamId, leagueId, seasonId, err)
+			log.Printf("failed to update teamOverallStats for TeamID %d, LeagueID %d, SeasonID %d: %v", teamId, leagueId, seasonId, err)
 		}
 
 	}
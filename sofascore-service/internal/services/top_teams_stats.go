@@ -2,7 +2,6 @@ package services
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"sync"
@@ -59,7 +58,7 @@ func (s *TopTeamsStatsService) UpdateLeagueTopTeamsStats(ctx context.Context, se
 	return nil
 }
 
-// worker consumes jobs from the channel and publishes to Kafka by accessing fields directly.
+// worker consumes jobs from the channel and publishes them to Kafka.
 func (s *TopTeamsStatsService) worker(ctx context.Context, wg *sync.WaitGroup, jobs <-chan statJob, topic string, seasonId, leagueId int) {
 	defer wg.Done()
 	for job := range jobs {
@@ -67,56 +66,57 @@ func (s *TopTeamsStatsService) worker(ctx context.Context, wg *sync.WaitGroup, j
 		stat.SeasonId = int32(seasonId)
 		stat.LeagueId = int32(leagueId)
 
-		payload, err := json.Marshal(stat)
-		if err != nil {
-			log.Printf("[ERROR] Failed to marshal %s: %v", job.label, err)
-			continue
-		}
-
-		// Direct access to team ID without helpers methods
-		teamID := 0
-		if stat.Team != nil {
-			teamID = int(stat.Team.Id)
-		}
-
-		key := []byte(fmt.Sprintf("%s-%d-%d-%d", job.label, seasonId, leagueId, teamID))
-		if err := s.Producer.Publish(ctx, topic, key, payload); err != nil {
+		key := []byte(fmt.Sprintf("%s-%d-%d-%d", job.label, seasonId, leagueId, stat.GetTeam().GetId()))
+		if err := s.Producer.PublishWithProcess(ctx, stat, topic, key); err != nil {
 			log.Printf("[ERROR] Kafka publish failed for %s: %v", job.label, err)
 		}
 	}
 }
 
-// enqueueJobs extracts all stats from the TopTeams message and sends them to the job channel.
+type leaderboard struct {
+	items []*sofascore.TopTeamStatItem
+	value func(*sofascore.TeamLeaderboardStatistics) float64
+}
+
+// enqueueJobs extracts all stats from the TopTeams message, stamps each item with its
+// leaderboard label, rank and ranked value, and sends them to the job channel.
 func (s *TopTeamsStatsService) enqueueJobs(tt *sofascore.TopTeams, jobChan chan<- statJob) {
-	categories := map[string][]*sofascore.TopTeamStatItem{
-		"averageRating":         tt.AvgRating,
-		"goalsScored":           tt.GoalsScored,
-		"goalsConceded":         tt.GoalsConceded,
-		"bigChances":            tt.BigChances,
-		"bigChancesMissed":      tt.BigChancesMissed,
-		"hitWoodWork":           tt.HitWoodWork,
-		"yellowCards":           tt.YellowCards,
-		"redCards":              tt.RedCards,
-		"averageBallPossession": tt.AverageBallPossession,
-		"accuratePasses":        tt.AccuratePasses,
-		"accurateLongBalls":     tt.AccurateLongBalls,
-		"accurateCrosses":       tt.AccurateCrosses,
-		"shots":                 tt.Shots,
-		"shotsOnTarget":         tt.ShotsOnTarget,
-		"successfulDribbles":    tt.SuccessfulDribbles,
-		"tackles":               tt.Tackles,
-		"interceptions":         tt.Interceptions,
-		"clearances":            tt.Clearances,
-		"corners":               tt.Corners,
-		"fouls":                 tt.Fouls,
-		"penaltyGoals":          tt.PenaltyGoals,
-		"penaltyGoalsConceded":  tt.PenaltyGoalsConceded,
-		"cleanSheets":           tt.CleanSheets,
+	type stats = sofascore.TeamLeaderboardStatistics
+	i32 := func(v int32) float64 { return float64(v) }
+
+	categories := map[string]leaderboard{
+		"averageRating":         {tt.AvgRating, func(s *stats) float64 { return s.GetAvgRating() }},
+		"goalsScored":           {tt.GoalsScored, func(s *stats) float64 { return i32(s.GetGoalsScored()) }},
+		"goalsConceded":         {tt.GoalsConceded, func(s *stats) float64 { return i32(s.GetGoalsConceded()) }},
+		"bigChances":            {tt.BigChances, func(s *stats) float64 { return i32(s.GetBigChances()) }},
+		"bigChancesMissed":      {tt.BigChancesMissed, func(s *stats) float64 { return i32(s.GetBigChancesMissed()) }},
+		"hitWoodWork":           {tt.HitWoodWork, func(s *stats) float64 { return i32(s.GetHitWoodWork()) }},
+		"yellowCards":           {tt.YellowCards, func(s *stats) float64 { return i32(s.GetYellowCards()) }},
+		"redCards":              {tt.RedCards, func(s *stats) float64 { return i32(s.GetRedCards()) }},
+		"averageBallPossession": {tt.AverageBallPossession, func(s *stats) float64 { return s.GetAvgBallPossession() }},
+		"accuratePasses":        {tt.AccuratePasses, func(s *stats) float64 { return i32(s.GetAccuratePasses()) }},
+		"accurateLongBalls":     {tt.AccurateLongBalls, func(s *stats) float64 { return i32(s.GetAccurateLongBalls()) }},
+		"accurateCrosses":       {tt.AccurateCrosses, func(s *stats) float64 { return i32(s.GetAccurateCrosses()) }},
+		"shots":                 {tt.Shots, func(s *stats) float64 { return i32(s.GetShots()) }},
+		"shotsOnTarget":         {tt.ShotsOnTarget, func(s *stats) float64 { return i32(s.GetShotsOnTarget()) }},
+		"successfulDribbles":    {tt.SuccessfulDribbles, func(s *stats) float64 { return i32(s.GetSuccessfulDribbles()) }},
+		"tackles":               {tt.Tackles, func(s *stats) float64 { return i32(s.GetTackles()) }},
+		"interceptions":         {tt.Interceptions, func(s *stats) float64 { return i32(s.GetInterceptions()) }},
+		"clearances":            {tt.Clearances, func(s *stats) float64 { return i32(s.GetClearances()) }},
+		"corners":               {tt.Corners, func(s *stats) float64 { return i32(s.GetCorners()) }},
+		"fouls":                 {tt.Fouls, func(s *stats) float64 { return i32(s.GetFouls()) }},
+		"penaltyGoals":          {tt.PenaltyGoals, func(s *stats) float64 { return i32(s.GetPenaltyGoals()) }},
+		"penaltyGoalsConceded":  {tt.PenaltyGoalsConceded, func(s *stats) float64 { return i32(s.GetPenaltyGoalsConceded()) }},
+		"cleanSheets":           {tt.CleanSheets, func(s *stats) float64 { return i32(s.GetCleanSheets()) }},
 	}
 
-	for label, items := range categories {
-		for _, item := range items {
+	for label, board := range categories {
+		// Sofascore returns each leaderboard already sorted, so position is the rank.
+		for i, item := range board.items {
 			if item != nil {
+				item.StatLabel = label
+				item.Rank = int32(i + 1)
+				item.Value = board.value(item.GetStatistics())
 				jobChan <- statJob{label: label, item: item}
 			}
 		}
@@ -127,7 +127,7 @@ func (s *TopTeamsStatsService) enqueueJobs(tt *sofascore.TopTeams, jobChan chan<
 func (s *TopTeamsStatsService) GetTopTeamsStats(ctx context.Context, leagueId int, seasonId int) (*sofascore.TopTeamsMessage, error) {
 	topTeams := &sofascore.TopTeamsMessage{}
 	endpoint := fmt.Sprintf(s.Config.SofascoreApi.TeamEndpoints.TopTeamsStats, leagueId, seasonId)
-	if err := s.Client.GetAndUnmarshal(ctx, endpoint, topTeams); err != nil {
+	if err := s.Client.GetAndUnmarshalProto(ctx, endpoint, topTeams); err != nil {
 		return nil, fmt.Errorf("API request failed: %w", err)
 	}
 	return topTeams, nil
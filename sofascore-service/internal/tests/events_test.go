@@ -74,3 +74,36 @@ func TestEventsService(t *testing.T) {
 
 	t.Log("Test completed successfully")
 }
+
+func TestEventsServiceSeasonDiscovery(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping API test")
+	}
+
+	cfg := config.LoadConfig()
+	service := &eventService.EventsService{
+		Config:   cfg,
+		Client:   sofascore_api.NewSofascoreApiClient(cfg),
+		Producer: kafka.NewProducer(),
+	}
+
+	leagueID := cfg.SofascoreApi.LeaguesID.PremierLeague
+	seasonID, err := cfg.GetSeasonID("PREMIERLEAGUE", "2425")
+	if err != nil {
+		t.Fatalf("Failed to resolve season: %v", err)
+	}
+
+	events, err := service.GetSeasonMatches(context.Background(), seasonID, leagueID)
+	if err != nil {
+		t.Fatalf("Failed to discover season events: %v", err)
+	}
+
+	// 20 teams playing each other home and away.
+	if len(events) != 380 {
+		t.Errorf("Expected 380 events, got %d", len(events))
+	}
+
+	if err := service.UpdateSeasonMatches(context.Background(), seasonID, leagueID); err != nil {
+		t.Fatalf("Failed to publish season events: %v", err)
+	}
+}
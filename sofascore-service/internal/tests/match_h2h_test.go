@@ -0,0 +1,67 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/imadeddine-belkat/sofascore-service/config"
+	sofascore_api "github.com/imadeddine-belkat/sofascore-service/internal/api"
+	"github.com/imadeddine-belkat/sofascore-service/internal/services"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+)
+
+func TestMatchH2hService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping API test")
+	}
+
+	cfg := config.LoadConfig()
+	apiClient := sofascore_api.NewSofascoreApiClient(cfg)
+	service := &services.MatchH2hService{
+		Event: &services.EventsService{
+			Config: cfg,
+			Client: apiClient,
+		},
+		Config:   cfg,
+		Client:   apiClient,
+		Producer: kafka.NewProducer(),
+	}
+
+	ctx := context.Background()
+	seasonID := cfg.MustGetSeasonID("PREMIERLEAGUE", "2425")
+	leagueID := cfg.SofascoreApi.LeaguesID.PremierLeague
+
+	if err := service.UpdateSeasonMatchesH2h(ctx, seasonID, leagueID); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Log("Test completed successfully")
+}
+
+func TestMatchBestPlayersService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping API test")
+	}
+
+	cfg := config.LoadConfig()
+	apiClient := sofascore_api.NewSofascoreApiClient(cfg)
+	service := &services.MatchBestPlayersService{
+		Event: &services.EventsService{
+			Config: cfg,
+			Client: apiClient,
+		},
+		Config:   cfg,
+		Client:   apiClient,
+		Producer: kafka.NewProducer(),
+	}
+
+	ctx := context.Background()
+	seasonID := cfg.MustGetSeasonID("PREMIERLEAGUE", "2425")
+	leagueID := cfg.SofascoreApi.LeaguesID.PremierLeague
+
+	if err := service.UpdateSeasonMatchesBestPlayers(ctx, seasonID, leagueID); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Log("Test completed successfully")
+}
@@ -0,0 +1,46 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/imadeddine-belkat/sofascore-service/config"
+	sofascore_api "github.com/imadeddine-belkat/sofascore-service/internal/api"
+	"github.com/imadeddine-belkat/sofascore-service/internal/services"
+	kafka "github.com/imadeddine-belkat/tactify-kafka"
+)
+
+func TestPlayerOverallStatsService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping API test")
+	}
+
+	cfg := config.LoadConfig()
+	apiClient := sofascore_api.NewSofascoreApiClient(cfg)
+	producer := kafka.NewProducer()
+	service := &services.PlayerOverallStatsService{
+		Config:   cfg,
+		Client:   apiClient,
+		Producer: producer,
+		Players: &services.PlayersService{
+			Config: *cfg,
+			Client: apiClient,
+			Standing: &services.LeagueStandingService{
+				Config:   cfg,
+				Client:   apiClient,
+				Producer: producer,
+			},
+			Producer: producer,
+		},
+	}
+
+	ctx := context.Background()
+	seasonID := cfg.MustGetSeasonID("PREMIERLEAGUE", "2425")
+	leagueID := cfg.SofascoreApi.LeaguesID.PremierLeague
+
+	if err := service.UpdateLeaguePlayersOverallStats(ctx, seasonID, leagueID); err != nil {
+		t.Fatalf("Error: %v", err)
+	}
+
+	t.Log("Test completed successfully")
+}
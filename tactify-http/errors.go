@@ -0,0 +1,200 @@
+package tactify_http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NotFoundError is returned when the upstream answers 404 for a resource.
+type NotFoundError struct {
+	URL string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("not found: %s", e.URL)
+}
+
+// RateLimitedError is returned on 429. RetryAfter is zero when the upstream
+// did not send a usable Retry-After header.
+type RateLimitedError struct {
+	URL        string
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("rate limited: %s (retry after %s)", e.URL, e.RetryAfter)
+	}
+	return fmt.Sprintf("rate limited: %s", e.URL)
+}
+
+// BlockedError is returned when the upstream refuses the client (401/403).
+type BlockedError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *BlockedError) Error() string {
+	return fmt.Sprintf("blocked (status %d): %s", e.StatusCode, e.URL)
+}
+
+// UpstreamError is returned for 5xx responses.
+type UpstreamError struct {
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *UpstreamError) Error() string {
+	return fmt.Sprintf("upstream error (status %d): %s", e.StatusCode, e.URL)
+}
+
+// StatusError is returned for any other non-2xx response.
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.URL)
+}
+
+// DecodeError is returned when a response body cannot be unmarshalled.
+type DecodeError struct {
+	URL string
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("decoding response from %s: %v", e.URL, e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// CheckResponse maps a non-2xx response to one of the typed errors above.
+// It returns nil for 2xx responses and does not close the body.
+func CheckResponse(url string, resp *http.Response, body []byte) error {
+	code := resp.StatusCode
+	if code >= 200 && code < 300 {
+		return nil
+	}
+	return statusToError(url, code, resp.Header.Get("Retry-After"), string(body))
+}
+
+// CheckErrorEnvelope detects the {"error":{"code":N,"message":"..."}} body some
+// upstreams return with a 200 (or via a browser fetch where no status is
+// available) and maps it to a typed error.
+func CheckErrorEnvelope(url string, body []byte) error {
+	var envelope struct {
+		Error *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	trimmed := strings.TrimSpace(string(body))
+	if !strings.HasPrefix(trimmed, "{") {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(trimmed), &envelope); err != nil || envelope.Error == nil || envelope.Error.Code == 0 {
+		return nil
+	}
+
+	return statusToError(url, envelope.Error.Code, "", envelope.Error.Message)
+}
+
+func statusToError(url string, code int, retryAfter, body string) error {
+	switch {
+	case code == http.StatusNotFound:
+		return &NotFoundError{URL: url}
+	case code == http.StatusTooManyRequests:
+		return &RateLimitedError{URL: url, RetryAfter: parseRetryAfter(retryAfter)}
+	case code == http.StatusUnauthorized || code == http.StatusForbidden:
+		return &BlockedError{URL: url, StatusCode: code}
+	case code >= 500:
+		return &UpstreamError{URL: url, StatusCode: code, Body: body}
+	default:
+		return &StatusError{URL: url, StatusCode: code, Body: body}
+	}
+}
+
+// parseRetryAfter accepts both forms allowed by RFC 9110: delay-seconds and an HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+func IsNotFound(err error) bool {
+	var target *NotFoundError
+	return errors.As(err, &target)
+}
+
+func IsRateLimited(err error) bool {
+	var target *RateLimitedError
+	return errors.As(err, &target)
+}
+
+func IsBlocked(err error) bool {
+	var target *BlockedError
+	return errors.As(err, &target)
+}
+
+func IsUpstream(err error) bool {
+	var target *UpstreamError
+	return errors.As(err, &target)
+}
+
+func IsDecode(err error) bool {
+	var target *DecodeError
+	return errors.As(err, &target)
+}
+
+// Retryable reports whether a request that failed with err is worth repeating.
+// Rate limits, 5xx and transport failures are; 404, blocks, decode errors and
+// context cancellation are not.
+func Retryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	switch {
+	case IsRateLimited(err), IsUpstream(err):
+		return true
+	case IsNotFound(err), IsBlocked(err), IsDecode(err):
+		return false
+	}
+
+	var status *StatusError
+	if errors.As(err, &status) {
+		return status.StatusCode == http.StatusRequestTimeout
+	}
+
+	// Anything untyped is a transport-level failure (dial, reset, timeout).
+	return true
+}
@@ -0,0 +1,92 @@
+package tactify_http
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tactify",
+		Subsystem: "api_client",
+		Name:      "requests_total",
+		Help:      "Requests made to upstream APIs, by client, endpoint and status.",
+	}, []string{"client", "endpoint", "status"})
+
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "tactify",
+		Subsystem: "api_client",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of requests made to upstream APIs, by client, endpoint and status.",
+		Buckets:   []float64{.05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"client", "endpoint", "status"})
+)
+
+// ObserveRequest records one attempt against an upstream API that started
+// at start. resp is nil when no response was received, which is counted with
+// the status "error".
+func ObserveRequest(client, endpoint string, resp *http.Response, start time.Time) {
+	status := "error"
+	if resp != nil {
+		status = strconv.Itoa(resp.StatusCode)
+	}
+	ObserveRequestStatus(client, endpoint, status, start)
+}
+
+// ObserveRequestStatus is ObserveRequest for attempts that aren't plain HTTP
+// responses, such as a browser fetch.
+func ObserveRequestStatus(client, endpoint, status string, start time.Time) {
+	label := EndpointLabel(endpoint)
+	requestsTotal.WithLabelValues(client, label, status).Inc()
+	requestDuration.WithLabelValues(client, label, status).Observe(time.Since(start).Seconds())
+}
+
+// EndpointLabel reduces a concrete endpoint to its shape so that metrics stay
+// one series per endpoint rather than per resource: the query string is
+// dropped and numeric path segments become ":id" ("/event/123/live/" →
+// "/event/:id/live/").
+func EndpointLabel(endpoint string) string {
+	if i := strings.IndexByte(endpoint, '?'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+
+	segments := strings.Split(endpoint, "/")
+	for i, s := range segments {
+		if s == "" {
+			continue
+		}
+		if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+			segments[i] = ":id"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// Serve exposes the default Prometheus registry on addr at /metrics until ctx
+// is done. It is meant for the long-running commands of every service.
+func Serve(ctx context.Context, addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", promhttp.Handler())
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	log.Printf("Serving metrics on %s/metrics", addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Printf("Error serving metrics: %v", err)
+	}
+}
@@ -0,0 +1,176 @@
+package tactify_http
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RetryPolicy is an exponential backoff with full jitter. The delay before
+// attempt n (1-based, n > 1) is a random value in [0, min(MaxDelay, BaseDelay*2^(n-2))].
+// A RateLimitedError carrying a Retry-After overrides the computed delay.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+
+	// RetryOn decides whether an error is retried. Defaults to Retryable.
+	RetryOn func(error) bool
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+// NoRetry performs a single attempt.
+var NoRetry = RetryPolicy{MaxAttempts: 1}
+
+// Do runs fn until it succeeds, returns a non-retryable error, the attempts are
+// exhausted or ctx is done. The last error from fn is returned unchanged so
+// callers can still match it with errors.As.
+func (p RetryPolicy) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	attempts := p.MaxAttempts
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	retryOn := p.RetryOn
+	if retryOn == nil {
+		retryOn = Retryable
+	}
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if err = fn(ctx); err == nil {
+			return nil
+		}
+
+		if attempt == attempts || !retryOn(err) {
+			return err
+		}
+
+		timer := time.NewTimer(p.Backoff(attempt, err))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+
+	return err
+}
+
+// Backoff returns the delay to wait after the given failed attempt.
+func (p RetryPolicy) Backoff(attempt int, err error) time.Duration {
+	var rateLimited *RateLimitedError
+	if errors.As(err, &rateLimited) && rateLimited.RetryAfter > 0 {
+		if p.MaxDelay > 0 && rateLimited.RetryAfter > p.MaxDelay {
+			return p.MaxDelay
+		}
+		return rateLimited.RetryAfter
+	}
+
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+
+	ceiling := p.BaseDelay << (attempt - 1)
+	if ceiling <= 0 || (p.MaxDelay > 0 && ceiling > p.MaxDelay) {
+		ceiling = p.MaxDelay
+	}
+
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// RetryPolicies holds a default policy plus per-endpoint overrides. Endpoints
+// are registered with the same format string used to build them (e.g.
+// "/event/%d/lineups"), so a concrete path matches its template.
+type RetryPolicies struct {
+	mu        sync.RWMutex
+	Default   RetryPolicy
+	endpoints map[string]RetryPolicy
+}
+
+func NewRetryPolicies(def RetryPolicy) *RetryPolicies {
+	return &RetryPolicies{
+		Default:   def,
+		endpoints: make(map[string]RetryPolicy),
+	}
+}
+
+// Set registers a policy for an endpoint template.
+func (r *RetryPolicies) Set(template string, policy RetryPolicy) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.endpoints[template] = policy
+}
+
+// For returns the policy for a concrete endpoint, falling back to Default.
+func (r *RetryPolicies) For(endpoint string) RetryPolicy {
+	if r == nil {
+		return DefaultRetryPolicy
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if policy, ok := r.endpoints[endpoint]; ok {
+		return policy
+	}
+
+	// Prefer the most specific (longest) matching template.
+	best, bestLen := r.Default, -1
+	for template, policy := range r.endpoints {
+		if len(template) > bestLen && matchTemplate(template, endpoint) {
+			best, bestLen = policy, len(template)
+		}
+	}
+	return best
+}
+
+// matchTemplate reports whether endpoint could have been produced by
+// fmt.Sprintf(template, ...). Each verb matches one or more characters
+// other than '/'; the query string is ignored.
+func matchTemplate(template, endpoint string) bool {
+	if i := strings.IndexByte(endpoint, '?'); i >= 0 {
+		endpoint = endpoint[:i]
+	}
+	if i := strings.IndexByte(template, '?'); i >= 0 {
+		template = template[:i]
+	}
+
+	for len(template) > 0 {
+		verb := strings.IndexByte(template, '%')
+		if verb < 0 {
+			return template == endpoint
+		}
+
+		if verb+2 > len(template) {
+			return false
+		}
+
+		literal := template[:verb]
+		if !strings.HasPrefix(endpoint, literal) {
+			return false
+		}
+		endpoint = endpoint[len(literal):]
+		template = template[verb+2:]
+
+		n := 0
+		for n < len(endpoint) && endpoint[n] != '/' {
+			n++
+		}
+		if n == 0 {
+			return false
+		}
+		endpoint = endpoint[n:]
+	}
+
+	return endpoint == ""
+}
@@ -0,0 +1,98 @@
+package tests
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	tactify_http "github.com/imadeddine-belkat/tactify-http"
+)
+
+func TestCheckResponseRetryAfter(t *testing.T) {
+	cases := []struct {
+		name       string
+		retryAfter string
+		min, max   time.Duration
+	}{
+		{"missing", "", 0, 0},
+		{"seconds", "5", 5 * time.Second, 5 * time.Second},
+		{"seconds with spaces", " 12 ", 12 * time.Second, 12 * time.Second},
+		{"zero seconds", "0", 0, 0},
+		{"negative seconds", "-3", 0, 0},
+		{"garbage", "soon", 0, 0},
+		{"future date", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), 55 * time.Second, time.Minute},
+		{"past date", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat), 0, 0},
+	}
+
+	for _, c := range cases {
+		resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+		if c.retryAfter != "" {
+			resp.Header.Set("Retry-After", c.retryAfter)
+		}
+
+		var rateLimited *tactify_http.RateLimitedError
+		if err := tactify_http.CheckResponse("/x", resp, nil); !errors.As(err, &rateLimited) {
+			t.Fatalf("%s: got %v, want a RateLimitedError", c.name, err)
+		}
+		if rateLimited.RetryAfter < c.min || rateLimited.RetryAfter > c.max {
+			t.Fatalf("%s: got %s, want within [%s, %s]", c.name, rateLimited.RetryAfter, c.min, c.max)
+		}
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	cases := []struct {
+		code  int
+		check func(error) bool
+	}{
+		{200, func(err error) bool { return err == nil }},
+		{204, func(err error) bool { return err == nil }},
+		{404, tactify_http.IsNotFound},
+		{429, tactify_http.IsRateLimited},
+		{401, tactify_http.IsBlocked},
+		{403, tactify_http.IsBlocked},
+		{500, tactify_http.IsUpstream},
+		{503, tactify_http.IsUpstream},
+		{400, func(err error) bool {
+			var status *tactify_http.StatusError
+			return errors.As(err, &status) && status.StatusCode == 400 && status.Body == "bad"
+		}},
+	}
+
+	for _, c := range cases {
+		resp := &http.Response{StatusCode: c.code, Header: http.Header{}}
+		if err := tactify_http.CheckResponse("/x", resp, []byte("bad")); !c.check(err) {
+			t.Fatalf("status %d: unexpected error %v", c.code, err)
+		}
+	}
+}
+
+func TestCheckErrorEnvelope(t *testing.T) {
+	cases := []struct {
+		name  string
+		body  string
+		check func(error) bool
+	}{
+		{"plain data", `{"events":[]}`, func(err error) bool { return err == nil }},
+		{"not json", `<html>ok</html>`, func(err error) bool { return err == nil }},
+		{"array", `[{"error":{"code":404}}]`, func(err error) bool { return err == nil }},
+		{"malformed", `{"error":`, func(err error) bool { return err == nil }},
+		{"null error", `{"error":null}`, func(err error) bool { return err == nil }},
+		{"zero code", `{"error":{"code":0,"message":"fine"}}`, func(err error) bool { return err == nil }},
+		{"string error", `{"error":"nope"}`, func(err error) bool { return err == nil }},
+		{"not found", `{"error":{"code":404,"message":"Not Found"}}`, tactify_http.IsNotFound},
+		{"leading whitespace", " \n{\"error\":{\"code\":403}}", tactify_http.IsBlocked},
+		{"rate limited", `{"error":{"code":429,"message":"slow down"}}`, tactify_http.IsRateLimited},
+		{"upstream", `{"error":{"code":500,"message":"Internal"}}`, func(err error) bool {
+			var upstream *tactify_http.UpstreamError
+			return errors.As(err, &upstream) && upstream.Body == "Internal"
+		}},
+	}
+
+	for _, c := range cases {
+		if err := tactify_http.CheckErrorEnvelope("/x", []byte(c.body)); !c.check(err) {
+			t.Fatalf("%s: unexpected error %v", c.name, err)
+		}
+	}
+}
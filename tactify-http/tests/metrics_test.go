@@ -0,0 +1,28 @@
+package tests
+
+import (
+	"testing"
+
+	tactify_http "github.com/imadeddine-belkat/tactify-http"
+)
+
+func TestEndpointLabel(t *testing.T) {
+	cases := []struct {
+		endpoint string
+		want     string
+	}{
+		{"/event/123/live/", "/event/:id/live/"},
+		{"/entry/42/event/3/picks/", "/entry/:id/event/:id/picks/"},
+		{"/bootstrap-static/", "/bootstrap-static/"},
+		{"/leagues-classic/314/standings/?page_standings=2", "/leagues-classic/:id/standings/"},
+		{"/team/17/events/last/0", "/team/:id/events/last/:id"},
+		{"/player/12ab/", "/player/12ab/"},
+		{"", ""},
+	}
+
+	for _, c := range cases {
+		if got := tactify_http.EndpointLabel(c.endpoint); got != c.want {
+			t.Fatalf("%q: got %q, want %q", c.endpoint, got, c.want)
+		}
+	}
+}
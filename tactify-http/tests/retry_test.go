@@ -0,0 +1,162 @@
+package tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	tactify_http "github.com/imadeddine-belkat/tactify-http"
+)
+
+func TestRetryPolicyDo(t *testing.T) {
+	upstream := &tactify_http.UpstreamError{URL: "/x", StatusCode: 502}
+	notFound := &tactify_http.NotFoundError{URL: "/x"}
+
+	cases := []struct {
+		name      string
+		policy    tactify_http.RetryPolicy
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{"success first time", tactify_http.RetryPolicy{MaxAttempts: 3}, []error{nil}, 1, nil},
+		{"retries 5xx until success", tactify_http.RetryPolicy{MaxAttempts: 3}, []error{upstream, upstream, nil}, 3, nil},
+		{"gives up after max attempts", tactify_http.RetryPolicy{MaxAttempts: 2}, []error{upstream, upstream, nil}, 2, upstream},
+		{"does not retry 404", tactify_http.RetryPolicy{MaxAttempts: 3}, []error{notFound, nil}, 1, notFound},
+		{"no retry policy", tactify_http.NoRetry, []error{upstream, nil}, 1, upstream},
+		{"zero attempts still runs once", tactify_http.RetryPolicy{}, []error{upstream, nil}, 1, upstream},
+		{"custom RetryOn", tactify_http.RetryPolicy{MaxAttempts: 3, RetryOn: tactify_http.IsNotFound}, []error{notFound, nil}, 2, nil},
+	}
+
+	for _, c := range cases {
+		calls := 0
+		err := c.policy.Do(context.Background(), func(ctx context.Context) error {
+			calls++
+			return c.errs[calls-1]
+		})
+		if calls != c.wantCalls {
+			t.Fatalf("%s: got %d calls, want %d", c.name, calls, c.wantCalls)
+		}
+		if err != c.wantErr {
+			t.Fatalf("%s: got error %v, want %v", c.name, err, c.wantErr)
+		}
+	}
+}
+
+func TestRetryPolicyDoStopsWhenContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	policy := tactify_http.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour, MaxDelay: time.Hour}
+	upstream := &tactify_http.UpstreamError{URL: "/x", StatusCode: 503}
+
+	calls := 0
+	err := policy.Do(ctx, func(ctx context.Context) error {
+		calls++
+		cancel()
+		return upstream
+	})
+
+	if calls != 1 || err != upstream {
+		t.Fatalf("got %d calls and %v, want 1 call and the upstream error", calls, err)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := tactify_http.RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	upstream := &tactify_http.UpstreamError{URL: "/x", StatusCode: 500}
+
+	cases := []struct {
+		name    string
+		policy  tactify_http.RetryPolicy
+		attempt int
+		err     error
+		ceiling time.Duration
+		exact   bool
+	}{
+		{"first retry", policy, 1, upstream, 100 * time.Millisecond, false},
+		{"doubles per attempt", policy, 3, upstream, 400 * time.Millisecond, false},
+		{"capped by MaxDelay", policy, 10, upstream, time.Second, false},
+		{"no overflow on large attempts", policy, 80, upstream, time.Second, false},
+		{"no base delay", tactify_http.RetryPolicy{}, 2, upstream, 0, true},
+		{"Retry-After wins", policy, 1, &tactify_http.RateLimitedError{RetryAfter: 700 * time.Millisecond}, 700 * time.Millisecond, true},
+		{"Retry-After capped by MaxDelay", policy, 1, &tactify_http.RateLimitedError{RetryAfter: time.Minute}, time.Second, true},
+		{"rate limit without Retry-After", policy, 2, &tactify_http.RateLimitedError{}, 200 * time.Millisecond, false},
+	}
+
+	for _, c := range cases {
+		for range 50 {
+			d := c.policy.Backoff(c.attempt, c.err)
+			if c.exact && d != c.ceiling {
+				t.Fatalf("%s: got %s, want %s", c.name, d, c.ceiling)
+			}
+			if d < 0 || d > c.ceiling {
+				t.Fatalf("%s: got %s, want within [0, %s]", c.name, d, c.ceiling)
+			}
+		}
+	}
+}
+
+func TestRetryable(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"rate limited", &tactify_http.RateLimitedError{}, true},
+		{"upstream", &tactify_http.UpstreamError{StatusCode: 502}, true},
+		{"wrapped upstream", fmt.Errorf("fetching: %w", &tactify_http.UpstreamError{StatusCode: 502}), true},
+		{"not found", &tactify_http.NotFoundError{}, false},
+		{"blocked", &tactify_http.BlockedError{StatusCode: 403}, false},
+		{"decode", &tactify_http.DecodeError{Err: errors.New("bad json")}, false},
+		{"request timeout", &tactify_http.StatusError{StatusCode: 408}, true},
+		{"bad request", &tactify_http.StatusError{StatusCode: 400}, false},
+		{"canceled", context.Canceled, false},
+		{"deadline", fmt.Errorf("fetching: %w", context.DeadlineExceeded), false},
+		{"transport", errors.New("connection reset by peer"), true},
+	}
+
+	for _, c := range cases {
+		if got := tactify_http.Retryable(c.err); got != c.want {
+			t.Fatalf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
+
+func TestRetryPoliciesFor(t *testing.T) {
+	lineups := tactify_http.RetryPolicy{MaxAttempts: 5}
+	live := tactify_http.RetryPolicy{MaxAttempts: 7}
+	picks := tactify_http.RetryPolicy{MaxAttempts: 2}
+
+	policies := tactify_http.NewRetryPolicies(tactify_http.NoRetry)
+	policies.Set("/event/%d/lineups", lineups)
+	policies.Set("/event/%d/live/", live)
+	policies.Set("/entry/%d/event/%d/picks/", picks)
+
+	cases := []struct {
+		endpoint string
+		want     int
+	}{
+		{"/event/123/lineups", 5},
+		{"/event/123/lineups?lang=en", 5},
+		{"/event/12/live/", 7},
+		{"/entry/42/event/3/picks/", 2},
+		{"/event//lineups", 1},
+		{"/event/1/2/lineups", 1},
+		{"/event/123/lineups/extra", 1},
+		{"/event/123", 1},
+		{"/bootstrap-static/", 1},
+	}
+
+	for _, c := range cases {
+		if got := policies.For(c.endpoint).MaxAttempts; got != c.want {
+			t.Fatalf("%s: got %d attempts, want %d", c.endpoint, got, c.want)
+		}
+	}
+
+	var unset *tactify_http.RetryPolicies
+	if got := unset.For("/event/1/lineups"); got.MaxAttempts != tactify_http.DefaultRetryPolicy.MaxAttempts {
+		t.Fatalf("nil policies should use the default, got %+v", got)
+	}
+}
@@ -0,0 +1,36 @@
+package tactify_http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/imadeddine-belkat/tactify-http")
+
+// StartRequest starts the span of one attempt against an upstream API. It is
+// named after the endpoint's shape, like the metrics, and ended by the caller
+// with tracing.End.
+func StartRequest(ctx context.Context, client, method, endpoint, url string) (context.Context, trace.Span) {
+	return tracer.Start(ctx, fmt.Sprintf("%s %s", method, EndpointLabel(endpoint)),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("tactify.api.client", client),
+			attribute.String("http.request.method", method),
+			attribute.String("url.full", url),
+		),
+	)
+}
+
+// RecordStatus records the status code of a received response on the span of
+// its attempt. Failures are recorded when the caller ends the span with
+// tracing.End.
+func RecordStatus(span trace.Span, resp *http.Response) {
+	if resp != nil {
+		span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	}
+}
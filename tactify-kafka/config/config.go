@@ -42,12 +42,16 @@ type Topics struct {
 	// FPL Core Data TopicsRetention
 	FplPlayersBootstrap      Topic `yaml:"fpl_players_bootstrap"`
 	FplPlayersStats          Topic `yaml:"fpl_players_stats"`
+	FplPlayerAvailability    Topic `yaml:"fpl_player_availability"`
+	FplBootstrapSnapshots    Topic `yaml:"fpl_bootstrap_snapshots"`
 	FplPlayerMatchStats      Topic `yaml:"fpl_player_match_history_stats"`
 	FplPlayerHistoryStats    Topic `yaml:"fpl_player_past_history_stats"`
 	FplTeams                 Topic `yaml:"fpl_teams"`
 	FplFixtures              Topic `yaml:"fpl_fixtures"`
 	FplFixtureDetails        Topic `yaml:"fpl_fixture_details"`
 	FplLiveEvent             Topic `yaml:"fpl_live_event"`
+	FplBonusCorrections      Topic `yaml:"fpl_bonus_corrections"`
+	FplGoalProbabilities     Topic `yaml:"fpl_fixture_goal_probabilities"`
 	FplEntry                 Topic `yaml:"fpl_entry"`
 	FplEntryHistory          Topic `yaml:"fpl_entry_history"`
 	FplEntryTransfers        Topic `yaml:"fpl_entry_transfers"`
@@ -62,6 +66,7 @@ type Topics struct {
 	SofascoreLeagueRoundMatches Topic `yaml:"sofascore_league_round_matches"`
 	SofascoreMatchLineups       Topic `yaml:"sofascore_match_lineups"`
 	SofascoreMatchH2hHistory    Topic `yaml:"sofascore_match_h2h_history"`
+	SofascoreMatchBestPlayers   Topic `yaml:"sofascore_match_best_players"`
 	SofascoreTopTeamsStats      Topic `yaml:"sofascore_top_teams_stats"`
 	SofascoreTeamOverallStats   Topic `yaml:"sofascore_team_overall_stats"`
 	SofascoreTeamMatchStats     Topic `yaml:"sofascore_team_match_stats"`
@@ -97,17 +102,24 @@ type ConsumersGroupID struct {
 	FplEntriesPicks           string `envconfig:"CONSUMERSGROUPID_FPL_ENTRY_PICKS"`
 	FplLeaguesClassicStanding string `envconfig:"CONSUMERSGROUPID_FPL_LEAGUES_CLASSIC_STANDING"`
 	FplLeaguesH2hStanding     string `envconfig:"CONSUMERSGROUPID_FPL_LEAGUES_H2H_STANDING"`
+	FplSnapshots              string `envconfig:"CONSUMERSGROUPID_FPL_BOOTSTRAP_SNAPSHOTS"`
 
 	SofascoreLeagueStanding     string `envconfig:"CONSUMERSGROUPID_SOFASCORE_LEAGUE_STANDINGS"`
 	SofascoreLeagueRoundMatches string `envconfig:"CONSUMERSGROUPID_SOFASCORE_LEAGUE_ROUND_MATCHES"`
 	SofascoreMatchLineups       string `envconfig:"CONSUMERSGROUPID_SOFASCORE_MATCH_LINEUPS"`
 	SofascoreMatchH2hHistory    string `envconfig:"CONSUMERSGROUPID_SOFASCORE_MATCH_H2H_HISTORY"`
+	SofascoreMatchBestPlayers   string `envconfig:"CONSUMERSGROUPID_SOFASCORE_MATCH_BEST_PLAYERS"`
 	SofascoreTeamOverallStats   string `envconfig:"CONSUMERSGROUPID_SOFASCORE_TEAM_OVERALL_STATS"`
+	SofascoreTopTeamsStats      string `envconfig:"CONSUMERSGROUPID_SOFASCORE_TOP_TEAMS_STATS"`
 	SofascoreTeamMatchStats     string `envconfig:"CONSUMERSGROUPID_SOFASCORE_TEAM_MATCH_STATS"`
 	SofascorePlayerTeamStats    string `envconfig:"CONSUMERSGROUPID_SOFASCORE_PLAYER_TEAM_STATS"`
 	SofascorePlayerInfo         string `envconfig:"CONSUMERSGROUPID_SOFASCORE_PLAYER_INFO"`
 	SofascorePlayerSeasonsStats string `envconfig:"CONSUMERSGROUPID_SOFASCORE_PLAYER_SEASONS_STATS"`
 	SofascorePlayerAttributes   string `envconfig:"CONSUMERSGROUPID_SOFASCORE_PLAYER_ATTRIBUTES"`
+	SofascorePlayerMatchStats   string `envconfig:"CONSUMERSGROUPID_SOFASCORE_PLAYER_MATCH_STATS"`
+
+	// read-service keeps its own view of the live topic
+	ReadLive string `envconfig:"CONSUMERSGROUPID_READ_LIVE_EVENT"`
 
 	Test string `envconfig:"CONSUMERSGROUPID_FPL_TEST"`
 }
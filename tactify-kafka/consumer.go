@@ -6,7 +6,10 @@ import (
 	"time"
 
 	"github.com/imadeddine-belkat/tactify-kafka/config"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Consumer struct {
@@ -51,17 +54,32 @@ func (c *Consumer) Subscribe(ctx context.Context) (<-chan kafka.Message, <-chan
 				}
 				continue
 			}
+			group := c.reader.Config().GroupID
+			observeLag(group, msg)
+
+			// The receive span continues the producer's trace and takes its
+			// place in the headers, so work done on the message downstream
+			// (see ContextFromMessage) nests under it.
+			msgCtx, span := tracer.Start(ContextFromMessage(ctx, msg), "receive "+msg.Topic,
+				trace.WithSpanKind(trace.SpanKindConsumer),
+				trace.WithAttributes(messageAttributes(msg)...),
+				trace.WithAttributes(attribute.String("messaging.consumer.group.name", group)),
+			)
+			injectContext(msgCtx, &msg)
 
 			select {
 			case messages <- msg:
 				// Auto-commit the message after successful delivery to channel
-				if err := c.reader.CommitMessages(ctx, msg); err != nil {
+				err := c.reader.CommitMessages(ctx, msg)
+				if err != nil {
 					select {
 					case errors <- fmt.Errorf("committing message: %w", err):
 					default:
 					}
 				}
+				tracing.End(span, err)
 			case <-ctx.Done():
+				span.End()
 				return
 			}
 		}
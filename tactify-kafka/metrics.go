@@ -0,0 +1,40 @@
+package tactify_kafka
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/segmentio/kafka-go"
+)
+
+var (
+	messagesPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "tactify",
+		Subsystem: "kafka",
+		Name:      "messages_published_total",
+		Help:      "Messages published, by topic and result (success or failure).",
+	}, []string{"topic", "result"})
+
+	consumerLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "tactify",
+		Subsystem: "kafka",
+		Name:      "consumer_lag",
+		Help:      "Messages behind the end of the partition as of the last fetch, by topic, group and partition.",
+	}, []string{"topic", "group", "partition"})
+)
+
+func observePublish(topic string, err error) {
+	result := "success"
+	if err != nil {
+		result = "failure"
+	}
+	messagesPublished.WithLabelValues(topic, result).Inc()
+}
+
+// observeLag records how far behind a fetched message the partition's high
+// water mark is.
+func observeLag(group string, msg kafka.Message) {
+	lag := max(msg.HighWaterMark-msg.Offset-1, 0)
+	consumerLag.WithLabelValues(msg.Topic, group, strconv.Itoa(msg.Partition)).Set(float64(lag))
+}
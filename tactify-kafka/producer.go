@@ -7,6 +7,7 @@ import (
 	"time"
 
 	kafkaConfig "github.com/imadeddine-belkat/tactify-kafka/config"
+	tracing "github.com/imadeddine-belkat/tactify-tracing"
 	"github.com/segmentio/kafka-go"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -47,12 +48,12 @@ func (p *Producer) Publish(ctx context.Context, topic string, key, value []byte)
 	if topic == "" {
 		return fmt.Errorf("kafka topic is empty")
 	}
-	return p.writer.WriteMessages(ctx, kafka.Message{
-		Topic: topic,
-		Key:   key,
-		Value: value,
-		Time:  time.Now(),
-	})
+	ctx, msg, span := startPublish(ctx, topic, key, value)
+
+	err := p.writer.WriteMessages(ctx, msg)
+	observePublish(topic, err)
+	tracing.End(span, err)
+	return err
 }
 
 func (p *Producer) Close() error {
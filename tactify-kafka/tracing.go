@@ -0,0 +1,89 @@
+package tactify_kafka
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/imadeddine-belkat/tactify-kafka")
+
+// headerCarrier adapts kafka message headers to the propagation API, so that
+// the W3C traceparent and tracestate travel with each message.
+type headerCarrier struct {
+	headers *[]kafka.Header
+}
+
+func (c headerCarrier) Get(key string) string {
+	for _, h := range *c.headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c headerCarrier) Set(key, value string) {
+	for i, h := range *c.headers {
+		if h.Key == key {
+			(*c.headers)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c.headers = append(*c.headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c.headers))
+	for _, h := range *c.headers {
+		keys = append(keys, h.Key)
+	}
+	return keys
+}
+
+// ContextFromMessage returns ctx carrying the trace context of msg, so that
+// work done on a consumed message joins the trace it was published in.
+func ContextFromMessage(ctx context.Context, msg kafka.Message) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, headerCarrier{&msg.Headers})
+}
+
+// startPublish builds the message for a publish and starts its span, whose
+// context the message carries to consumers.
+func startPublish(ctx context.Context, topic string, key, value []byte) (context.Context, kafka.Message, trace.Span) {
+	msg := kafka.Message{
+		Topic: topic,
+		Key:   key,
+		Value: value,
+		Time:  time.Now(),
+	}
+
+	ctx, span := tracer.Start(ctx, "publish "+topic,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination.name", topic),
+			attribute.String("messaging.kafka.message.key", string(key)),
+		),
+	)
+	injectContext(ctx, &msg)
+	return ctx, msg, span
+}
+
+func injectContext(ctx context.Context, msg *kafka.Message) {
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{&msg.Headers})
+}
+
+func messageAttributes(msg kafka.Message) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("messaging.system", "kafka"),
+		attribute.String("messaging.destination.name", msg.Topic),
+		attribute.String("messaging.kafka.message.key", string(msg.Key)),
+		attribute.Int("messaging.destination.partition.id", msg.Partition),
+		attribute.String("messaging.kafka.offset", strconv.FormatInt(msg.Offset, 10)),
+	}
+}
@@ -0,0 +1,75 @@
+package tactify_kafka
+
+import (
+	"context"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTracing(t *testing.T) {
+	t.Helper()
+	tp := sdktrace.NewTracerProvider()
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+func TestPublishContextRoundTrip(t *testing.T) {
+	setupTracing(t)
+
+	_, msg, span := startPublish(context.Background(), "fpl.players", []byte("1"), []byte("{}"))
+	span.End()
+
+	got := trace.SpanContextFromContext(ContextFromMessage(context.Background(), msg))
+	want := span.SpanContext()
+	if !got.IsRemote() || got.TraceID() != want.TraceID() || got.SpanID() != want.SpanID() {
+		t.Fatalf("got span context %v, want the publish span %v", got, want)
+	}
+}
+
+func TestHeaderCarrier(t *testing.T) {
+	headers := []kafka.Header{{Key: "source", Value: []byte("fpl")}}
+	carrier := headerCarrier{&headers}
+
+	carrier.Set("traceparent", "a")
+	carrier.Set("traceparent", "b")
+
+	if len(headers) != 2 {
+		t.Fatalf("setting a key twice should replace it, got %v", headers)
+	}
+	if got := carrier.Get("traceparent"); got != "b" {
+		t.Fatalf("got %q, want %q", got, "b")
+	}
+	if got := carrier.Get("tracestate"); got != "" {
+		t.Fatalf("missing key: got %q, want empty", got)
+	}
+	if keys := carrier.Keys(); len(keys) != 2 || keys[0] != "source" || keys[1] != "traceparent" {
+		t.Fatalf("got keys %v", keys)
+	}
+}
+
+func TestReceiveSpanReplacesTraceparent(t *testing.T) {
+	setupTracing(t)
+
+	ctx, msg, publish := startPublish(context.Background(), "fpl.players", nil, nil)
+	defer publish.End()
+
+	// The consumer injects its receive span into headers already carrying
+	// the producer's traceparent.
+	receiveCtx, receive := tracer.Start(ContextFromMessage(ctx, msg), "receive fpl.players")
+	defer receive.End()
+	injectContext(receiveCtx, &msg)
+
+	got := trace.SpanContextFromContext(ContextFromMessage(context.Background(), msg))
+	if got.SpanID() != receive.SpanContext().SpanID() || got.TraceID() != publish.SpanContext().TraceID() {
+		t.Fatalf("got %v, want the receive span in the publish trace", got)
+	}
+	if n := len(headerCarrier{&msg.Headers}.Keys()); n != 1 {
+		t.Fatalf("got %d headers, want a single traceparent", n)
+	}
+}
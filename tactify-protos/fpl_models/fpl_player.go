@@ -19,6 +19,11 @@ type PlayerBootstrap struct {
 	ElementType int    `json:"element_type" db:"element_type_id"`
 	Status      string `json:"status" db:"status"`
 
+	News                     string  `json:"news" db:"news"`
+	NewsAdded                *string `json:"news_added" db:"news_added"`
+	ChanceOfPlayingThisRound *int    `json:"chance_of_playing_this_round" db:"chance_of_playing_this_round"`
+	ChanceOfPlayingNextRound *int    `json:"chance_of_playing_next_round" db:"chance_of_playing_next_round"`
+
 	CanTransact bool `json:"can_transact" db:"can_transact"`
 	CanSelect   bool `json:"can_select" db:"can_select"`
 	InDreamteam bool `json:"in_dreamteam" db:"in_dreamteam"`
@@ -32,6 +37,11 @@ type PlayerBootstrap struct {
 	CostChangeStart     int `json:"cost_change_start" db:"cost_change_start"`
 	CostChangeStartFall int `json:"cost_change_start_fall" db:"cost_change_start_fall"`
 
+	TransfersIn       int `json:"transfers_in" db:"transfers_in"`
+	TransfersOut      int `json:"transfers_out" db:"transfers_out"`
+	TransfersInEvent  int `json:"transfers_in_event" db:"transfers_in_event"`
+	TransfersOutEvent int `json:"transfers_out_event" db:"transfers_out_event"`
+
 	DreamteamCount int `json:"dreamteam_count" db:"dreamteam_count"`
 
 	TotalPoints       int    `json:"total_points" db:"total_points"`
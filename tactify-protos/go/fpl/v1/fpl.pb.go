@@ -3241,6 +3241,14 @@ type PlayerBootstrap struct {
 	PointsPerGameRankType          int32                  `protobuf:"varint,83,opt,name=points_per_game_rank_type,json=pointsPerGameRankType,proto3" json:"points_per_game_rank_type,omitempty"`
 	SelectedRank                   int32                  `protobuf:"varint,84,opt,name=selected_rank,json=selectedRank,proto3" json:"selected_rank,omitempty"`
 	SelectedRankType               int32                  `protobuf:"varint,85,opt,name=selected_rank_type,json=selectedRankType,proto3" json:"selected_rank_type,omitempty"`
+	TransfersIn                    int32                  `protobuf:"varint,86,opt,name=transfers_in,json=transfersIn,proto3" json:"transfers_in,omitempty"`
+	TransfersOut                   int32                  `protobuf:"varint,87,opt,name=transfers_out,json=transfersOut,proto3" json:"transfers_out,omitempty"`
+	TransfersInEvent               int32                  `protobuf:"varint,88,opt,name=transfers_in_event,json=transfersInEvent,proto3" json:"transfers_in_event,omitempty"`
+	TransfersOutEvent              int32                  `protobuf:"varint,89,opt,name=transfers_out_event,json=transfersOutEvent,proto3" json:"transfers_out_event,omitempty"`
+	News                           string                 `protobuf:"bytes,90,opt,name=news,proto3" json:"news,omitempty"`
+	NewsAdded                      string                 `protobuf:"bytes,91,opt,name=news_added,json=newsAdded,proto3" json:"news_added,omitempty"`
+	ChanceOfPlayingThisRound       *int32                 `protobuf:"varint,92,opt,name=chance_of_playing_this_round,json=chanceOfPlayingThisRound,proto3,oneof" json:"chance_of_playing_this_round,omitempty"`
+	ChanceOfPlayingNextRound       *int32                 `protobuf:"varint,93,opt,name=chance_of_playing_next_round,json=chanceOfPlayingNextRound,proto3,oneof" json:"chance_of_playing_next_round,omitempty"`
 	unknownFields                  protoimpl.UnknownFields
 	sizeCache                      protoimpl.SizeCache
 }
@@ -3870,6 +3878,62 @@ func (x *PlayerBootstrap) GetSelectedRankType() int32 {
 	return 0
 }
 
+func (x *PlayerBootstrap) GetTransfersIn() int32 {
+	if x != nil {
+		return x.TransfersIn
+	}
+	return 0
+}
+
+func (x *PlayerBootstrap) GetTransfersOut() int32 {
+	if x != nil {
+		return x.TransfersOut
+	}
+	return 0
+}
+
+func (x *PlayerBootstrap) GetTransfersInEvent() int32 {
+	if x != nil {
+		return x.TransfersInEvent
+	}
+	return 0
+}
+
+func (x *PlayerBootstrap) GetTransfersOutEvent() int32 {
+	if x != nil {
+		return x.TransfersOutEvent
+	}
+	return 0
+}
+
+func (x *PlayerBootstrap) GetNews() string {
+	if x != nil {
+		return x.News
+	}
+	return ""
+}
+
+func (x *PlayerBootstrap) GetNewsAdded() string {
+	if x != nil {
+		return x.NewsAdded
+	}
+	return ""
+}
+
+func (x *PlayerBootstrap) GetChanceOfPlayingThisRound() int32 {
+	if x != nil && x.ChanceOfPlayingThisRound != nil {
+		return *x.ChanceOfPlayingThisRound
+	}
+	return 0
+}
+
+func (x *PlayerBootstrap) GetChanceOfPlayingNextRound() int32 {
+	if x != nil && x.ChanceOfPlayingNextRound != nil {
+		return *x.ChanceOfPlayingNextRound
+	}
+	return 0
+}
+
 type PlayersBootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Elements      []*PlayerBootstrap     `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements,omitempty"`
@@ -4922,6 +4986,467 @@ func (x *LiveEventMessage) GetModified() bool {
 	return false
 }
 
+// Published by the indexer when FPL confirms a fixture's bonus and it differs
+// from the provisional bonus computed from live BPS.
+type BonusCorrectionMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId         int32                  `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	Event            int32                  `protobuf:"varint,2,opt,name=event,proto3" json:"event,omitempty"`
+	FixtureId        int32                  `protobuf:"varint,3,opt,name=fixture_id,json=fixtureId,proto3" json:"fixture_id,omitempty"`
+	PlayerId         int32                  `protobuf:"varint,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Bps              int32                  `protobuf:"varint,5,opt,name=bps,proto3" json:"bps,omitempty"`
+	ProvisionalBonus int32                  `protobuf:"varint,6,opt,name=provisional_bonus,json=provisionalBonus,proto3" json:"provisional_bonus,omitempty"`
+	ConfirmedBonus   int32                  `protobuf:"varint,7,opt,name=confirmed_bonus,json=confirmedBonus,proto3" json:"confirmed_bonus,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BonusCorrectionMessage) Reset() {
+	*x = BonusCorrectionMessage{}
+	mi := &file_fpl_v1_fpl_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BonusCorrectionMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BonusCorrectionMessage) ProtoMessage() {}
+
+func (x *BonusCorrectionMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_fpl_v1_fpl_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BonusCorrectionMessage.ProtoReflect.Descriptor instead.
+func (*BonusCorrectionMessage) Descriptor() ([]byte, []int) {
+	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BonusCorrectionMessage) GetSeasonId() int32 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *BonusCorrectionMessage) GetEvent() int32 {
+	if x != nil {
+		return x.Event
+	}
+	return 0
+}
+
+func (x *BonusCorrectionMessage) GetFixtureId() int32 {
+	if x != nil {
+		return x.FixtureId
+	}
+	return 0
+}
+
+func (x *BonusCorrectionMessage) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *BonusCorrectionMessage) GetBps() int32 {
+	if x != nil {
+		return x.Bps
+	}
+	return 0
+}
+
+func (x *BonusCorrectionMessage) GetProvisionalBonus() int32 {
+	if x != nil {
+		return x.ProvisionalBonus
+	}
+	return 0
+}
+
+func (x *BonusCorrectionMessage) GetConfirmedBonus() int32 {
+	if x != nil {
+		return x.ConfirmedBonus
+	}
+	return 0
+}
+
+// Published by fpl-service just before each gameweek deadline: the raw
+// bootstrap-static response, gzipped. format_version numbers the encoding of
+// payload; sha256 is of the uncompressed response.
+type BootstrapSnapshotMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      int32                  `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	Event         int32                  `protobuf:"varint,2,opt,name=event,proto3" json:"event,omitempty"`
+	DeadlineTime  string                 `protobuf:"bytes,3,opt,name=deadline_time,json=deadlineTime,proto3" json:"deadline_time,omitempty"`
+	CapturedAt    string                 `protobuf:"bytes,4,opt,name=captured_at,json=capturedAt,proto3" json:"captured_at,omitempty"`
+	FormatVersion int32                  `protobuf:"varint,5,opt,name=format_version,json=formatVersion,proto3" json:"format_version,omitempty"`
+	Sha256        string                 `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BootstrapSnapshotMessage) Reset() {
+	*x = BootstrapSnapshotMessage{}
+	mi := &file_fpl_v1_fpl_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BootstrapSnapshotMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BootstrapSnapshotMessage) ProtoMessage() {}
+
+func (x *BootstrapSnapshotMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_fpl_v1_fpl_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BootstrapSnapshotMessage.ProtoReflect.Descriptor instead.
+func (*BootstrapSnapshotMessage) Descriptor() ([]byte, []int) {
+	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *BootstrapSnapshotMessage) GetSeasonId() int32 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *BootstrapSnapshotMessage) GetEvent() int32 {
+	if x != nil {
+		return x.Event
+	}
+	return 0
+}
+
+func (x *BootstrapSnapshotMessage) GetDeadlineTime() string {
+	if x != nil {
+		return x.DeadlineTime
+	}
+	return ""
+}
+
+func (x *BootstrapSnapshotMessage) GetCapturedAt() string {
+	if x != nil {
+		return x.CapturedAt
+	}
+	return ""
+}
+
+func (x *BootstrapSnapshotMessage) GetFormatVersion() int32 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *BootstrapSnapshotMessage) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *BootstrapSnapshotMessage) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// Published by the indexer when a player's status, news or chance of playing
+// next round changes. Chances are unset when FPL has no doubt about the player.
+type PlayerAvailabilityChangeMessage struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId                    int32                  `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	PlayerId                    int32                  `protobuf:"varint,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	OldStatus                   string                 `protobuf:"bytes,3,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus                   string                 `protobuf:"bytes,4,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	OldNews                     string                 `protobuf:"bytes,5,opt,name=old_news,json=oldNews,proto3" json:"old_news,omitempty"`
+	News                        string                 `protobuf:"bytes,6,opt,name=news,proto3" json:"news,omitempty"`
+	NewsAdded                   string                 `protobuf:"bytes,7,opt,name=news_added,json=newsAdded,proto3" json:"news_added,omitempty"`
+	OldChanceOfPlayingNextRound *int32                 `protobuf:"varint,8,opt,name=old_chance_of_playing_next_round,json=oldChanceOfPlayingNextRound,proto3,oneof" json:"old_chance_of_playing_next_round,omitempty"`
+	ChanceOfPlayingNextRound    *int32                 `protobuf:"varint,9,opt,name=chance_of_playing_next_round,json=chanceOfPlayingNextRound,proto3,oneof" json:"chance_of_playing_next_round,omitempty"`
+	ChangedAt                   string                 `protobuf:"bytes,10,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *PlayerAvailabilityChangeMessage) Reset() {
+	*x = PlayerAvailabilityChangeMessage{}
+	mi := &file_fpl_v1_fpl_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerAvailabilityChangeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAvailabilityChangeMessage) ProtoMessage() {}
+
+func (x *PlayerAvailabilityChangeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_fpl_v1_fpl_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAvailabilityChangeMessage.ProtoReflect.Descriptor instead.
+func (*PlayerAvailabilityChangeMessage) Descriptor() ([]byte, []int) {
+	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetSeasonId() int32 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetOldStatus() string {
+	if x != nil {
+		return x.OldStatus
+	}
+	return ""
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetOldNews() string {
+	if x != nil {
+		return x.OldNews
+	}
+	return ""
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetNews() string {
+	if x != nil {
+		return x.News
+	}
+	return ""
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetNewsAdded() string {
+	if x != nil {
+		return x.NewsAdded
+	}
+	return ""
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetOldChanceOfPlayingNextRound() int32 {
+	if x != nil && x.OldChanceOfPlayingNextRound != nil {
+		return *x.OldChanceOfPlayingNextRound
+	}
+	return 0
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetChanceOfPlayingNextRound() int32 {
+	if x != nil && x.ChanceOfPlayingNextRound != nil {
+		return *x.ChanceOfPlayingNextRound
+	}
+	return 0
+}
+
+func (x *PlayerAvailabilityChangeMessage) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+// Published by analytics with each fixture's goal probabilities for both
+// sides. goals holds P(0), P(1), P(2) and P(3 or more).
+type FixtureGoalProbabilitiesMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      int32                  `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	FixtureId     int32                  `protobuf:"varint,2,opt,name=fixture_id,json=fixtureId,proto3" json:"fixture_id,omitempty"`
+	Event         int32                  `protobuf:"varint,3,opt,name=event,proto3" json:"event,omitempty"`
+	ModelVersion  string                 `protobuf:"bytes,4,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
+	Home          *TeamGoalProbabilities `protobuf:"bytes,5,opt,name=home,proto3" json:"home,omitempty"`
+	Away          *TeamGoalProbabilities `protobuf:"bytes,6,opt,name=away,proto3" json:"away,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FixtureGoalProbabilitiesMessage) Reset() {
+	*x = FixtureGoalProbabilitiesMessage{}
+	mi := &file_fpl_v1_fpl_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FixtureGoalProbabilitiesMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixtureGoalProbabilitiesMessage) ProtoMessage() {}
+
+func (x *FixtureGoalProbabilitiesMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_fpl_v1_fpl_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FixtureGoalProbabilitiesMessage.ProtoReflect.Descriptor instead.
+func (*FixtureGoalProbabilitiesMessage) Descriptor() ([]byte, []int) {
+	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FixtureGoalProbabilitiesMessage) GetSeasonId() int32 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *FixtureGoalProbabilitiesMessage) GetFixtureId() int32 {
+	if x != nil {
+		return x.FixtureId
+	}
+	return 0
+}
+
+func (x *FixtureGoalProbabilitiesMessage) GetEvent() int32 {
+	if x != nil {
+		return x.Event
+	}
+	return 0
+}
+
+func (x *FixtureGoalProbabilitiesMessage) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
+
+func (x *FixtureGoalProbabilitiesMessage) GetHome() *TeamGoalProbabilities {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *FixtureGoalProbabilitiesMessage) GetAway() *TeamGoalProbabilities {
+	if x != nil {
+		return x.Away
+	}
+	return nil
+}
+
+type TeamGoalProbabilities struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamId        int32                  `protobuf:"varint,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	ExpectedGoals float64                `protobuf:"fixed64,2,opt,name=expected_goals,json=expectedGoals,proto3" json:"expected_goals,omitempty"`
+	CleanSheet    float64                `protobuf:"fixed64,3,opt,name=clean_sheet,json=cleanSheet,proto3" json:"clean_sheet,omitempty"`
+	Goals         []float64              `protobuf:"fixed64,4,rep,packed,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamGoalProbabilities) Reset() {
+	*x = TeamGoalProbabilities{}
+	mi := &file_fpl_v1_fpl_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamGoalProbabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamGoalProbabilities) ProtoMessage() {}
+
+func (x *TeamGoalProbabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_fpl_v1_fpl_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamGoalProbabilities.ProtoReflect.Descriptor instead.
+func (*TeamGoalProbabilities) Descriptor() ([]byte, []int) {
+	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TeamGoalProbabilities) GetTeamId() int32 {
+	if x != nil {
+		return x.TeamId
+	}
+	return 0
+}
+
+func (x *TeamGoalProbabilities) GetExpectedGoals() float64 {
+	if x != nil {
+		return x.ExpectedGoals
+	}
+	return 0
+}
+
+func (x *TeamGoalProbabilities) GetCleanSheet() float64 {
+	if x != nil {
+		return x.CleanSheet
+	}
+	return 0
+}
+
+func (x *TeamGoalProbabilities) GetGoals() []float64 {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
 type EntryMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Entry         *Entry                 `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
@@ -4932,7 +5457,7 @@ type EntryMessage struct {
 
 func (x *EntryMessage) Reset() {
 	*x = EntryMessage{}
-	mi := &file_fpl_v1_fpl_proto_msgTypes[39]
+	mi := &file_fpl_v1_fpl_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4944,7 +5469,7 @@ func (x *EntryMessage) String() string {
 func (*EntryMessage) ProtoMessage() {}
 
 func (x *EntryMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_fpl_v1_fpl_proto_msgTypes[39]
+	mi := &file_fpl_v1_fpl_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4957,7 +5482,7 @@ func (x *EntryMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntryMessage.ProtoReflect.Descriptor instead.
 func (*EntryMessage) Descriptor() ([]byte, []int) {
-	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{39}
+	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *EntryMessage) GetEntry() *Entry {
@@ -4986,7 +5511,7 @@ type EntryEventPicksMessage struct {
 
 func (x *EntryEventPicksMessage) Reset() {
 	*x = EntryEventPicksMessage{}
-	mi := &file_fpl_v1_fpl_proto_msgTypes[40]
+	mi := &file_fpl_v1_fpl_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4998,7 +5523,7 @@ func (x *EntryEventPicksMessage) String() string {
 func (*EntryEventPicksMessage) ProtoMessage() {}
 
 func (x *EntryEventPicksMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_fpl_v1_fpl_proto_msgTypes[40]
+	mi := &file_fpl_v1_fpl_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5011,7 +5536,7 @@ func (x *EntryEventPicksMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntryEventPicksMessage.ProtoReflect.Descriptor instead.
 func (*EntryEventPicksMessage) Descriptor() ([]byte, []int) {
-	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{40}
+	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *EntryEventPicksMessage) GetEntryId() int32 {
@@ -5053,7 +5578,7 @@ type EntryHistoryMessage struct {
 
 func (x *EntryHistoryMessage) Reset() {
 	*x = EntryHistoryMessage{}
-	mi := &file_fpl_v1_fpl_proto_msgTypes[41]
+	mi := &file_fpl_v1_fpl_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5065,7 +5590,7 @@ func (x *EntryHistoryMessage) String() string {
 func (*EntryHistoryMessage) ProtoMessage() {}
 
 func (x *EntryHistoryMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_fpl_v1_fpl_proto_msgTypes[41]
+	mi := &file_fpl_v1_fpl_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5078,7 +5603,7 @@ func (x *EntryHistoryMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntryHistoryMessage.ProtoReflect.Descriptor instead.
 func (*EntryHistoryMessage) Descriptor() ([]byte, []int) {
-	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{41}
+	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *EntryHistoryMessage) GetEntryHistory() *EntryHistory {
@@ -5113,7 +5638,7 @@ type EntryTransfersMessage struct {
 
 func (x *EntryTransfersMessage) Reset() {
 	*x = EntryTransfersMessage{}
-	mi := &file_fpl_v1_fpl_proto_msgTypes[42]
+	mi := &file_fpl_v1_fpl_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5125,7 +5650,7 @@ func (x *EntryTransfersMessage) String() string {
 func (*EntryTransfersMessage) ProtoMessage() {}
 
 func (x *EntryTransfersMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_fpl_v1_fpl_proto_msgTypes[42]
+	mi := &file_fpl_v1_fpl_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5138,7 +5663,7 @@ func (x *EntryTransfersMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntryTransfersMessage.ProtoReflect.Descriptor instead.
 func (*EntryTransfersMessage) Descriptor() ([]byte, []int) {
-	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{42}
+	return file_fpl_v1_fpl_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *EntryTransfersMessage) GetEntryId() int32 {
@@ -5536,7 +6061,7 @@ const file_fpl_v1_fpl_proto_rawDesc = "" +
 	"\x04time\x18\a \x01(\tR\x04time\"w\n" +
 	"\x06Player\x12/\n" +
 	"\ahistory\x18\x01 \x03(\v2\x15.fpl.v1.PlayerHistoryR\ahistory\x12<\n" +
-	"\fhistory_past\x18\x02 \x03(\v2\x19.fpl.v1.PlayerPastHistoryR\vhistoryPast\"\x9d\x19\n" +
+	"\fhistory_past\x18\x02 \x03(\v2\x19.fpl.v1.PlayerPastHistoryR\vhistoryPast\"\xc2\x1c\n" +
 	"\x0fPlayerBootstrap\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\x05R\x04code\x12\x1d\n" +
@@ -5634,8 +6159,19 @@ const file_fpl_v1_fpl_proto_rawDesc = "" +
 	"\x14points_per_game_rank\x18R \x01(\x05R\x11pointsPerGameRank\x128\n" +
 	"\x19points_per_game_rank_type\x18S \x01(\x05R\x15pointsPerGameRankType\x12#\n" +
 	"\rselected_rank\x18T \x01(\x05R\fselectedRank\x12,\n" +
-	"\x12selected_rank_type\x18U \x01(\x05R\x10selectedRankTypeB\x0f\n" +
-	"\r_squad_number\"G\n" +
+	"\x12selected_rank_type\x18U \x01(\x05R\x10selectedRankType\x12!\n" +
+	"\ftransfers_in\x18V \x01(\x05R\vtransfersIn\x12#\n" +
+	"\rtransfers_out\x18W \x01(\x05R\ftransfersOut\x12,\n" +
+	"\x12transfers_in_event\x18X \x01(\x05R\x10transfersInEvent\x12.\n" +
+	"\x13transfers_out_event\x18Y \x01(\x05R\x11transfersOutEvent\x12\x12\n" +
+	"\x04news\x18Z \x01(\tR\x04news\x12\x1d\n" +
+	"\n" +
+	"news_added\x18[ \x01(\tR\tnewsAdded\x12C\n" +
+	"\x1cchance_of_playing_this_round\x18\\ \x01(\x05H\x01R\x18chanceOfPlayingThisRound\x88\x01\x01\x12C\n" +
+	"\x1cchance_of_playing_next_round\x18] \x01(\x05H\x02R\x18chanceOfPlayingNextRound\x88\x01\x01B\x0f\n" +
+	"\r_squad_numberB\x1f\n" +
+	"\x1d_chance_of_playing_this_roundB\x1f\n" +
+	"\x1d_chance_of_playing_next_round\"G\n" +
 	"\x10PlayersBootstrap\x123\n" +
 	"\belements\x18\x01 \x03(\v2\x17.fpl.v1.PlayerBootstrapR\belements\"\x82\v\n" +
 	"\rPlayerHistory\x12\x18\n" +
@@ -5749,7 +6285,57 @@ const file_fpl_v1_fpl_proto_rawDesc = "" +
 	"\tseason_id\x18\x03 \x01(\x05R\bseasonId\x12'\n" +
 	"\x05stats\x18\x04 \x01(\v2\x11.fpl.v1.LiveStatsR\x05stats\x12-\n" +
 	"\aexplain\x18\x05 \x03(\v2\x13.fpl.v1.ExplainItemR\aexplain\x12\x1a\n" +
-	"\bmodified\x18\x06 \x01(\bR\bmodified\"P\n" +
+	"\bmodified\x18\x06 \x01(\bR\bmodified\"\xef\x01\n" +
+	"\x16BonusCorrectionMessage\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\x05R\bseasonId\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\x05R\x05event\x12\x1d\n" +
+	"\n" +
+	"fixture_id\x18\x03 \x01(\x05R\tfixtureId\x12\x1b\n" +
+	"\tplayer_id\x18\x04 \x01(\x05R\bplayerId\x12\x10\n" +
+	"\x03bps\x18\x05 \x01(\x05R\x03bps\x12+\n" +
+	"\x11provisional_bonus\x18\x06 \x01(\x05R\x10provisionalBonus\x12'\n" +
+	"\x0fconfirmed_bonus\x18\a \x01(\x05R\x0econfirmedBonus\"\xec\x01\n" +
+	"\x18BootstrapSnapshotMessage\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\x05R\bseasonId\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\x05R\x05event\x12#\n" +
+	"\rdeadline_time\x18\x03 \x01(\tR\fdeadlineTime\x12\x1f\n" +
+	"\vcaptured_at\x18\x04 \x01(\tR\n" +
+	"capturedAt\x12%\n" +
+	"\x0eformat_version\x18\x05 \x01(\x05R\rformatVersion\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x12\x18\n" +
+	"\apayload\x18\a \x01(\fR\apayload\"\xdd\x03\n" +
+	"\x1fPlayerAvailabilityChangeMessage\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\x05R\bseasonId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\x05R\bplayerId\x12\x1d\n" +
+	"\n" +
+	"old_status\x18\x03 \x01(\tR\toldStatus\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\x04 \x01(\tR\tnewStatus\x12\x19\n" +
+	"\bold_news\x18\x05 \x01(\tR\aoldNews\x12\x12\n" +
+	"\x04news\x18\x06 \x01(\tR\x04news\x12\x1d\n" +
+	"\n" +
+	"news_added\x18\a \x01(\tR\tnewsAdded\x12J\n" +
+	" old_chance_of_playing_next_round\x18\b \x01(\x05H\x00R\x1boldChanceOfPlayingNextRound\x88\x01\x01\x12C\n" +
+	"\x1cchance_of_playing_next_round\x18\t \x01(\x05H\x01R\x18chanceOfPlayingNextRound\x88\x01\x01\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\n" +
+	" \x01(\tR\tchangedAtB#\n" +
+	"!_old_chance_of_playing_next_roundB\x1f\n" +
+	"\x1d_chance_of_playing_next_round\"\xfe\x01\n" +
+	"\x1fFixtureGoalProbabilitiesMessage\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\x05R\bseasonId\x12\x1d\n" +
+	"\n" +
+	"fixture_id\x18\x02 \x01(\x05R\tfixtureId\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\x05R\x05event\x12#\n" +
+	"\rmodel_version\x18\x04 \x01(\tR\fmodelVersion\x121\n" +
+	"\x04home\x18\x05 \x01(\v2\x1d.fpl.v1.TeamGoalProbabilitiesR\x04home\x121\n" +
+	"\x04away\x18\x06 \x01(\v2\x1d.fpl.v1.TeamGoalProbabilitiesR\x04away\"\x8e\x01\n" +
+	"\x15TeamGoalProbabilities\x12\x17\n" +
+	"\ateam_id\x18\x01 \x01(\x05R\x06teamId\x12%\n" +
+	"\x0eexpected_goals\x18\x02 \x01(\x01R\rexpectedGoals\x12\x1f\n" +
+	"\vclean_sheet\x18\x03 \x01(\x01R\n" +
+	"cleanSheet\x12\x14\n" +
+	"\x05goals\x18\x04 \x03(\x01R\x05goals\"P\n" +
 	"\fEntryMessage\x12#\n" +
 	"\x05entry\x18\x01 \x01(\v2\r.fpl.v1.EntryR\x05entry\x12\x1b\n" +
 	"\tseason_id\x18\x02 \x01(\x05R\bseasonId\"\x95\x01\n" +
@@ -5779,62 +6365,67 @@ func file_fpl_v1_fpl_proto_rawDescGZIP() []byte {
 	return file_fpl_v1_fpl_proto_rawDescData
 }
 
-var file_fpl_v1_fpl_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_fpl_v1_fpl_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_fpl_v1_fpl_proto_goTypes = []any{
-	(*BootstrapResponse)(nil),        // 0: fpl.v1.BootstrapResponse
-	(*Team)(nil),                     // 1: fpl.v1.Team
-	(*Chip)(nil),                     // 2: fpl.v1.Chip
-	(*Phase)(nil),                    // 3: fpl.v1.Phase
-	(*ElementStat)(nil),              // 4: fpl.v1.ElementStat
-	(*ElementType)(nil),              // 5: fpl.v1.ElementType
-	(*Fixture)(nil),                  // 6: fpl.v1.Fixture
-	(*FixtureStat)(nil),              // 7: fpl.v1.FixtureStat
-	(*StatElement)(nil),              // 8: fpl.v1.StatElement
-	(*GameSettings)(nil),             // 9: fpl.v1.GameSettings
-	(*Scoring)(nil),                  // 10: fpl.v1.Scoring
-	(*Event)(nil),                    // 11: fpl.v1.Event
-	(*TopElementInfo)(nil),           // 12: fpl.v1.TopElementInfo
-	(*LiveEvent)(nil),                // 13: fpl.v1.LiveEvent
-	(*LiveElement)(nil),              // 14: fpl.v1.LiveElement
-	(*LiveStats)(nil),                // 15: fpl.v1.LiveStats
-	(*ExplainItem)(nil),              // 16: fpl.v1.ExplainItem
-	(*ExplainStatItem)(nil),          // 17: fpl.v1.ExplainStatItem
-	(*Entry)(nil),                    // 18: fpl.v1.Entry
-	(*EntryEventPicks)(nil),          // 19: fpl.v1.EntryEventPicks
-	(*AutomaticSub)(nil),             // 20: fpl.v1.AutomaticSub
-	(*Pick)(nil),                     // 21: fpl.v1.Pick
-	(*EntryHistory)(nil),             // 22: fpl.v1.EntryHistory
-	(*EntryHistoryCurrent)(nil),      // 23: fpl.v1.EntryHistoryCurrent
-	(*EntryHistoryPast)(nil),         // 24: fpl.v1.EntryHistoryPast
-	(*EntryHistoryChip)(nil),         // 25: fpl.v1.EntryHistoryChip
-	(*EntryTransfers)(nil),           // 26: fpl.v1.EntryTransfers
-	(*Transfer)(nil),                 // 27: fpl.v1.Transfer
-	(*Player)(nil),                   // 28: fpl.v1.Player
-	(*PlayerBootstrap)(nil),          // 29: fpl.v1.PlayerBootstrap
-	(*PlayersBootstrap)(nil),         // 30: fpl.v1.PlayersBootstrap
-	(*PlayerHistory)(nil),            // 31: fpl.v1.PlayerHistory
-	(*PlayerPastHistory)(nil),        // 32: fpl.v1.PlayerPastHistory
-	(*TeamMessage)(nil),              // 33: fpl.v1.TeamMessage
-	(*PlayerBootstrapMessage)(nil),   // 34: fpl.v1.PlayerBootstrapMessage
-	(*PlayerHistoryMessage)(nil),     // 35: fpl.v1.PlayerHistoryMessage
-	(*PlayerPastHistoryMessage)(nil), // 36: fpl.v1.PlayerPastHistoryMessage
-	(*FixtureMessage)(nil),           // 37: fpl.v1.FixtureMessage
-	(*LiveEventMessage)(nil),         // 38: fpl.v1.LiveEventMessage
-	(*EntryMessage)(nil),             // 39: fpl.v1.EntryMessage
-	(*EntryEventPicksMessage)(nil),   // 40: fpl.v1.EntryEventPicksMessage
-	(*EntryHistoryMessage)(nil),      // 41: fpl.v1.EntryHistoryMessage
-	(*EntryTransfersMessage)(nil),    // 42: fpl.v1.EntryTransfersMessage
-	nil,                              // 43: fpl.v1.Scoring.GoalsConcededEntry
-	nil,                              // 44: fpl.v1.Scoring.GoalsScoredEntry
-	nil,                              // 45: fpl.v1.Scoring.CleanSheetsEntry
-	nil,                              // 46: fpl.v1.Scoring.DefensiveContributionEntry
-	nil,                              // 47: fpl.v1.Scoring.MngGoalsScoredEntry
-	nil,                              // 48: fpl.v1.Scoring.MngCleanSheetsEntry
-	nil,                              // 49: fpl.v1.Scoring.MngWinEntry
-	nil,                              // 50: fpl.v1.Scoring.MngDrawEntry
-	nil,                              // 51: fpl.v1.Scoring.MngUnderdogWinEntry
-	nil,                              // 52: fpl.v1.Scoring.MngUnderdogDrawEntry
-	(*structpb.Value)(nil),           // 53: google.protobuf.Value
+	(*BootstrapResponse)(nil),               // 0: fpl.v1.BootstrapResponse
+	(*Team)(nil),                            // 1: fpl.v1.Team
+	(*Chip)(nil),                            // 2: fpl.v1.Chip
+	(*Phase)(nil),                           // 3: fpl.v1.Phase
+	(*ElementStat)(nil),                     // 4: fpl.v1.ElementStat
+	(*ElementType)(nil),                     // 5: fpl.v1.ElementType
+	(*Fixture)(nil),                         // 6: fpl.v1.Fixture
+	(*FixtureStat)(nil),                     // 7: fpl.v1.FixtureStat
+	(*StatElement)(nil),                     // 8: fpl.v1.StatElement
+	(*GameSettings)(nil),                    // 9: fpl.v1.GameSettings
+	(*Scoring)(nil),                         // 10: fpl.v1.Scoring
+	(*Event)(nil),                           // 11: fpl.v1.Event
+	(*TopElementInfo)(nil),                  // 12: fpl.v1.TopElementInfo
+	(*LiveEvent)(nil),                       // 13: fpl.v1.LiveEvent
+	(*LiveElement)(nil),                     // 14: fpl.v1.LiveElement
+	(*LiveStats)(nil),                       // 15: fpl.v1.LiveStats
+	(*ExplainItem)(nil),                     // 16: fpl.v1.ExplainItem
+	(*ExplainStatItem)(nil),                 // 17: fpl.v1.ExplainStatItem
+	(*Entry)(nil),                           // 18: fpl.v1.Entry
+	(*EntryEventPicks)(nil),                 // 19: fpl.v1.EntryEventPicks
+	(*AutomaticSub)(nil),                    // 20: fpl.v1.AutomaticSub
+	(*Pick)(nil),                            // 21: fpl.v1.Pick
+	(*EntryHistory)(nil),                    // 22: fpl.v1.EntryHistory
+	(*EntryHistoryCurrent)(nil),             // 23: fpl.v1.EntryHistoryCurrent
+	(*EntryHistoryPast)(nil),                // 24: fpl.v1.EntryHistoryPast
+	(*EntryHistoryChip)(nil),                // 25: fpl.v1.EntryHistoryChip
+	(*EntryTransfers)(nil),                  // 26: fpl.v1.EntryTransfers
+	(*Transfer)(nil),                        // 27: fpl.v1.Transfer
+	(*Player)(nil),                          // 28: fpl.v1.Player
+	(*PlayerBootstrap)(nil),                 // 29: fpl.v1.PlayerBootstrap
+	(*PlayersBootstrap)(nil),                // 30: fpl.v1.PlayersBootstrap
+	(*PlayerHistory)(nil),                   // 31: fpl.v1.PlayerHistory
+	(*PlayerPastHistory)(nil),               // 32: fpl.v1.PlayerPastHistory
+	(*TeamMessage)(nil),                     // 33: fpl.v1.TeamMessage
+	(*PlayerBootstrapMessage)(nil),          // 34: fpl.v1.PlayerBootstrapMessage
+	(*PlayerHistoryMessage)(nil),            // 35: fpl.v1.PlayerHistoryMessage
+	(*PlayerPastHistoryMessage)(nil),        // 36: fpl.v1.PlayerPastHistoryMessage
+	(*FixtureMessage)(nil),                  // 37: fpl.v1.FixtureMessage
+	(*LiveEventMessage)(nil),                // 38: fpl.v1.LiveEventMessage
+	(*BonusCorrectionMessage)(nil),          // 39: fpl.v1.BonusCorrectionMessage
+	(*BootstrapSnapshotMessage)(nil),        // 40: fpl.v1.BootstrapSnapshotMessage
+	(*PlayerAvailabilityChangeMessage)(nil), // 41: fpl.v1.PlayerAvailabilityChangeMessage
+	(*FixtureGoalProbabilitiesMessage)(nil), // 42: fpl.v1.FixtureGoalProbabilitiesMessage
+	(*TeamGoalProbabilities)(nil),           // 43: fpl.v1.TeamGoalProbabilities
+	(*EntryMessage)(nil),                    // 44: fpl.v1.EntryMessage
+	(*EntryEventPicksMessage)(nil),          // 45: fpl.v1.EntryEventPicksMessage
+	(*EntryHistoryMessage)(nil),             // 46: fpl.v1.EntryHistoryMessage
+	(*EntryTransfersMessage)(nil),           // 47: fpl.v1.EntryTransfersMessage
+	nil,                                     // 48: fpl.v1.Scoring.GoalsConcededEntry
+	nil,                                     // 49: fpl.v1.Scoring.GoalsScoredEntry
+	nil,                                     // 50: fpl.v1.Scoring.CleanSheetsEntry
+	nil,                                     // 51: fpl.v1.Scoring.DefensiveContributionEntry
+	nil,                                     // 52: fpl.v1.Scoring.MngGoalsScoredEntry
+	nil,                                     // 53: fpl.v1.Scoring.MngCleanSheetsEntry
+	nil,                                     // 54: fpl.v1.Scoring.MngWinEntry
+	nil,                                     // 55: fpl.v1.Scoring.MngDrawEntry
+	nil,                                     // 56: fpl.v1.Scoring.MngUnderdogWinEntry
+	nil,                                     // 57: fpl.v1.Scoring.MngUnderdogDrawEntry
+	(*structpb.Value)(nil),                  // 58: google.protobuf.Value
 }
 var file_fpl_v1_fpl_proto_depIdxs = []int32{
 	11, // 0: fpl.v1.BootstrapResponse.events:type_name -> fpl.v1.Event
@@ -5847,17 +6438,17 @@ var file_fpl_v1_fpl_proto_depIdxs = []int32{
 	7,  // 7: fpl.v1.Fixture.stats:type_name -> fpl.v1.FixtureStat
 	8,  // 8: fpl.v1.FixtureStat.a:type_name -> fpl.v1.StatElement
 	8,  // 9: fpl.v1.FixtureStat.h:type_name -> fpl.v1.StatElement
-	53, // 10: fpl.v1.GameSettings.ui_special_shirt_exclusions:type_name -> google.protobuf.Value
-	43, // 11: fpl.v1.Scoring.goals_conceded:type_name -> fpl.v1.Scoring.GoalsConcededEntry
-	44, // 12: fpl.v1.Scoring.goals_scored:type_name -> fpl.v1.Scoring.GoalsScoredEntry
-	45, // 13: fpl.v1.Scoring.clean_sheets:type_name -> fpl.v1.Scoring.CleanSheetsEntry
-	46, // 14: fpl.v1.Scoring.defensive_contribution:type_name -> fpl.v1.Scoring.DefensiveContributionEntry
-	47, // 15: fpl.v1.Scoring.mng_goals_scored:type_name -> fpl.v1.Scoring.MngGoalsScoredEntry
-	48, // 16: fpl.v1.Scoring.mng_clean_sheets:type_name -> fpl.v1.Scoring.MngCleanSheetsEntry
-	49, // 17: fpl.v1.Scoring.mng_win:type_name -> fpl.v1.Scoring.MngWinEntry
-	50, // 18: fpl.v1.Scoring.mng_draw:type_name -> fpl.v1.Scoring.MngDrawEntry
-	51, // 19: fpl.v1.Scoring.mng_underdog_win:type_name -> fpl.v1.Scoring.MngUnderdogWinEntry
-	52, // 20: fpl.v1.Scoring.mng_underdog_draw:type_name -> fpl.v1.Scoring.MngUnderdogDrawEntry
+	58, // 10: fpl.v1.GameSettings.ui_special_shirt_exclusions:type_name -> google.protobuf.Value
+	48, // 11: fpl.v1.Scoring.goals_conceded:type_name -> fpl.v1.Scoring.GoalsConcededEntry
+	49, // 12: fpl.v1.Scoring.goals_scored:type_name -> fpl.v1.Scoring.GoalsScoredEntry
+	50, // 13: fpl.v1.Scoring.clean_sheets:type_name -> fpl.v1.Scoring.CleanSheetsEntry
+	51, // 14: fpl.v1.Scoring.defensive_contribution:type_name -> fpl.v1.Scoring.DefensiveContributionEntry
+	52, // 15: fpl.v1.Scoring.mng_goals_scored:type_name -> fpl.v1.Scoring.MngGoalsScoredEntry
+	53, // 16: fpl.v1.Scoring.mng_clean_sheets:type_name -> fpl.v1.Scoring.MngCleanSheetsEntry
+	54, // 17: fpl.v1.Scoring.mng_win:type_name -> fpl.v1.Scoring.MngWinEntry
+	55, // 18: fpl.v1.Scoring.mng_draw:type_name -> fpl.v1.Scoring.MngDrawEntry
+	56, // 19: fpl.v1.Scoring.mng_underdog_win:type_name -> fpl.v1.Scoring.MngUnderdogWinEntry
+	57, // 20: fpl.v1.Scoring.mng_underdog_draw:type_name -> fpl.v1.Scoring.MngUnderdogDrawEntry
 	2,  // 21: fpl.v1.Event.chip_plays:type_name -> fpl.v1.Chip
 	12, // 22: fpl.v1.Event.top_element_info:type_name -> fpl.v1.TopElementInfo
 	14, // 23: fpl.v1.LiveEvent.elements:type_name -> fpl.v1.LiveElement
@@ -5881,15 +6472,17 @@ var file_fpl_v1_fpl_proto_depIdxs = []int32{
 	6,  // 41: fpl.v1.FixtureMessage.fixture:type_name -> fpl.v1.Fixture
 	15, // 42: fpl.v1.LiveEventMessage.stats:type_name -> fpl.v1.LiveStats
 	16, // 43: fpl.v1.LiveEventMessage.explain:type_name -> fpl.v1.ExplainItem
-	18, // 44: fpl.v1.EntryMessage.entry:type_name -> fpl.v1.Entry
-	19, // 45: fpl.v1.EntryEventPicksMessage.picks:type_name -> fpl.v1.EntryEventPicks
-	22, // 46: fpl.v1.EntryHistoryMessage.entry_history:type_name -> fpl.v1.EntryHistory
-	27, // 47: fpl.v1.EntryTransfersMessage.transfers:type_name -> fpl.v1.Transfer
-	48, // [48:48] is the sub-list for method output_type
-	48, // [48:48] is the sub-list for method input_type
-	48, // [48:48] is the sub-list for extension type_name
-	48, // [48:48] is the sub-list for extension extendee
-	0,  // [0:48] is the sub-list for field type_name
+	43, // 44: fpl.v1.FixtureGoalProbabilitiesMessage.home:type_name -> fpl.v1.TeamGoalProbabilities
+	43, // 45: fpl.v1.FixtureGoalProbabilitiesMessage.away:type_name -> fpl.v1.TeamGoalProbabilities
+	18, // 46: fpl.v1.EntryMessage.entry:type_name -> fpl.v1.Entry
+	19, // 47: fpl.v1.EntryEventPicksMessage.picks:type_name -> fpl.v1.EntryEventPicks
+	22, // 48: fpl.v1.EntryHistoryMessage.entry_history:type_name -> fpl.v1.EntryHistory
+	27, // 49: fpl.v1.EntryTransfersMessage.transfers:type_name -> fpl.v1.Transfer
+	50, // [50:50] is the sub-list for method output_type
+	50, // [50:50] is the sub-list for method input_type
+	50, // [50:50] is the sub-list for extension type_name
+	50, // [50:50] is the sub-list for extension extendee
+	0,  // [0:50] is the sub-list for field type_name
 }
 
 func init() { file_fpl_v1_fpl_proto_init() }
@@ -5901,13 +6494,14 @@ func file_fpl_v1_fpl_proto_init() {
 	file_fpl_v1_fpl_proto_msgTypes[5].OneofWrappers = []any{}
 	file_fpl_v1_fpl_proto_msgTypes[19].OneofWrappers = []any{}
 	file_fpl_v1_fpl_proto_msgTypes[29].OneofWrappers = []any{}
+	file_fpl_v1_fpl_proto_msgTypes[41].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fpl_v1_fpl_proto_rawDesc), len(file_fpl_v1_fpl_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   53,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
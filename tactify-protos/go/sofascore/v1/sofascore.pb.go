@@ -279,7 +279,7 @@ type Team struct {
 	TeamColors *TeamColors            `protobuf:"bytes,6,opt,name=team_colors,json=teamColors,proto3" json:"team_colors,omitempty"`
 	Tournament *Tournament            `protobuf:"bytes,7,opt,name=tournament,proto3" json:"tournament,omitempty"`
 	// Fields for Top Team Stats
-	UserCount     bool   `protobuf:"varint,8,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
+	UserCount     int32  `protobuf:"varint,8,opt,name=user_count,json=userCount,proto3" json:"user_count,omitempty"`
 	Slug          string `protobuf:"bytes,9,opt,name=slug,proto3" json:"slug,omitempty"`
 	Gender        string `protobuf:"bytes,10,opt,name=gender,proto3" json:"gender,omitempty"`
 	unknownFields protoimpl.UnknownFields
@@ -365,11 +365,11 @@ func (x *Team) GetTournament() *Tournament {
 	return nil
 }
 
-func (x *Team) GetUserCount() bool {
+func (x *Team) GetUserCount() int32 {
 	if x != nil {
 		return x.UserCount
 	}
-	return false
+	return 0
 }
 
 func (x *Team) GetSlug() string {
@@ -1035,8 +1035,12 @@ func (x *Season) GetIsCurrent() bool {
 }
 
 type RoundInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Round         int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Round int32                  `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// Set for cup-style rounds (e.g. "Quarterfinals"), where round alone is ambiguous.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	CupRoundType  int32  `protobuf:"varint,4,opt,name=cup_round_type,json=cupRoundType,proto3" json:"cup_round_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1078,6 +1082,27 @@ func (x *RoundInfo) GetRound() int32 {
 	return 0
 }
 
+func (x *RoundInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoundInfo) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *RoundInfo) GetCupRoundType() int32 {
+	if x != nil {
+		return x.CupRoundType
+	}
+	return 0
+}
+
 type EventsPage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
@@ -1140,6 +1165,8 @@ type Event struct {
 	AwayTeam       *Team                  `protobuf:"bytes,6,opt,name=away_team,json=awayTeam,proto3" json:"away_team,omitempty"`
 	Status         *Status                `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
 	StartTimestamp int64                  `protobuf:"varint,8,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	HomeScore      *Score                 `protobuf:"bytes,9,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+	AwayScore      *Score                 `protobuf:"bytes,10,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1230,6 +1257,97 @@ func (x *Event) GetStartTimestamp() int64 {
 	return 0
 }
 
+func (x *Event) GetHomeScore() *Score {
+	if x != nil {
+		return x.HomeScore
+	}
+	return nil
+}
+
+func (x *Event) GetAwayScore() *Score {
+	if x != nil {
+		return x.AwayScore
+	}
+	return nil
+}
+
+// Score is one side's goals; normaltime leaves out extra time and penalties.
+type Score struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Current       *int32                 `protobuf:"varint,1,opt,name=current,proto3,oneof" json:"current,omitempty"`
+	Display       *int32                 `protobuf:"varint,2,opt,name=display,proto3,oneof" json:"display,omitempty"`
+	Period1       *int32                 `protobuf:"varint,3,opt,name=period1,proto3,oneof" json:"period1,omitempty"`
+	Period2       *int32                 `protobuf:"varint,4,opt,name=period2,proto3,oneof" json:"period2,omitempty"`
+	Normaltime    *int32                 `protobuf:"varint,5,opt,name=normaltime,proto3,oneof" json:"normaltime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Score) Reset() {
+	*x = Score{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Score) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Score) ProtoMessage() {}
+
+func (x *Score) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Score.ProtoReflect.Descriptor instead.
+func (*Score) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Score) GetCurrent() int32 {
+	if x != nil && x.Current != nil {
+		return *x.Current
+	}
+	return 0
+}
+
+func (x *Score) GetDisplay() int32 {
+	if x != nil && x.Display != nil {
+		return *x.Display
+	}
+	return 0
+}
+
+func (x *Score) GetPeriod1() int32 {
+	if x != nil && x.Period1 != nil {
+		return *x.Period1
+	}
+	return 0
+}
+
+func (x *Score) GetPeriod2() int32 {
+	if x != nil && x.Period2 != nil {
+		return *x.Period2
+	}
+	return 0
+}
+
+func (x *Score) GetNormaltime() int32 {
+	if x != nil && x.Normaltime != nil {
+		return *x.Normaltime
+	}
+	return 0
+}
+
 type LeagueCategories struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Categories    []*LeagueCategory      `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
@@ -1239,7 +1357,7 @@ type LeagueCategories struct {
 
 func (x *LeagueCategories) Reset() {
 	*x = LeagueCategories{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[16]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1251,7 +1369,7 @@ func (x *LeagueCategories) String() string {
 func (*LeagueCategories) ProtoMessage() {}
 
 func (x *LeagueCategories) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[16]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1264,7 +1382,7 @@ func (x *LeagueCategories) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeagueCategories.ProtoReflect.Descriptor instead.
 func (*LeagueCategories) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{16}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *LeagueCategories) GetCategories() []*LeagueCategory {
@@ -1283,7 +1401,7 @@ type LeagueUniqueTournaments struct {
 
 func (x *LeagueUniqueTournaments) Reset() {
 	*x = LeagueUniqueTournaments{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[17]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1295,7 +1413,7 @@ func (x *LeagueUniqueTournaments) String() string {
 func (*LeagueUniqueTournaments) ProtoMessage() {}
 
 func (x *LeagueUniqueTournaments) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[17]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,7 +1426,7 @@ func (x *LeagueUniqueTournaments) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LeagueUniqueTournaments.ProtoReflect.Descriptor instead.
 func (*LeagueUniqueTournaments) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{17}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *LeagueUniqueTournaments) GetGroups() []*UniqueTournamentGroups {
@@ -1327,7 +1445,7 @@ type UniqueTournamentGroups struct {
 
 func (x *UniqueTournamentGroups) Reset() {
 	*x = UniqueTournamentGroups{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[18]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1339,7 +1457,7 @@ func (x *UniqueTournamentGroups) String() string {
 func (*UniqueTournamentGroups) ProtoMessage() {}
 
 func (x *UniqueTournamentGroups) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[18]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1352,7 +1470,7 @@ func (x *UniqueTournamentGroups) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UniqueTournamentGroups.ProtoReflect.Descriptor instead.
 func (*UniqueTournamentGroups) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{18}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UniqueTournamentGroups) GetUniqueTournaments() []*UniqueTournament {
@@ -1373,7 +1491,7 @@ type MatchLineup struct {
 
 func (x *MatchLineup) Reset() {
 	*x = MatchLineup{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[19]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1385,7 +1503,7 @@ func (x *MatchLineup) String() string {
 func (*MatchLineup) ProtoMessage() {}
 
 func (x *MatchLineup) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[19]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1398,7 +1516,7 @@ func (x *MatchLineup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchLineup.ProtoReflect.Descriptor instead.
 func (*MatchLineup) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{19}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *MatchLineup) GetConfirmed() bool {
@@ -1436,7 +1554,7 @@ type TeamLineup struct {
 
 func (x *TeamLineup) Reset() {
 	*x = TeamLineup{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[20]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1448,7 +1566,7 @@ func (x *TeamLineup) String() string {
 func (*TeamLineup) ProtoMessage() {}
 
 func (x *TeamLineup) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[20]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,7 +1579,7 @@ func (x *TeamLineup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamLineup.ProtoReflect.Descriptor instead.
 func (*TeamLineup) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{20}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *TeamLineup) GetPlayers() []*MatchPlayer {
@@ -1515,7 +1633,7 @@ type MatchPlayer struct {
 
 func (x *MatchPlayer) Reset() {
 	*x = MatchPlayer{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[21]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1527,7 +1645,7 @@ func (x *MatchPlayer) String() string {
 func (*MatchPlayer) ProtoMessage() {}
 
 func (x *MatchPlayer) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[21]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1540,7 +1658,7 @@ func (x *MatchPlayer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchPlayer.ProtoReflect.Descriptor instead.
 func (*MatchPlayer) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{21}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MatchPlayer) GetPlayer() *Player {
@@ -1613,7 +1731,7 @@ type MissingPlayer struct {
 
 func (x *MissingPlayer) Reset() {
 	*x = MissingPlayer{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[22]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1625,7 +1743,7 @@ func (x *MissingPlayer) String() string {
 func (*MissingPlayer) ProtoMessage() {}
 
 func (x *MissingPlayer) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[22]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,7 +1756,7 @@ func (x *MissingPlayer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MissingPlayer.ProtoReflect.Descriptor instead.
 func (*MissingPlayer) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{22}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *MissingPlayer) GetPlayer() *Player {
@@ -1683,28 +1801,31 @@ func (x *MissingPlayer) GetExpectedEndDate() string {
 	return ""
 }
 
-type MatchStats struct {
+// Head-to-head record from the point of view of the event's home side.
+type H2HDuel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Statistics    []*MatchPeriods        `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	HomeWins      int32                  `protobuf:"varint,1,opt,name=home_wins,json=homeWins,proto3" json:"home_wins,omitempty"`
+	AwayWins      int32                  `protobuf:"varint,2,opt,name=away_wins,json=awayWins,proto3" json:"away_wins,omitempty"`
+	Draws         int32                  `protobuf:"varint,3,opt,name=draws,proto3" json:"draws,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchStats) Reset() {
-	*x = MatchStats{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[23]
+func (x *H2HDuel) Reset() {
+	*x = H2HDuel{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchStats) String() string {
+func (x *H2HDuel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchStats) ProtoMessage() {}
+func (*H2HDuel) ProtoMessage() {}
 
-func (x *MatchStats) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[23]
+func (x *H2HDuel) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1715,41 +1836,56 @@ func (x *MatchStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchStats.ProtoReflect.Descriptor instead.
-func (*MatchStats) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use H2HDuel.ProtoReflect.Descriptor instead.
+func (*H2HDuel) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MatchStats) GetStatistics() []*MatchPeriods {
+func (x *H2HDuel) GetHomeWins() int32 {
 	if x != nil {
-		return x.Statistics
+		return x.HomeWins
 	}
-	return nil
+	return 0
 }
 
-type MatchPeriods struct {
+func (x *H2HDuel) GetAwayWins() int32 {
+	if x != nil {
+		return x.AwayWins
+	}
+	return 0
+}
+
+func (x *H2HDuel) GetDraws() int32 {
+	if x != nil {
+		return x.Draws
+	}
+	return 0
+}
+
+// /event/{id}/h2h. manager_duel is absent when either side has no manager on record.
+type MatchH2H struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
-	Groups        []*StatsGroup          `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	TeamDuel      *H2HDuel               `protobuf:"bytes,1,opt,name=team_duel,json=teamDuel,proto3" json:"team_duel,omitempty"`
+	ManagerDuel   *H2HDuel               `protobuf:"bytes,2,opt,name=manager_duel,json=managerDuel,proto3" json:"manager_duel,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MatchPeriods) Reset() {
-	*x = MatchPeriods{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[24]
+func (x *MatchH2H) Reset() {
+	*x = MatchH2H{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MatchPeriods) String() string {
+func (x *MatchH2H) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MatchPeriods) ProtoMessage() {}
+func (*MatchH2H) ProtoMessage() {}
 
-func (x *MatchPeriods) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[24]
+func (x *MatchH2H) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1760,48 +1896,50 @@ func (x *MatchPeriods) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MatchPeriods.ProtoReflect.Descriptor instead.
-func (*MatchPeriods) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use MatchH2H.ProtoReflect.Descriptor instead.
+func (*MatchH2H) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MatchPeriods) GetPeriod() string {
+func (x *MatchH2H) GetTeamDuel() *H2HDuel {
 	if x != nil {
-		return x.Period
+		return x.TeamDuel
 	}
-	return ""
+	return nil
 }
 
-func (x *MatchPeriods) GetGroups() []*StatsGroup {
+func (x *MatchH2H) GetManagerDuel() *H2HDuel {
 	if x != nil {
-		return x.Groups
+		return x.ManagerDuel
 	}
 	return nil
 }
 
-type StatsGroup struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	GroupName       string                 `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
-	StatisticsItems []*StatsItem           `protobuf:"bytes,2,rep,name=statistics_items,json=statisticsItems,proto3" json:"statistics_items,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type BestPlayer struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Player *Player                `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	// Sofascore sends the rating as a string, e.g. "8.4".
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Label         string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatsGroup) Reset() {
-	*x = StatsGroup{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[25]
+func (x *BestPlayer) Reset() {
+	*x = BestPlayer{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsGroup) String() string {
+func (x *BestPlayer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsGroup) ProtoMessage() {}
+func (*BestPlayer) ProtoMessage() {}
 
-func (x *StatsGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[25]
+func (x *BestPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1812,56 +1950,57 @@ func (x *StatsGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsGroup.ProtoReflect.Descriptor instead.
-func (*StatsGroup) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use BestPlayer.ProtoReflect.Descriptor instead.
+func (*BestPlayer) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *StatsGroup) GetGroupName() string {
+func (x *BestPlayer) GetPlayer() *Player {
 	if x != nil {
-		return x.GroupName
+		return x.Player
+	}
+	return nil
+}
+
+func (x *BestPlayer) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
-func (x *StatsGroup) GetStatisticsItems() []*StatsItem {
+func (x *BestPlayer) GetLabel() string {
 	if x != nil {
-		return x.StatisticsItems
+		return x.Label
 	}
-	return nil
+	return ""
 }
 
-type StatsItem struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Period         string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
-	Key            string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	HomeValue      float64                `protobuf:"fixed64,4,opt,name=home_value,json=homeValue,proto3" json:"home_value,omitempty"`
-	AwayValue      float64                `protobuf:"fixed64,5,opt,name=away_value,json=awayValue,proto3" json:"away_value,omitempty"`
-	CompareCode    int32                  `protobuf:"varint,6,opt,name=compare_code,json=compareCode,proto3" json:"compare_code,omitempty"`
-	HomeTotal      *int32                 `protobuf:"varint,7,opt,name=home_total,json=homeTotal,proto3,oneof" json:"home_total,omitempty"`
-	AwayTotal      *int32                 `protobuf:"varint,8,opt,name=away_total,json=awayTotal,proto3,oneof" json:"away_total,omitempty"`
-	StatisticsType string                 `protobuf:"bytes,9,opt,name=statistics_type,json=statisticsType,proto3" json:"statistics_type,omitempty"`
-	RenderType     int32                  `protobuf:"varint,10,opt,name=render_type,json=renderType,proto3" json:"render_type,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+// /event/{id}/best-players/summary, only available once a match has been played.
+type MatchBestPlayers struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	BestHomeTeamPlayers []*BestPlayer          `protobuf:"bytes,1,rep,name=best_home_team_players,json=bestHomeTeamPlayers,proto3" json:"best_home_team_players,omitempty"`
+	BestAwayTeamPlayers []*BestPlayer          `protobuf:"bytes,2,rep,name=best_away_team_players,json=bestAwayTeamPlayers,proto3" json:"best_away_team_players,omitempty"`
+	PlayerOfTheMatch    *BestPlayer            `protobuf:"bytes,3,opt,name=player_of_the_match,json=playerOfTheMatch,proto3" json:"player_of_the_match,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *StatsItem) Reset() {
-	*x = StatsItem{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[26]
+func (x *MatchBestPlayers) Reset() {
+	*x = MatchBestPlayers{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsItem) String() string {
+func (x *MatchBestPlayers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsItem) ProtoMessage() {}
+func (*MatchBestPlayers) ProtoMessage() {}
 
-func (x *StatsItem) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[26]
+func (x *MatchBestPlayers) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1872,40 +2011,255 @@ func (x *StatsItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsItem.ProtoReflect.Descriptor instead.
-func (*StatsItem) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use MatchBestPlayers.ProtoReflect.Descriptor instead.
+func (*MatchBestPlayers) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *StatsItem) GetPeriod() string {
+func (x *MatchBestPlayers) GetBestHomeTeamPlayers() []*BestPlayer {
 	if x != nil {
-		return x.Period
+		return x.BestHomeTeamPlayers
 	}
-	return ""
+	return nil
 }
 
-func (x *StatsItem) GetKey() string {
+func (x *MatchBestPlayers) GetBestAwayTeamPlayers() []*BestPlayer {
 	if x != nil {
-		return x.Key
+		return x.BestAwayTeamPlayers
 	}
-	return ""
+	return nil
 }
 
-func (x *StatsItem) GetName() string {
+func (x *MatchBestPlayers) GetPlayerOfTheMatch() *BestPlayer {
 	if x != nil {
-		return x.Name
+		return x.PlayerOfTheMatch
 	}
-	return ""
+	return nil
 }
 
-func (x *StatsItem) GetHomeValue() float64 {
-	if x != nil {
-		return x.HomeValue
-	}
-	return 0
+type MatchStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statistics    []*MatchPeriods        `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StatsItem) GetAwayValue() float64 {
+func (x *MatchStats) Reset() {
+	*x = MatchStats{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchStats) ProtoMessage() {}
+
+func (x *MatchStats) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchStats.ProtoReflect.Descriptor instead.
+func (*MatchStats) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MatchStats) GetStatistics() []*MatchPeriods {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+type MatchPeriods struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Groups        []*StatsGroup          `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchPeriods) Reset() {
+	*x = MatchPeriods{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchPeriods) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchPeriods) ProtoMessage() {}
+
+func (x *MatchPeriods) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchPeriods.ProtoReflect.Descriptor instead.
+func (*MatchPeriods) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *MatchPeriods) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *MatchPeriods) GetGroups() []*StatsGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type StatsGroup struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	GroupName       string                 `protobuf:"bytes,1,opt,name=group_name,json=groupName,proto3" json:"group_name,omitempty"`
+	StatisticsItems []*StatsItem           `protobuf:"bytes,2,rep,name=statistics_items,json=statisticsItems,proto3" json:"statistics_items,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StatsGroup) Reset() {
+	*x = StatsGroup{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsGroup) ProtoMessage() {}
+
+func (x *StatsGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsGroup.ProtoReflect.Descriptor instead.
+func (*StatsGroup) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *StatsGroup) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *StatsGroup) GetStatisticsItems() []*StatsItem {
+	if x != nil {
+		return x.StatisticsItems
+	}
+	return nil
+}
+
+type StatsItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Period         string                 `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	Key            string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Name           string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	HomeValue      float64                `protobuf:"fixed64,4,opt,name=home_value,json=homeValue,proto3" json:"home_value,omitempty"`
+	AwayValue      float64                `protobuf:"fixed64,5,opt,name=away_value,json=awayValue,proto3" json:"away_value,omitempty"`
+	CompareCode    int32                  `protobuf:"varint,6,opt,name=compare_code,json=compareCode,proto3" json:"compare_code,omitempty"`
+	HomeTotal      *int32                 `protobuf:"varint,7,opt,name=home_total,json=homeTotal,proto3,oneof" json:"home_total,omitempty"`
+	AwayTotal      *int32                 `protobuf:"varint,8,opt,name=away_total,json=awayTotal,proto3,oneof" json:"away_total,omitempty"`
+	StatisticsType string                 `protobuf:"bytes,9,opt,name=statistics_type,json=statisticsType,proto3" json:"statistics_type,omitempty"`
+	RenderType     int32                  `protobuf:"varint,10,opt,name=render_type,json=renderType,proto3" json:"render_type,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StatsItem) Reset() {
+	*x = StatsItem{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatsItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsItem) ProtoMessage() {}
+
+func (x *StatsItem) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsItem.ProtoReflect.Descriptor instead.
+func (*StatsItem) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StatsItem) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *StatsItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatsItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsItem) GetHomeValue() float64 {
+	if x != nil {
+		return x.HomeValue
+	}
+	return 0
+}
+
+func (x *StatsItem) GetAwayValue() float64 {
 	if x != nil {
 		return x.AwayValue
 	}
@@ -2013,7 +2367,7 @@ type PlayerStatistics struct {
 
 func (x *PlayerStatistics) Reset() {
 	*x = PlayerStatistics{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[27]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2025,7 +2379,7 @@ func (x *PlayerStatistics) String() string {
 func (*PlayerStatistics) ProtoMessage() {}
 
 func (x *PlayerStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[27]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2038,7 +2392,7 @@ func (x *PlayerStatistics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerStatistics.ProtoReflect.Descriptor instead.
 func (*PlayerStatistics) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{27}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *PlayerStatistics) GetMinutesPlayed() int32 {
@@ -2457,7 +2811,7 @@ type RatingVersions struct {
 
 func (x *RatingVersions) Reset() {
 	*x = RatingVersions{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[28]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2469,7 +2823,7 @@ func (x *RatingVersions) String() string {
 func (*RatingVersions) ProtoMessage() {}
 
 func (x *RatingVersions) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[28]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2482,7 +2836,7 @@ func (x *RatingVersions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RatingVersions.ProtoReflect.Descriptor instead.
 func (*RatingVersions) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{28}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *RatingVersions) GetOriginal() float64 {
@@ -2508,7 +2862,7 @@ type PlayerSeasonsStats struct {
 
 func (x *PlayerSeasonsStats) Reset() {
 	*x = PlayerSeasonsStats{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[29]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2520,7 +2874,7 @@ func (x *PlayerSeasonsStats) String() string {
 func (*PlayerSeasonsStats) ProtoMessage() {}
 
 func (x *PlayerSeasonsStats) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[29]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2533,7 +2887,7 @@ func (x *PlayerSeasonsStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerSeasonsStats.ProtoReflect.Descriptor instead.
 func (*PlayerSeasonsStats) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{29}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *PlayerSeasonsStats) GetSeasons() []*PlayerSeasonStats {
@@ -2544,20 +2898,22 @@ func (x *PlayerSeasonsStats) GetSeasons() []*PlayerSeasonStats {
 }
 
 type PlayerSeasonStats struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
-	Year          string                 `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
-	StartYear     int32                  `protobuf:"varint,3,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
-	EndYear       string                 `protobuf:"bytes,4,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
-	Team          *Team                  `protobuf:"bytes,5,opt,name=team,proto3" json:"team,omitempty"`
-	Season        *Season                `protobuf:"bytes,6,opt,name=season,proto3" json:"season,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId         int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Year             string                 `protobuf:"bytes,2,opt,name=year,proto3" json:"year,omitempty"`
+	StartYear        int32                  `protobuf:"varint,3,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	EndYear          int32                  `protobuf:"varint,4,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	Team             *Team                  `protobuf:"bytes,5,opt,name=team,proto3" json:"team,omitempty"`
+	Season           *Season                `protobuf:"bytes,6,opt,name=season,proto3" json:"season,omitempty"`
+	Statistics       *AggregatedStatistics  `protobuf:"bytes,7,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	UniqueTournament *UniqueTournament      `protobuf:"bytes,8,opt,name=unique_tournament,json=uniqueTournament,proto3" json:"unique_tournament,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *PlayerSeasonStats) Reset() {
 	*x = PlayerSeasonStats{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[30]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2569,7 +2925,7 @@ func (x *PlayerSeasonStats) String() string {
 func (*PlayerSeasonStats) ProtoMessage() {}
 
 func (x *PlayerSeasonStats) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[30]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2582,7 +2938,7 @@ func (x *PlayerSeasonStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerSeasonStats.ProtoReflect.Descriptor instead.
 func (*PlayerSeasonStats) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{30}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *PlayerSeasonStats) GetPlayerId() int32 {
@@ -2606,11 +2962,11 @@ func (x *PlayerSeasonStats) GetStartYear() int32 {
 	return 0
 }
 
-func (x *PlayerSeasonStats) GetEndYear() string {
+func (x *PlayerSeasonStats) GetEndYear() int32 {
 	if x != nil {
 		return x.EndYear
 	}
-	return ""
+	return 0
 }
 
 func (x *PlayerSeasonStats) GetTeam() *Team {
@@ -2627,6 +2983,279 @@ func (x *PlayerSeasonStats) GetSeason() *Season {
 	return nil
 }
 
+func (x *PlayerSeasonStats) GetStatistics() *AggregatedStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *PlayerSeasonStats) GetUniqueTournament() *UniqueTournament {
+	if x != nil {
+		return x.UniqueTournament
+	}
+	return nil
+}
+
+// One season line of /player/{id}/statistics, tagged with the player it belongs to.
+type PlayerSeasonStatsMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Stats         *PlayerSeasonStats     `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerSeasonStatsMessage) Reset() {
+	*x = PlayerSeasonStatsMessage{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerSeasonStatsMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerSeasonStatsMessage) ProtoMessage() {}
+
+func (x *PlayerSeasonStatsMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerSeasonStatsMessage.ProtoReflect.Descriptor instead.
+func (*PlayerSeasonStatsMessage) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PlayerSeasonStatsMessage) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerSeasonStatsMessage) GetStats() *PlayerSeasonStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type PlayerAttributeOverview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attacking     int32                  `protobuf:"varint,2,opt,name=attacking,proto3" json:"attacking,omitempty"`
+	Technical     int32                  `protobuf:"varint,3,opt,name=technical,proto3" json:"technical,omitempty"`
+	Tactical      int32                  `protobuf:"varint,4,opt,name=tactical,proto3" json:"tactical,omitempty"`
+	Defending     int32                  `protobuf:"varint,5,opt,name=defending,proto3" json:"defending,omitempty"`
+	Creativity    int32                  `protobuf:"varint,6,opt,name=creativity,proto3" json:"creativity,omitempty"`
+	Position      string                 `protobuf:"bytes,7,opt,name=position,proto3" json:"position,omitempty"`
+	YearShift     int32                  `protobuf:"varint,8,opt,name=year_shift,json=yearShift,proto3" json:"year_shift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerAttributeOverview) Reset() {
+	*x = PlayerAttributeOverview{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerAttributeOverview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAttributeOverview) ProtoMessage() {}
+
+func (x *PlayerAttributeOverview) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAttributeOverview.ProtoReflect.Descriptor instead.
+func (*PlayerAttributeOverview) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PlayerAttributeOverview) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PlayerAttributeOverview) GetAttacking() int32 {
+	if x != nil {
+		return x.Attacking
+	}
+	return 0
+}
+
+func (x *PlayerAttributeOverview) GetTechnical() int32 {
+	if x != nil {
+		return x.Technical
+	}
+	return 0
+}
+
+func (x *PlayerAttributeOverview) GetTactical() int32 {
+	if x != nil {
+		return x.Tactical
+	}
+	return 0
+}
+
+func (x *PlayerAttributeOverview) GetDefending() int32 {
+	if x != nil {
+		return x.Defending
+	}
+	return 0
+}
+
+func (x *PlayerAttributeOverview) GetCreativity() int32 {
+	if x != nil {
+		return x.Creativity
+	}
+	return 0
+}
+
+func (x *PlayerAttributeOverview) GetPosition() string {
+	if x != nil {
+		return x.Position
+	}
+	return ""
+}
+
+func (x *PlayerAttributeOverview) GetYearShift() int32 {
+	if x != nil {
+		return x.YearShift
+	}
+	return 0
+}
+
+// /player/{id}/attribute-overviews: the player's profile for the current and
+// previous years plus the average profile of players in the same position.
+type PlayerAttributes struct {
+	state                     protoimpl.MessageState     `protogen:"open.v1"`
+	PlayerAttributeOverviews  []*PlayerAttributeOverview `protobuf:"bytes,1,rep,name=player_attribute_overviews,json=playerAttributeOverviews,proto3" json:"player_attribute_overviews,omitempty"`
+	AverageAttributeOverviews []*PlayerAttributeOverview `protobuf:"bytes,2,rep,name=average_attribute_overviews,json=averageAttributeOverviews,proto3" json:"average_attribute_overviews,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *PlayerAttributes) Reset() {
+	*x = PlayerAttributes{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerAttributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAttributes) ProtoMessage() {}
+
+func (x *PlayerAttributes) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAttributes.ProtoReflect.Descriptor instead.
+func (*PlayerAttributes) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PlayerAttributes) GetPlayerAttributeOverviews() []*PlayerAttributeOverview {
+	if x != nil {
+		return x.PlayerAttributeOverviews
+	}
+	return nil
+}
+
+func (x *PlayerAttributes) GetAverageAttributeOverviews() []*PlayerAttributeOverview {
+	if x != nil {
+		return x.AverageAttributeOverviews
+	}
+	return nil
+}
+
+type PlayerAttributesMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId      int32                  `protobuf:"varint,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Attributes    *PlayerAttributes      `protobuf:"bytes,2,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlayerAttributesMessage) Reset() {
+	*x = PlayerAttributesMessage{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlayerAttributesMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerAttributesMessage) ProtoMessage() {}
+
+func (x *PlayerAttributesMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerAttributesMessage.ProtoReflect.Descriptor instead.
+func (*PlayerAttributesMessage) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PlayerAttributesMessage) GetPlayerId() int32 {
+	if x != nil {
+		return x.PlayerId
+	}
+	return 0
+}
+
+func (x *PlayerAttributesMessage) GetAttributes() *PlayerAttributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 // Statistics summary usually found in Top Players lists
 type AggregatedStatistics struct {
 	state                       protoimpl.MessageState `protogen:"open.v1"`
@@ -2660,7 +3289,7 @@ type AggregatedStatistics struct {
 	Tackles                     int32                  `protobuf:"varint,28,opt,name=tackles,proto3" json:"tackles,omitempty"`
 	TotalShots                  int32                  `protobuf:"varint,29,opt,name=total_shots,json=totalShots,proto3" json:"total_shots,omitempty"`
 	YellowCards                 int32                  `protobuf:"varint,30,opt,name=yellow_cards,json=yellowCards,proto3" json:"yellow_cards,omitempty"`
-	TotalRating                 int32                  `protobuf:"varint,31,opt,name=total_rating,json=totalRating,proto3" json:"total_rating,omitempty"`
+	TotalRating                 float64                `protobuf:"fixed64,31,opt,name=total_rating,json=totalRating,proto3" json:"total_rating,omitempty"`
 	CountRating                 int32                  `protobuf:"varint,32,opt,name=count_rating,json=countRating,proto3" json:"count_rating,omitempty"`
 	TotalLongBalls              int32                  `protobuf:"varint,33,opt,name=total_long_balls,json=totalLongBalls,proto3" json:"total_long_balls,omitempty"`
 	TotalPasses                 int32                  `protobuf:"varint,34,opt,name=total_passes,json=totalPasses,proto3" json:"total_passes,omitempty"`
@@ -2668,13 +3297,16 @@ type AggregatedStatistics struct {
 	Appearances                 int32                  `protobuf:"varint,36,opt,name=appearances,proto3" json:"appearances,omitempty"`
 	Type                        string                 `protobuf:"bytes,37,opt,name=type,proto3" json:"type,omitempty"`
 	Id                          int32                  `protobuf:"varint,38,opt,name=id,proto3" json:"id,omitempty"`
+	AccurateCrosses             int32                  `protobuf:"varint,39,opt,name=accurate_crosses,json=accurateCrosses,proto3" json:"accurate_crosses,omitempty"`
+	AccurateCrossesPercentage   float64                `protobuf:"fixed64,40,opt,name=accurate_crosses_percentage,json=accurateCrossesPercentage,proto3" json:"accurate_crosses_percentage,omitempty"`
+	TotalCross                  int32                  `protobuf:"varint,41,opt,name=total_cross,json=totalCross,proto3" json:"total_cross,omitempty"`
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
 
 func (x *AggregatedStatistics) Reset() {
 	*x = AggregatedStatistics{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[31]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2686,7 +3318,7 @@ func (x *AggregatedStatistics) String() string {
 func (*AggregatedStatistics) ProtoMessage() {}
 
 func (x *AggregatedStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[31]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2699,7 +3331,7 @@ func (x *AggregatedStatistics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AggregatedStatistics.ProtoReflect.Descriptor instead.
 func (*AggregatedStatistics) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{31}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *AggregatedStatistics) GetAccurateLongBalls() int32 {
@@ -2912,7 +3544,7 @@ func (x *AggregatedStatistics) GetYellowCards() int32 {
 	return 0
 }
 
-func (x *AggregatedStatistics) GetTotalRating() int32 {
+func (x *AggregatedStatistics) GetTotalRating() float64 {
 	if x != nil {
 		return x.TotalRating
 	}
@@ -2968,6 +3600,27 @@ func (x *AggregatedStatistics) GetId() int32 {
 	return 0
 }
 
+func (x *AggregatedStatistics) GetAccurateCrosses() int32 {
+	if x != nil {
+		return x.AccurateCrosses
+	}
+	return 0
+}
+
+func (x *AggregatedStatistics) GetAccurateCrossesPercentage() float64 {
+	if x != nil {
+		return x.AccurateCrossesPercentage
+	}
+	return 0
+}
+
+func (x *AggregatedStatistics) GetTotalCross() int32 {
+	if x != nil {
+		return x.TotalCross
+	}
+	return 0
+}
+
 type Standings struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Standings     []*Standing            `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`
@@ -2977,7 +3630,7 @@ type Standings struct {
 
 func (x *Standings) Reset() {
 	*x = Standings{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[32]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2989,7 +3642,7 @@ func (x *Standings) String() string {
 func (*Standings) ProtoMessage() {}
 
 func (x *Standings) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[32]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3002,7 +3655,7 @@ func (x *Standings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Standings.ProtoReflect.Descriptor instead.
 func (*Standings) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{32}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Standings) GetStandings() []*Standing {
@@ -3021,7 +3674,7 @@ type Standing struct {
 
 func (x *Standing) Reset() {
 	*x = Standing{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[33]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3033,7 +3686,7 @@ func (x *Standing) String() string {
 func (*Standing) ProtoMessage() {}
 
 func (x *Standing) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[33]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3046,7 +3699,7 @@ func (x *Standing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Standing.ProtoReflect.Descriptor instead.
 func (*Standing) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{33}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *Standing) GetRows() []*StandingRow {
@@ -3074,7 +3727,7 @@ type StandingRow struct {
 
 func (x *StandingRow) Reset() {
 	*x = StandingRow{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[34]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3086,7 +3739,7 @@ func (x *StandingRow) String() string {
 func (*StandingRow) ProtoMessage() {}
 
 func (x *StandingRow) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[34]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3099,7 +3752,7 @@ func (x *StandingRow) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StandingRow.ProtoReflect.Descriptor instead.
 func (*StandingRow) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{34}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *StandingRow) GetTeam() *Team {
@@ -3181,7 +3834,7 @@ type TeamPlayers struct {
 
 func (x *TeamPlayers) Reset() {
 	*x = TeamPlayers{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[35]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3193,7 +3846,7 @@ func (x *TeamPlayers) String() string {
 func (*TeamPlayers) ProtoMessage() {}
 
 func (x *TeamPlayers) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[35]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3206,7 +3859,7 @@ func (x *TeamPlayers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamPlayers.ProtoReflect.Descriptor instead.
 func (*TeamPlayers) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{35}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *TeamPlayers) GetPlayers() []*TeamPlayerItem {
@@ -3225,7 +3878,7 @@ type TeamPlayerItem struct {
 
 func (x *TeamPlayerItem) Reset() {
 	*x = TeamPlayerItem{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[36]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3237,7 +3890,7 @@ func (x *TeamPlayerItem) String() string {
 func (*TeamPlayerItem) ProtoMessage() {}
 
 func (x *TeamPlayerItem) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[36]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3250,7 +3903,7 @@ func (x *TeamPlayerItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamPlayerItem.ProtoReflect.Descriptor instead.
 func (*TeamPlayerItem) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{36}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *TeamPlayerItem) GetPlayer() *Player {
@@ -3382,7 +4035,7 @@ type TeamOverallStats struct {
 
 func (x *TeamOverallStats) Reset() {
 	*x = TeamOverallStats{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[37]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3394,7 +4047,7 @@ func (x *TeamOverallStats) String() string {
 func (*TeamOverallStats) ProtoMessage() {}
 
 func (x *TeamOverallStats) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[37]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3407,7 +4060,7 @@ func (x *TeamOverallStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamOverallStats.ProtoReflect.Descriptor instead.
 func (*TeamOverallStats) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{37}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *TeamOverallStats) GetGoalsScored() int32 {
@@ -4210,7 +4863,7 @@ type TopPlayers struct {
 
 func (x *TopPlayers) Reset() {
 	*x = TopPlayers{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[38]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4222,7 +4875,7 @@ func (x *TopPlayers) String() string {
 func (*TopPlayers) ProtoMessage() {}
 
 func (x *TopPlayers) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[38]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4235,7 +4888,7 @@ func (x *TopPlayers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopPlayers.ProtoReflect.Descriptor instead.
 func (*TopPlayers) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{38}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *TopPlayers) GetTopPlayers() *TopPlayersStats {
@@ -4277,7 +4930,7 @@ type TopPlayersStats struct {
 
 func (x *TopPlayersStats) Reset() {
 	*x = TopPlayersStats{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[39]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4289,7 +4942,7 @@ func (x *TopPlayersStats) String() string {
 func (*TopPlayersStats) ProtoMessage() {}
 
 func (x *TopPlayersStats) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[39]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4302,7 +4955,7 @@ func (x *TopPlayersStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopPlayersStats.ProtoReflect.Descriptor instead.
 func (*TopPlayersStats) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{39}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *TopPlayersStats) GetRating() []*PlayerLeaderboardItem {
@@ -4485,7 +5138,7 @@ type PlayerLeaderboardItem struct {
 
 func (x *PlayerLeaderboardItem) Reset() {
 	*x = PlayerLeaderboardItem{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[40]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4497,7 +5150,7 @@ func (x *PlayerLeaderboardItem) String() string {
 func (*PlayerLeaderboardItem) ProtoMessage() {}
 
 func (x *PlayerLeaderboardItem) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[40]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4510,7 +5163,7 @@ func (x *PlayerLeaderboardItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerLeaderboardItem.ProtoReflect.Descriptor instead.
 func (*PlayerLeaderboardItem) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{40}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *PlayerLeaderboardItem) GetPlayer() *Player {
@@ -4548,7 +5201,7 @@ type TopTeams struct {
 	GoalsConceded         []*TopTeamStatItem     `protobuf:"bytes,3,rep,name=goals_conceded,json=goalsConceded,proto3" json:"goals_conceded,omitempty"`
 	BigChances            []*TopTeamStatItem     `protobuf:"bytes,4,rep,name=big_chances,json=bigChances,proto3" json:"big_chances,omitempty"`
 	BigChancesMissed      []*TopTeamStatItem     `protobuf:"bytes,5,rep,name=big_chances_missed,json=bigChancesMissed,proto3" json:"big_chances_missed,omitempty"`
-	HitWoodWork           []*TopTeamStatItem     `protobuf:"bytes,6,rep,name=hit_wood_work,json=hitWoodWork,proto3" json:"hit_wood_work,omitempty"`
+	HitWoodWork           []*TopTeamStatItem     `protobuf:"bytes,6,rep,name=hit_wood_work,json=hitWoodwork,proto3" json:"hit_wood_work,omitempty"`
 	YellowCards           []*TopTeamStatItem     `protobuf:"bytes,7,rep,name=yellow_cards,json=yellowCards,proto3" json:"yellow_cards,omitempty"`
 	RedCards              []*TopTeamStatItem     `protobuf:"bytes,8,rep,name=red_cards,json=redCards,proto3" json:"red_cards,omitempty"`
 	AverageBallPossession []*TopTeamStatItem     `protobuf:"bytes,9,rep,name=average_ball_possession,json=averageBallPossession,proto3" json:"average_ball_possession,omitempty"`
@@ -4572,7 +5225,7 @@ type TopTeams struct {
 
 func (x *TopTeams) Reset() {
 	*x = TopTeams{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[41]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4584,7 +5237,7 @@ func (x *TopTeams) String() string {
 func (*TopTeams) ProtoMessage() {}
 
 func (x *TopTeams) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[41]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4597,7 +5250,7 @@ func (x *TopTeams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopTeams.ProtoReflect.Descriptor instead.
 func (*TopTeams) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{41}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *TopTeams) GetAvgRating() []*TopTeamStatItem {
@@ -4763,18 +5416,23 @@ func (x *TopTeams) GetCleanSheets() []*TopTeamStatItem {
 
 // Wraps Team and its leaderboard stats (replaces TeamStat[T])
 type TopTeamStatItem struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	SeasonId      int32                      `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
-	LeagueId      int32                      `protobuf:"varint,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
-	Team          *Team                      `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"`
-	Statistics    *TeamLeaderboardStatistics `protobuf:"bytes,4,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	state      protoimpl.MessageState     `protogen:"open.v1"`
+	SeasonId   int32                      `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	LeagueId   int32                      `protobuf:"varint,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	Team       *Team                      `protobuf:"bytes,3,opt,name=team,proto3" json:"team,omitempty"`
+	Statistics *TeamLeaderboardStatistics `protobuf:"bytes,4,opt,name=statistics,proto3" json:"statistics,omitempty"`
+	// Filled in by the publisher: which leaderboard the item came from,
+	// its 1-based position in it and the value it is ranked by.
+	StatLabel     string  `protobuf:"bytes,5,opt,name=stat_label,json=statLabel,proto3" json:"stat_label,omitempty"`
+	Rank          int32   `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+	Value         float64 `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *TopTeamStatItem) Reset() {
 	*x = TopTeamStatItem{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[42]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4786,7 +5444,7 @@ func (x *TopTeamStatItem) String() string {
 func (*TopTeamStatItem) ProtoMessage() {}
 
 func (x *TopTeamStatItem) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[42]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4799,7 +5457,7 @@ func (x *TopTeamStatItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopTeamStatItem.ProtoReflect.Descriptor instead.
 func (*TopTeamStatItem) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{42}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *TopTeamStatItem) GetSeasonId() int32 {
@@ -4830,6 +5488,27 @@ func (x *TopTeamStatItem) GetStatistics() *TeamLeaderboardStatistics {
 	return nil
 }
 
+func (x *TopTeamStatItem) GetStatLabel() string {
+	if x != nil {
+		return x.StatLabel
+	}
+	return ""
+}
+
+func (x *TopTeamStatItem) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *TopTeamStatItem) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
 // Unified statistics for leaderboards (Combines Int and Float fields)
 type TeamLeaderboardStatistics struct {
 	state                protoimpl.MessageState `protogen:"open.v1"`
@@ -4841,10 +5520,10 @@ type TeamLeaderboardStatistics struct {
 	GoalsConceded        int32                  `protobuf:"varint,6,opt,name=goals_conceded,json=goalsConceded,proto3" json:"goals_conceded,omitempty"`
 	BigChances           int32                  `protobuf:"varint,7,opt,name=big_chances,json=bigChances,proto3" json:"big_chances,omitempty"`
 	BigChancesMissed     int32                  `protobuf:"varint,8,opt,name=big_chances_missed,json=bigChancesMissed,proto3" json:"big_chances_missed,omitempty"`
-	HitWoodWork          int32                  `protobuf:"varint,9,opt,name=hit_wood_work,json=hitWoodWork,proto3" json:"hit_wood_work,omitempty"`
+	HitWoodWork          int32                  `protobuf:"varint,9,opt,name=hit_wood_work,json=hitWoodwork,proto3" json:"hit_wood_work,omitempty"`
 	YellowCards          int32                  `protobuf:"varint,10,opt,name=yellow_cards,json=yellowCards,proto3" json:"yellow_cards,omitempty"`
 	RedCards             int32                  `protobuf:"varint,11,opt,name=red_cards,json=redCards,proto3" json:"red_cards,omitempty"`
-	AvgBallPossession    float64                `protobuf:"fixed64,12,opt,name=avg_ball_possession,json=avgBallPossession,proto3" json:"avg_ball_possession,omitempty"`
+	AvgBallPossession    float64                `protobuf:"fixed64,12,opt,name=avg_ball_possession,json=averageBallPossession,proto3" json:"avg_ball_possession,omitempty"`
 	AccuratePasses       int32                  `protobuf:"varint,13,opt,name=accurate_passes,json=accuratePasses,proto3" json:"accurate_passes,omitempty"`
 	AccurateLongBalls    int32                  `protobuf:"varint,14,opt,name=accurate_long_balls,json=accurateLongBalls,proto3" json:"accurate_long_balls,omitempty"`
 	AccurateCrosses      int32                  `protobuf:"varint,15,opt,name=accurate_crosses,json=accurateCrosses,proto3" json:"accurate_crosses,omitempty"`
@@ -4865,7 +5544,7 @@ type TeamLeaderboardStatistics struct {
 
 func (x *TeamLeaderboardStatistics) Reset() {
 	*x = TeamLeaderboardStatistics{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[43]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4877,7 +5556,7 @@ func (x *TeamLeaderboardStatistics) String() string {
 func (*TeamLeaderboardStatistics) ProtoMessage() {}
 
 func (x *TeamLeaderboardStatistics) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[43]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4890,7 +5569,7 @@ func (x *TeamLeaderboardStatistics) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamLeaderboardStatistics.ProtoReflect.Descriptor instead.
 func (*TeamLeaderboardStatistics) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{43}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *TeamLeaderboardStatistics) GetId() int32 {
@@ -5086,7 +5765,7 @@ type StandingMessage struct {
 
 func (x *StandingMessage) Reset() {
 	*x = StandingMessage{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[44]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5098,7 +5777,7 @@ func (x *StandingMessage) String() string {
 func (*StandingMessage) ProtoMessage() {}
 
 func (x *StandingMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[44]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5111,7 +5790,7 @@ func (x *StandingMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StandingMessage.ProtoReflect.Descriptor instead.
 func (*StandingMessage) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{44}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *StandingMessage) GetSeasonId() int32 {
@@ -5148,7 +5827,7 @@ type PlayerMessage struct {
 
 func (x *PlayerMessage) Reset() {
 	*x = PlayerMessage{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[45]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5160,7 +5839,7 @@ func (x *PlayerMessage) String() string {
 func (*PlayerMessage) ProtoMessage() {}
 
 func (x *PlayerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[45]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5173,7 +5852,7 @@ func (x *PlayerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerMessage.ProtoReflect.Descriptor instead.
 func (*PlayerMessage) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{45}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *PlayerMessage) GetSeasonId() int32 {
@@ -5218,13 +5897,15 @@ type MatchLineupMessage struct {
 	MatchId       int32                  `protobuf:"varint,3,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
 	Round         int32                  `protobuf:"varint,4,opt,name=round,proto3" json:"round,omitempty"`
 	Lineup        *MatchLineup           `protobuf:"bytes,5,opt,name=lineup,proto3" json:"lineup,omitempty"`
+	HomeTeamId    int32                  `protobuf:"varint,6,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	AwayTeamId    int32                  `protobuf:"varint,7,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MatchLineupMessage) Reset() {
 	*x = MatchLineupMessage{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[46]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5236,7 +5917,7 @@ func (x *MatchLineupMessage) String() string {
 func (*MatchLineupMessage) ProtoMessage() {}
 
 func (x *MatchLineupMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[46]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5249,7 +5930,7 @@ func (x *MatchLineupMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchLineupMessage.ProtoReflect.Descriptor instead.
 func (*MatchLineupMessage) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{46}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *MatchLineupMessage) GetSeasonId() int32 {
@@ -5287,6 +5968,20 @@ func (x *MatchLineupMessage) GetLineup() *MatchLineup {
 	return nil
 }
 
+func (x *MatchLineupMessage) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *MatchLineupMessage) GetAwayTeamId() int32 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
 type PlayerMatchStatsMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PlayerName    string                 `protobuf:"bytes,1,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`
@@ -5301,7 +5996,7 @@ type PlayerMatchStatsMessage struct {
 
 func (x *PlayerMatchStatsMessage) Reset() {
 	*x = PlayerMatchStatsMessage{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[47]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5313,7 +6008,7 @@ func (x *PlayerMatchStatsMessage) String() string {
 func (*PlayerMatchStatsMessage) ProtoMessage() {}
 
 func (x *PlayerMatchStatsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[47]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5326,7 +6021,7 @@ func (x *PlayerMatchStatsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerMatchStatsMessage.ProtoReflect.Descriptor instead.
 func (*PlayerMatchStatsMessage) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{47}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *PlayerMatchStatsMessage) GetPlayerName() string {
@@ -5387,7 +6082,7 @@ type MatchStatsMessage struct {
 
 func (x *MatchStatsMessage) Reset() {
 	*x = MatchStatsMessage{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[48]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5399,7 +6094,7 @@ func (x *MatchStatsMessage) String() string {
 func (*MatchStatsMessage) ProtoMessage() {}
 
 func (x *MatchStatsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[48]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5412,7 +6107,7 @@ func (x *MatchStatsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MatchStatsMessage.ProtoReflect.Descriptor instead.
 func (*MatchStatsMessage) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{48}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *MatchStatsMessage) GetSeasonId() int32 {
@@ -5483,7 +6178,7 @@ type TeamOverallStatsMessage struct {
 
 func (x *TeamOverallStatsMessage) Reset() {
 	*x = TeamOverallStatsMessage{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[49]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5495,7 +6190,7 @@ func (x *TeamOverallStatsMessage) String() string {
 func (*TeamOverallStatsMessage) ProtoMessage() {}
 
 func (x *TeamOverallStatsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[49]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5508,7 +6203,7 @@ func (x *TeamOverallStatsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TeamOverallStatsMessage.ProtoReflect.Descriptor instead.
 func (*TeamOverallStatsMessage) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{49}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *TeamOverallStatsMessage) GetTeamId() int32 {
@@ -5548,7 +6243,7 @@ type TopTeamsMessage struct {
 
 func (x *TopTeamsMessage) Reset() {
 	*x = TopTeamsMessage{}
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[50]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5560,7 +6255,7 @@ func (x *TopTeamsMessage) String() string {
 func (*TopTeamsMessage) ProtoMessage() {}
 
 func (x *TopTeamsMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_sofascore_v1_sofascore_proto_msgTypes[50]
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5573,7 +6268,7 @@ func (x *TopTeamsMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopTeamsMessage.ProtoReflect.Descriptor instead.
 func (*TopTeamsMessage) Descriptor() ([]byte, []int) {
-	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{50}
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *TopTeamsMessage) GetTopTeams() *TopTeams {
@@ -5583,6 +6278,174 @@ func (x *TopTeamsMessage) GetTopTeams() *TopTeams {
 	return nil
 }
 
+type MatchH2HMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      int32                  `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	LeagueId      int32                  `protobuf:"varint,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	MatchId       int32                  `protobuf:"varint,3,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeTeamId    int32                  `protobuf:"varint,4,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	AwayTeamId    int32                  `protobuf:"varint,5,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
+	H2H           *MatchH2H              `protobuf:"bytes,6,opt,name=h2h,proto3" json:"h2h,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchH2HMessage) Reset() {
+	*x = MatchH2HMessage{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchH2HMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchH2HMessage) ProtoMessage() {}
+
+func (x *MatchH2HMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchH2HMessage.ProtoReflect.Descriptor instead.
+func (*MatchH2HMessage) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MatchH2HMessage) GetSeasonId() int32 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *MatchH2HMessage) GetLeagueId() int32 {
+	if x != nil {
+		return x.LeagueId
+	}
+	return 0
+}
+
+func (x *MatchH2HMessage) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *MatchH2HMessage) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *MatchH2HMessage) GetAwayTeamId() int32 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *MatchH2HMessage) GetH2H() *MatchH2H {
+	if x != nil {
+		return x.H2H
+	}
+	return nil
+}
+
+type MatchBestPlayersMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeasonId      int32                  `protobuf:"varint,1,opt,name=season_id,json=seasonId,proto3" json:"season_id,omitempty"`
+	LeagueId      int32                  `protobuf:"varint,2,opt,name=league_id,json=leagueId,proto3" json:"league_id,omitempty"`
+	MatchId       int32                  `protobuf:"varint,3,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	HomeTeamId    int32                  `protobuf:"varint,4,opt,name=home_team_id,json=homeTeamId,proto3" json:"home_team_id,omitempty"`
+	AwayTeamId    int32                  `protobuf:"varint,5,opt,name=away_team_id,json=awayTeamId,proto3" json:"away_team_id,omitempty"`
+	BestPlayers   *MatchBestPlayers      `protobuf:"bytes,6,opt,name=best_players,json=bestPlayers,proto3" json:"best_players,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchBestPlayersMessage) Reset() {
+	*x = MatchBestPlayersMessage{}
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchBestPlayersMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchBestPlayersMessage) ProtoMessage() {}
+
+func (x *MatchBestPlayersMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_sofascore_v1_sofascore_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchBestPlayersMessage.ProtoReflect.Descriptor instead.
+func (*MatchBestPlayersMessage) Descriptor() ([]byte, []int) {
+	return file_sofascore_v1_sofascore_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *MatchBestPlayersMessage) GetSeasonId() int32 {
+	if x != nil {
+		return x.SeasonId
+	}
+	return 0
+}
+
+func (x *MatchBestPlayersMessage) GetLeagueId() int32 {
+	if x != nil {
+		return x.LeagueId
+	}
+	return 0
+}
+
+func (x *MatchBestPlayersMessage) GetMatchId() int32 {
+	if x != nil {
+		return x.MatchId
+	}
+	return 0
+}
+
+func (x *MatchBestPlayersMessage) GetHomeTeamId() int32 {
+	if x != nil {
+		return x.HomeTeamId
+	}
+	return 0
+}
+
+func (x *MatchBestPlayersMessage) GetAwayTeamId() int32 {
+	if x != nil {
+		return x.AwayTeamId
+	}
+	return 0
+}
+
+func (x *MatchBestPlayersMessage) GetBestPlayers() *MatchBestPlayers {
+	if x != nil {
+		return x.BestPlayers
+	}
+	return nil
+}
+
 var File_sofascore_v1_sofascore_proto protoreflect.FileDescriptor
 
 const file_sofascore_v1_sofascore_proto_rawDesc = "" +
@@ -5619,7 +6482,7 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"tournament\x18\a \x01(\v2\x18.sofascore.v1.TournamentR\n" +
 	"tournament\x12\x1d\n" +
 	"\n" +
-	"user_count\x18\b \x01(\bR\tuserCount\x12\x12\n" +
+	"user_count\x18\b \x01(\x05R\tuserCount\x12\x12\n" +
 	"\x04slug\x18\t \x01(\tR\x04slug\x12\x16\n" +
 	"\x06gender\x18\n" +
 	" \x01(\tR\x06gender\"\x8a\x03\n" +
@@ -5689,13 +6552,16 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
 	"\x04year\x18\x04 \x01(\tR\x04year\x12\x1d\n" +
 	"\n" +
-	"is_current\x18\x05 \x01(\bR\tisCurrent\"!\n" +
+	"is_current\x18\x05 \x01(\bR\tisCurrent\"o\n" +
 	"\tRoundInfo\x12\x14\n" +
-	"\x05round\x18\x01 \x01(\x05R\x05round\"]\n" +
+	"\x05round\x18\x01 \x01(\x05R\x05round\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12$\n" +
+	"\x0ecup_round_type\x18\x04 \x01(\x05R\fcupRoundType\"]\n" +
 	"\n" +
 	"EventsPage\x12+\n" +
 	"\x06events\x18\x01 \x03(\v2\x13.sofascore.v1.EventR\x06events\x12\"\n" +
-	"\rhas_next_page\x18\x02 \x01(\bR\vhasNextPage\"\xf0\x02\n" +
+	"\rhas_next_page\x18\x02 \x01(\bR\vhasNextPage\"\xd8\x03\n" +
 	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x128\n" +
 	"\n" +
@@ -5707,7 +6573,29 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"\thome_team\x18\x05 \x01(\v2\x12.sofascore.v1.TeamR\bhomeTeam\x12/\n" +
 	"\taway_team\x18\x06 \x01(\v2\x12.sofascore.v1.TeamR\bawayTeam\x12,\n" +
 	"\x06status\x18\a \x01(\v2\x14.sofascore.v1.StatusR\x06status\x12'\n" +
-	"\x0fstart_timestamp\x18\b \x01(\x03R\x0estartTimestamp\"P\n" +
+	"\x0fstart_timestamp\x18\b \x01(\x03R\x0estartTimestamp\x122\n" +
+	"\n" +
+	"home_score\x18\t \x01(\v2\x13.sofascore.v1.ScoreR\thomeScore\x122\n" +
+	"\n" +
+	"away_score\x18\n" +
+	" \x01(\v2\x13.sofascore.v1.ScoreR\tawayScore\"\xe7\x01\n" +
+	"\x05Score\x12\x1d\n" +
+	"\acurrent\x18\x01 \x01(\x05H\x00R\acurrent\x88\x01\x01\x12\x1d\n" +
+	"\adisplay\x18\x02 \x01(\x05H\x01R\adisplay\x88\x01\x01\x12\x1d\n" +
+	"\aperiod1\x18\x03 \x01(\x05H\x02R\aperiod1\x88\x01\x01\x12\x1d\n" +
+	"\aperiod2\x18\x04 \x01(\x05H\x03R\aperiod2\x88\x01\x01\x12#\n" +
+	"\n" +
+	"normaltime\x18\x05 \x01(\x05H\x04R\n" +
+	"normaltime\x88\x01\x01B\n" +
+	"\n" +
+	"\b_currentB\n" +
+	"\n" +
+	"\b_displayB\n" +
+	"\n" +
+	"\b_period1B\n" +
+	"\n" +
+	"\b_period2B\r\n" +
+	"\v_normaltime\"P\n" +
 	"\x10LeagueCategories\x12<\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x1c.sofascore.v1.LeagueCategoryR\n" +
@@ -5746,7 +6634,23 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"\x06reason\x18\x03 \x01(\x05R\x06reason\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
 	"\rexternal_type\x18\x05 \x01(\x05R\fexternalType\x12*\n" +
-	"\x11expected_end_date\x18\x06 \x01(\tR\x0fexpectedEndDate\"H\n" +
+	"\x11expected_end_date\x18\x06 \x01(\tR\x0fexpectedEndDate\"Y\n" +
+	"\aH2hDuel\x12\x1b\n" +
+	"\thome_wins\x18\x01 \x01(\x05R\bhomeWins\x12\x1b\n" +
+	"\taway_wins\x18\x02 \x01(\x05R\bawayWins\x12\x14\n" +
+	"\x05draws\x18\x03 \x01(\x05R\x05draws\"x\n" +
+	"\bMatchH2h\x122\n" +
+	"\tteam_duel\x18\x01 \x01(\v2\x15.sofascore.v1.H2hDuelR\bteamDuel\x128\n" +
+	"\fmanager_duel\x18\x02 \x01(\v2\x15.sofascore.v1.H2hDuelR\vmanagerDuel\"f\n" +
+	"\n" +
+	"BestPlayer\x12,\n" +
+	"\x06player\x18\x01 \x01(\v2\x14.sofascore.v1.PlayerR\x06player\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\"\xf9\x01\n" +
+	"\x10MatchBestPlayers\x12M\n" +
+	"\x16best_home_team_players\x18\x01 \x03(\v2\x18.sofascore.v1.BestPlayerR\x13bestHomeTeamPlayers\x12M\n" +
+	"\x16best_away_team_players\x18\x02 \x03(\v2\x18.sofascore.v1.BestPlayerR\x13bestAwayTeamPlayers\x12G\n" +
+	"\x13player_of_the_match\x18\x03 \x01(\v2\x18.sofascore.v1.BestPlayerR\x10playerOfTheMatch\"H\n" +
 	"\n" +
 	"MatchStats\x12:\n" +
 	"\n" +
@@ -5852,15 +6756,42 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"\boriginal\x18\x01 \x01(\x01R\boriginal\x12 \n" +
 	"\valternative\x18\x02 \x01(\x01R\valternative\"O\n" +
 	"\x12PlayerSeasonsStats\x129\n" +
-	"\aseasons\x18\x01 \x03(\v2\x1f.sofascore.v1.PlayerSeasonStatsR\aseasons\"\xd4\x01\n" +
+	"\aseasons\x18\x01 \x03(\v2\x1f.sofascore.v1.PlayerSeasonStatsR\aseasons\"\xe5\x02\n" +
 	"\x11PlayerSeasonStats\x12\x1b\n" +
 	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12\x12\n" +
 	"\x04year\x18\x02 \x01(\tR\x04year\x12\x1d\n" +
 	"\n" +
 	"start_year\x18\x03 \x01(\x05R\tstartYear\x12\x19\n" +
-	"\bend_year\x18\x04 \x01(\tR\aendYear\x12&\n" +
+	"\bend_year\x18\x04 \x01(\x05R\aendYear\x12&\n" +
 	"\x04team\x18\x05 \x01(\v2\x12.sofascore.v1.TeamR\x04team\x12,\n" +
-	"\x06season\x18\x06 \x01(\v2\x14.sofascore.v1.SeasonR\x06season\"\xbb\v\n" +
+	"\x06season\x18\x06 \x01(\v2\x14.sofascore.v1.SeasonR\x06season\x12B\n" +
+	"\n" +
+	"statistics\x18\a \x01(\v2\".sofascore.v1.AggregatedStatisticsR\n" +
+	"statistics\x12K\n" +
+	"\x11unique_tournament\x18\b \x01(\v2\x1e.sofascore.v1.UniqueTournamentR\x10uniqueTournament\"n\n" +
+	"\x18PlayerSeasonStatsMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x125\n" +
+	"\x05stats\x18\x02 \x01(\v2\x1f.sofascore.v1.PlayerSeasonStatsR\x05stats\"\xfa\x01\n" +
+	"\x17PlayerAttributeOverview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1c\n" +
+	"\tattacking\x18\x02 \x01(\x05R\tattacking\x12\x1c\n" +
+	"\ttechnical\x18\x03 \x01(\x05R\ttechnical\x12\x1a\n" +
+	"\btactical\x18\x04 \x01(\x05R\btactical\x12\x1c\n" +
+	"\tdefending\x18\x05 \x01(\x05R\tdefending\x12\x1e\n" +
+	"\n" +
+	"creativity\x18\x06 \x01(\x05R\n" +
+	"creativity\x12\x1a\n" +
+	"\bposition\x18\a \x01(\tR\bposition\x12\x1d\n" +
+	"\n" +
+	"year_shift\x18\b \x01(\x05R\tyearShift\"\xde\x01\n" +
+	"\x10PlayerAttributes\x12c\n" +
+	"\x1aplayer_attribute_overviews\x18\x01 \x03(\v2%.sofascore.v1.PlayerAttributeOverviewR\x18playerAttributeOverviews\x12e\n" +
+	"\x1baverage_attribute_overviews\x18\x02 \x03(\v2%.sofascore.v1.PlayerAttributeOverviewR\x19averageAttributeOverviews\"v\n" +
+	"\x17PlayerAttributesMessage\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\x05R\bplayerId\x12>\n" +
+	"\n" +
+	"attributes\x18\x02 \x01(\v2\x1e.sofascore.v1.PlayerAttributesR\n" +
+	"attributes\"\xc7\f\n" +
 	"\x14AggregatedStatistics\x12.\n" +
 	"\x13accurate_long_balls\x18\x01 \x01(\x05R\x11accurateLongBalls\x12C\n" +
 	"\x1eaccurate_long_balls_percentage\x18\x02 \x01(\x01R\x1baccurateLongBallsPercentage\x12'\n" +
@@ -5896,14 +6827,18 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"\vtotal_shots\x18\x1d \x01(\x05R\n" +
 	"totalShots\x12!\n" +
 	"\fyellow_cards\x18\x1e \x01(\x05R\vyellowCards\x12!\n" +
-	"\ftotal_rating\x18\x1f \x01(\x05R\vtotalRating\x12!\n" +
+	"\ftotal_rating\x18\x1f \x01(\x01R\vtotalRating\x12!\n" +
 	"\fcount_rating\x18  \x01(\x05R\vcountRating\x12(\n" +
 	"\x10total_long_balls\x18! \x01(\x05R\x0etotalLongBalls\x12!\n" +
 	"\ftotal_passes\x18\" \x01(\x05R\vtotalPasses\x128\n" +
 	"\x19shots_from_inside_the_box\x18# \x01(\x05R\x15shotsFromInsideTheBox\x12 \n" +
 	"\vappearances\x18$ \x01(\x05R\vappearances\x12\x12\n" +
 	"\x04type\x18% \x01(\tR\x04type\x12\x0e\n" +
-	"\x02id\x18& \x01(\x05R\x02id\"A\n" +
+	"\x02id\x18& \x01(\x05R\x02id\x12)\n" +
+	"\x10accurate_crosses\x18' \x01(\x05R\x0faccurateCrosses\x12>\n" +
+	"\x1baccurate_crosses_percentage\x18( \x01(\x01R\x19accurateCrossesPercentage\x12\x1f\n" +
+	"\vtotal_cross\x18) \x01(\x05R\n" +
+	"totalCross\"A\n" +
 	"\tStandings\x124\n" +
 	"\tstandings\x18\x01 \x03(\v2\x16.sofascore.v1.StandingR\tstandings\"9\n" +
 	"\bStanding\x12-\n" +
@@ -6097,7 +7032,7 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"\vbig_chances\x18\x04 \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\n" +
 	"bigChances\x12K\n" +
 	"\x12big_chances_missed\x18\x05 \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\x10bigChancesMissed\x12A\n" +
-	"\rhit_wood_work\x18\x06 \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\vhitWoodWork\x12@\n" +
+	"\rhit_wood_work\x18\x06 \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\vhitWoodwork\x12@\n" +
 	"\fyellow_cards\x18\a \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\vyellowCards\x12:\n" +
 	"\tred_cards\x18\b \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\bredCards\x12U\n" +
 	"\x17average_ball_possession\x18\t \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\x15averageBallPossession\x12F\n" +
@@ -6117,14 +7052,18 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"\x05fouls\x18\x14 \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\x05fouls\x12B\n" +
 	"\rpenalty_goals\x18\x15 \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\fpenaltyGoals\x12S\n" +
 	"\x16penalty_goals_conceded\x18\x16 \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\x14penaltyGoalsConceded\x12@\n" +
-	"\fclean_sheets\x18\x17 \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\vcleanSheets\"\xbc\x01\n" +
+	"\fclean_sheets\x18\x17 \x03(\v2\x1d.sofascore.v1.TopTeamStatItemR\vcleanSheets\"\x85\x02\n" +
 	"\x0fTopTeamStatItem\x12\x1b\n" +
 	"\tseason_id\x18\x01 \x01(\x05R\bseasonId\x12\x1b\n" +
 	"\tleague_id\x18\x02 \x01(\x05R\bleagueId\x12&\n" +
 	"\x04team\x18\x03 \x01(\v2\x12.sofascore.v1.TeamR\x04team\x12G\n" +
 	"\n" +
 	"statistics\x18\x04 \x01(\v2'.sofascore.v1.TeamLeaderboardStatisticsR\n" +
-	"statistics\"\xbb\a\n" +
+	"statistics\x12\x1d\n" +
+	"\n" +
+	"stat_label\x18\x05 \x01(\tR\tstatLabel\x12\x12\n" +
+	"\x04rank\x18\x06 \x01(\x05R\x04rank\x12\x14\n" +
+	"\x05value\x18\a \x01(\x01R\x05value\"\xbf\a\n" +
 	"\x19TeamLeaderboardStatistics\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
 	"\amatches\x18\x02 \x01(\x05R\amatches\x12'\n" +
@@ -6136,11 +7075,11 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"\vbig_chances\x18\a \x01(\x05R\n" +
 	"bigChances\x12,\n" +
 	"\x12big_chances_missed\x18\b \x01(\x05R\x10bigChancesMissed\x12\"\n" +
-	"\rhit_wood_work\x18\t \x01(\x05R\vhitWoodWork\x12!\n" +
+	"\rhit_wood_work\x18\t \x01(\x05R\vhitWoodwork\x12!\n" +
 	"\fyellow_cards\x18\n" +
 	" \x01(\x05R\vyellowCards\x12\x1b\n" +
-	"\tred_cards\x18\v \x01(\x05R\bredCards\x12.\n" +
-	"\x13avg_ball_possession\x18\f \x01(\x01R\x11avgBallPossession\x12'\n" +
+	"\tred_cards\x18\v \x01(\x05R\bredCards\x122\n" +
+	"\x13avg_ball_possession\x18\f \x01(\x01R\x15averageBallPossession\x12'\n" +
 	"\x0faccurate_passes\x18\r \x01(\x05R\x0eaccuratePasses\x12.\n" +
 	"\x13accurate_long_balls\x18\x0e \x01(\x05R\x11accurateLongBalls\x12)\n" +
 	"\x10accurate_crosses\x18\x0f \x01(\x05R\x0faccurateCrosses\x12\x14\n" +
@@ -6166,13 +7105,17 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"\tleague_id\x18\x02 \x01(\x05R\bleagueId\x12\x17\n" +
 	"\ateam_id\x18\x03 \x01(\x05R\x06teamId\x12\x1b\n" +
 	"\tteam_name\x18\x04 \x01(\tR\bteamName\x12,\n" +
-	"\x06player\x18\x05 \x01(\v2\x14.sofascore.v1.PlayerR\x06player\"\xb2\x01\n" +
+	"\x06player\x18\x05 \x01(\v2\x14.sofascore.v1.PlayerR\x06player\"\xf6\x01\n" +
 	"\x12MatchLineupMessage\x12\x1b\n" +
 	"\tseason_id\x18\x01 \x01(\x05R\bseasonId\x12\x1b\n" +
 	"\tleague_id\x18\x02 \x01(\x05R\bleagueId\x12\x19\n" +
 	"\bmatch_id\x18\x03 \x01(\x05R\amatchId\x12\x14\n" +
 	"\x05round\x18\x04 \x01(\x05R\x05round\x121\n" +
-	"\x06lineup\x18\x05 \x01(\v2\x19.sofascore.v1.MatchLineupR\x06lineup\"\xd8\x01\n" +
+	"\x06lineup\x18\x05 \x01(\v2\x19.sofascore.v1.MatchLineupR\x06lineup\x12 \n" +
+	"\fhome_team_id\x18\x06 \x01(\x05R\n" +
+	"homeTeamId\x12 \n" +
+	"\faway_team_id\x18\a \x01(\x05R\n" +
+	"awayTeamId\"\xd8\x01\n" +
 	"\x17PlayerMatchStatsMessage\x12\x1f\n" +
 	"\vplayer_name\x18\x01 \x01(\tR\n" +
 	"playerName\x12\x1b\n" +
@@ -6203,7 +7146,25 @@ const file_sofascore_v1_sofascore_proto_rawDesc = "" +
 	"statistics\x18\x04 \x01(\v2\x1e.sofascore.v1.TeamOverallStatsR\n" +
 	"statistics\"F\n" +
 	"\x0fTopTeamsMessage\x123\n" +
-	"\ttop_teams\x18\x01 \x01(\v2\x16.sofascore.v1.TopTeamsR\btopTeamsBIZGgithub.com/imadeddine-belkat/tactify-protos/go/sofascore/v1;sofascorev1b\x06proto3"
+	"\ttop_teams\x18\x01 \x01(\v2\x16.sofascore.v1.TopTeamsR\btopTeams\"\xd4\x01\n" +
+	"\x0fMatchH2hMessage\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\x05R\bseasonId\x12\x1b\n" +
+	"\tleague_id\x18\x02 \x01(\x05R\bleagueId\x12\x19\n" +
+	"\bmatch_id\x18\x03 \x01(\x05R\amatchId\x12 \n" +
+	"\fhome_team_id\x18\x04 \x01(\x05R\n" +
+	"homeTeamId\x12 \n" +
+	"\faway_team_id\x18\x05 \x01(\x05R\n" +
+	"awayTeamId\x12(\n" +
+	"\x03h2h\x18\x06 \x01(\v2\x16.sofascore.v1.MatchH2hR\x03h2h\"\xf5\x01\n" +
+	"\x17MatchBestPlayersMessage\x12\x1b\n" +
+	"\tseason_id\x18\x01 \x01(\x05R\bseasonId\x12\x1b\n" +
+	"\tleague_id\x18\x02 \x01(\x05R\bleagueId\x12\x19\n" +
+	"\bmatch_id\x18\x03 \x01(\x05R\amatchId\x12 \n" +
+	"\fhome_team_id\x18\x04 \x01(\x05R\n" +
+	"homeTeamId\x12 \n" +
+	"\faway_team_id\x18\x05 \x01(\x05R\n" +
+	"awayTeamId\x12A\n" +
+	"\fbest_players\x18\x06 \x01(\v2\x1e.sofascore.v1.MatchBestPlayersR\vbestPlayersBIZGgithub.com/imadeddine-belkat/tactify-protos/go/sofascore/v1;sofascorev1b\x06proto3"
 
 var (
 	file_sofascore_v1_sofascore_proto_rawDescOnce sync.Once
@@ -6217,7 +7178,7 @@ func file_sofascore_v1_sofascore_proto_rawDescGZIP() []byte {
 	return file_sofascore_v1_sofascore_proto_rawDescData
 }
 
-var file_sofascore_v1_sofascore_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
+var file_sofascore_v1_sofascore_proto_msgTypes = make([]protoimpl.MessageInfo, 64)
 var file_sofascore_v1_sofascore_proto_goTypes = []any{
 	(*Country)(nil),                   // 0: sofascore.v1.Country
 	(*TeamColors)(nil),                // 1: sofascore.v1.TeamColors
@@ -6235,43 +7196,54 @@ var file_sofascore_v1_sofascore_proto_goTypes = []any{
 	(*RoundInfo)(nil),                 // 13: sofascore.v1.RoundInfo
 	(*EventsPage)(nil),                // 14: sofascore.v1.EventsPage
 	(*Event)(nil),                     // 15: sofascore.v1.Event
-	(*LeagueCategories)(nil),          // 16: sofascore.v1.LeagueCategories
-	(*LeagueUniqueTournaments)(nil),   // 17: sofascore.v1.LeagueUniqueTournaments
-	(*UniqueTournamentGroups)(nil),    // 18: sofascore.v1.UniqueTournamentGroups
-	(*MatchLineup)(nil),               // 19: sofascore.v1.MatchLineup
-	(*TeamLineup)(nil),                // 20: sofascore.v1.TeamLineup
-	(*MatchPlayer)(nil),               // 21: sofascore.v1.MatchPlayer
-	(*MissingPlayer)(nil),             // 22: sofascore.v1.MissingPlayer
-	(*MatchStats)(nil),                // 23: sofascore.v1.MatchStats
-	(*MatchPeriods)(nil),              // 24: sofascore.v1.MatchPeriods
-	(*StatsGroup)(nil),                // 25: sofascore.v1.StatsGroup
-	(*StatsItem)(nil),                 // 26: sofascore.v1.StatsItem
-	(*PlayerStatistics)(nil),          // 27: sofascore.v1.PlayerStatistics
-	(*RatingVersions)(nil),            // 28: sofascore.v1.RatingVersions
-	(*PlayerSeasonsStats)(nil),        // 29: sofascore.v1.PlayerSeasonsStats
-	(*PlayerSeasonStats)(nil),         // 30: sofascore.v1.PlayerSeasonStats
-	(*AggregatedStatistics)(nil),      // 31: sofascore.v1.AggregatedStatistics
-	(*Standings)(nil),                 // 32: sofascore.v1.Standings
-	(*Standing)(nil),                  // 33: sofascore.v1.Standing
-	(*StandingRow)(nil),               // 34: sofascore.v1.StandingRow
-	(*TeamPlayers)(nil),               // 35: sofascore.v1.TeamPlayers
-	(*TeamPlayerItem)(nil),            // 36: sofascore.v1.TeamPlayerItem
-	(*TeamOverallStats)(nil),          // 37: sofascore.v1.TeamOverallStats
-	(*TopPlayers)(nil),                // 38: sofascore.v1.TopPlayers
-	(*TopPlayersStats)(nil),           // 39: sofascore.v1.TopPlayersStats
-	(*PlayerLeaderboardItem)(nil),     // 40: sofascore.v1.PlayerLeaderboardItem
-	(*TopTeams)(nil),                  // 41: sofascore.v1.TopTeams
-	(*TopTeamStatItem)(nil),           // 42: sofascore.v1.TopTeamStatItem
-	(*TeamLeaderboardStatistics)(nil), // 43: sofascore.v1.TeamLeaderboardStatistics
-	(*StandingMessage)(nil),           // 44: sofascore.v1.StandingMessage
-	(*PlayerMessage)(nil),             // 45: sofascore.v1.PlayerMessage
-	(*MatchLineupMessage)(nil),        // 46: sofascore.v1.MatchLineupMessage
-	(*PlayerMatchStatsMessage)(nil),   // 47: sofascore.v1.PlayerMatchStatsMessage
-	(*MatchStatsMessage)(nil),         // 48: sofascore.v1.MatchStatsMessage
-	(*TeamOverallStatsMessage)(nil),   // 49: sofascore.v1.TeamOverallStatsMessage
-	(*TopTeamsMessage)(nil),           // 50: sofascore.v1.TopTeamsMessage
-	nil,                               // 51: sofascore.v1.FieldTranslations.NameTranslationEntry
-	nil,                               // 52: sofascore.v1.FieldTranslations.ShortNameTranslationEntry
+	(*Score)(nil),                     // 16: sofascore.v1.Score
+	(*LeagueCategories)(nil),          // 17: sofascore.v1.LeagueCategories
+	(*LeagueUniqueTournaments)(nil),   // 18: sofascore.v1.LeagueUniqueTournaments
+	(*UniqueTournamentGroups)(nil),    // 19: sofascore.v1.UniqueTournamentGroups
+	(*MatchLineup)(nil),               // 20: sofascore.v1.MatchLineup
+	(*TeamLineup)(nil),                // 21: sofascore.v1.TeamLineup
+	(*MatchPlayer)(nil),               // 22: sofascore.v1.MatchPlayer
+	(*MissingPlayer)(nil),             // 23: sofascore.v1.MissingPlayer
+	(*H2HDuel)(nil),                   // 24: sofascore.v1.H2hDuel
+	(*MatchH2H)(nil),                  // 25: sofascore.v1.MatchH2h
+	(*BestPlayer)(nil),                // 26: sofascore.v1.BestPlayer
+	(*MatchBestPlayers)(nil),          // 27: sofascore.v1.MatchBestPlayers
+	(*MatchStats)(nil),                // 28: sofascore.v1.MatchStats
+	(*MatchPeriods)(nil),              // 29: sofascore.v1.MatchPeriods
+	(*StatsGroup)(nil),                // 30: sofascore.v1.StatsGroup
+	(*StatsItem)(nil),                 // 31: sofascore.v1.StatsItem
+	(*PlayerStatistics)(nil),          // 32: sofascore.v1.PlayerStatistics
+	(*RatingVersions)(nil),            // 33: sofascore.v1.RatingVersions
+	(*PlayerSeasonsStats)(nil),        // 34: sofascore.v1.PlayerSeasonsStats
+	(*PlayerSeasonStats)(nil),         // 35: sofascore.v1.PlayerSeasonStats
+	(*PlayerSeasonStatsMessage)(nil),  // 36: sofascore.v1.PlayerSeasonStatsMessage
+	(*PlayerAttributeOverview)(nil),   // 37: sofascore.v1.PlayerAttributeOverview
+	(*PlayerAttributes)(nil),          // 38: sofascore.v1.PlayerAttributes
+	(*PlayerAttributesMessage)(nil),   // 39: sofascore.v1.PlayerAttributesMessage
+	(*AggregatedStatistics)(nil),      // 40: sofascore.v1.AggregatedStatistics
+	(*Standings)(nil),                 // 41: sofascore.v1.Standings
+	(*Standing)(nil),                  // 42: sofascore.v1.Standing
+	(*StandingRow)(nil),               // 43: sofascore.v1.StandingRow
+	(*TeamPlayers)(nil),               // 44: sofascore.v1.TeamPlayers
+	(*TeamPlayerItem)(nil),            // 45: sofascore.v1.TeamPlayerItem
+	(*TeamOverallStats)(nil),          // 46: sofascore.v1.TeamOverallStats
+	(*TopPlayers)(nil),                // 47: sofascore.v1.TopPlayers
+	(*TopPlayersStats)(nil),           // 48: sofascore.v1.TopPlayersStats
+	(*PlayerLeaderboardItem)(nil),     // 49: sofascore.v1.PlayerLeaderboardItem
+	(*TopTeams)(nil),                  // 50: sofascore.v1.TopTeams
+	(*TopTeamStatItem)(nil),           // 51: sofascore.v1.TopTeamStatItem
+	(*TeamLeaderboardStatistics)(nil), // 52: sofascore.v1.TeamLeaderboardStatistics
+	(*StandingMessage)(nil),           // 53: sofascore.v1.StandingMessage
+	(*PlayerMessage)(nil),             // 54: sofascore.v1.PlayerMessage
+	(*MatchLineupMessage)(nil),        // 55: sofascore.v1.MatchLineupMessage
+	(*PlayerMatchStatsMessage)(nil),   // 56: sofascore.v1.PlayerMatchStatsMessage
+	(*MatchStatsMessage)(nil),         // 57: sofascore.v1.MatchStatsMessage
+	(*TeamOverallStatsMessage)(nil),   // 58: sofascore.v1.TeamOverallStatsMessage
+	(*TopTeamsMessage)(nil),           // 59: sofascore.v1.TopTeamsMessage
+	(*MatchH2HMessage)(nil),           // 60: sofascore.v1.MatchH2hMessage
+	(*MatchBestPlayersMessage)(nil),   // 61: sofascore.v1.MatchBestPlayersMessage
+	nil,                               // 62: sofascore.v1.FieldTranslations.NameTranslationEntry
+	nil,                               // 63: sofascore.v1.FieldTranslations.ShortNameTranslationEntry
 }
 var file_sofascore_v1_sofascore_proto_depIdxs = []int32{
 	0,   // 0: sofascore.v1.Team.country:type_name -> sofascore.v1.Country
@@ -6282,8 +7254,8 @@ var file_sofascore_v1_sofascore_proto_depIdxs = []int32{
 	0,   // 5: sofascore.v1.LineupPlayer.country:type_name -> sofascore.v1.Country
 	7,   // 6: sofascore.v1.LineupPlayer.proposed_market_value_raw:type_name -> sofascore.v1.ProposedMarketValue
 	8,   // 7: sofascore.v1.LineupPlayer.field_translations:type_name -> sofascore.v1.FieldTranslations
-	51,  // 8: sofascore.v1.FieldTranslations.name_translation:type_name -> sofascore.v1.FieldTranslations.NameTranslationEntry
-	52,  // 9: sofascore.v1.FieldTranslations.short_name_translation:type_name -> sofascore.v1.FieldTranslations.ShortNameTranslationEntry
+	62,  // 8: sofascore.v1.FieldTranslations.name_translation:type_name -> sofascore.v1.FieldTranslations.NameTranslationEntry
+	63,  // 9: sofascore.v1.FieldTranslations.short_name_translation:type_name -> sofascore.v1.FieldTranslations.ShortNameTranslationEntry
 	10,  // 10: sofascore.v1.Tournament.unique_tournament:type_name -> sofascore.v1.UniqueTournament
 	11,  // 11: sofascore.v1.UniqueTournament.category:type_name -> sofascore.v1.LeagueCategory
 	15,  // 12: sofascore.v1.EventsPage.events:type_name -> sofascore.v1.Event
@@ -6293,95 +7265,111 @@ var file_sofascore_v1_sofascore_proto_depIdxs = []int32{
 	4,   // 16: sofascore.v1.Event.home_team:type_name -> sofascore.v1.Team
 	4,   // 17: sofascore.v1.Event.away_team:type_name -> sofascore.v1.Team
 	3,   // 18: sofascore.v1.Event.status:type_name -> sofascore.v1.Status
-	11,  // 19: sofascore.v1.LeagueCategories.categories:type_name -> sofascore.v1.LeagueCategory
-	18,  // 20: sofascore.v1.LeagueUniqueTournaments.groups:type_name -> sofascore.v1.UniqueTournamentGroups
-	10,  // 21: sofascore.v1.UniqueTournamentGroups.unique_tournaments:type_name -> sofascore.v1.UniqueTournament
-	20,  // 22: sofascore.v1.MatchLineup.home:type_name -> sofascore.v1.TeamLineup
-	20,  // 23: sofascore.v1.MatchLineup.away:type_name -> sofascore.v1.TeamLineup
-	21,  // 24: sofascore.v1.TeamLineup.players:type_name -> sofascore.v1.MatchPlayer
-	2,   // 25: sofascore.v1.TeamLineup.player_color:type_name -> sofascore.v1.PlayerColor
-	2,   // 26: sofascore.v1.TeamLineup.goalkeeper_color:type_name -> sofascore.v1.PlayerColor
-	22,  // 27: sofascore.v1.TeamLineup.missing_players:type_name -> sofascore.v1.MissingPlayer
-	5,   // 28: sofascore.v1.MatchPlayer.player:type_name -> sofascore.v1.Player
-	27,  // 29: sofascore.v1.MatchPlayer.statistics:type_name -> sofascore.v1.PlayerStatistics
-	5,   // 30: sofascore.v1.MissingPlayer.player:type_name -> sofascore.v1.Player
-	24,  // 31: sofascore.v1.MatchStats.statistics:type_name -> sofascore.v1.MatchPeriods
-	25,  // 32: sofascore.v1.MatchPeriods.groups:type_name -> sofascore.v1.StatsGroup
-	26,  // 33: sofascore.v1.StatsGroup.statistics_items:type_name -> sofascore.v1.StatsItem
-	28,  // 34: sofascore.v1.PlayerStatistics.rating_versions:type_name -> sofascore.v1.RatingVersions
-	30,  // 35: sofascore.v1.PlayerSeasonsStats.seasons:type_name -> sofascore.v1.PlayerSeasonStats
-	4,   // 36: sofascore.v1.PlayerSeasonStats.team:type_name -> sofascore.v1.Team
-	12,  // 37: sofascore.v1.PlayerSeasonStats.season:type_name -> sofascore.v1.Season
-	33,  // 38: sofascore.v1.Standings.standings:type_name -> sofascore.v1.Standing
-	34,  // 39: sofascore.v1.Standing.rows:type_name -> sofascore.v1.StandingRow
-	4,   // 40: sofascore.v1.StandingRow.team:type_name -> sofascore.v1.Team
-	36,  // 41: sofascore.v1.TeamPlayers.players:type_name -> sofascore.v1.TeamPlayerItem
-	5,   // 42: sofascore.v1.TeamPlayerItem.player:type_name -> sofascore.v1.Player
-	39,  // 43: sofascore.v1.TopPlayers.top_players:type_name -> sofascore.v1.TopPlayersStats
-	40,  // 44: sofascore.v1.TopPlayersStats.rating:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 45: sofascore.v1.TopPlayersStats.goals:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 46: sofascore.v1.TopPlayersStats.assists:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 47: sofascore.v1.TopPlayersStats.expected_goals:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 48: sofascore.v1.TopPlayersStats.expected_assists:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 49: sofascore.v1.TopPlayersStats.goals_assists_sum:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 50: sofascore.v1.TopPlayersStats.penalty_goals:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 51: sofascore.v1.TopPlayersStats.free_kick_goal:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 52: sofascore.v1.TopPlayersStats.scoring_frequency:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 53: sofascore.v1.TopPlayersStats.total_shots:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 54: sofascore.v1.TopPlayersStats.shots_on_target:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 55: sofascore.v1.TopPlayersStats.big_chances_created:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 56: sofascore.v1.TopPlayersStats.big_chances_missed:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 57: sofascore.v1.TopPlayersStats.accurate_passes:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 58: sofascore.v1.TopPlayersStats.key_passes:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 59: sofascore.v1.TopPlayersStats.accurate_long_balls:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 60: sofascore.v1.TopPlayersStats.successful_dribbles:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 61: sofascore.v1.TopPlayersStats.penalties_won:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 62: sofascore.v1.TopPlayersStats.tackles:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 63: sofascore.v1.TopPlayersStats.interceptions:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 64: sofascore.v1.TopPlayersStats.clearances:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 65: sofascore.v1.TopPlayersStats.possessions_lost:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 66: sofascore.v1.TopPlayersStats.yellow_cards:type_name -> sofascore.v1.PlayerLeaderboardItem
-	40,  // 67: sofascore.v1.TopPlayersStats.red_cards:type_name -> sofascore.v1.PlayerLeaderboardItem
-	5,   // 68: sofascore.v1.PlayerLeaderboardItem.player:type_name -> sofascore.v1.Player
-	4,   // 69: sofascore.v1.PlayerLeaderboardItem.team:type_name -> sofascore.v1.Team
-	31,  // 70: sofascore.v1.PlayerLeaderboardItem.statistics:type_name -> sofascore.v1.AggregatedStatistics
-	42,  // 71: sofascore.v1.TopTeams.avg_rating:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 72: sofascore.v1.TopTeams.goals_scored:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 73: sofascore.v1.TopTeams.goals_conceded:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 74: sofascore.v1.TopTeams.big_chances:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 75: sofascore.v1.TopTeams.big_chances_missed:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 76: sofascore.v1.TopTeams.hit_wood_work:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 77: sofascore.v1.TopTeams.yellow_cards:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 78: sofascore.v1.TopTeams.red_cards:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 79: sofascore.v1.TopTeams.average_ball_possession:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 80: sofascore.v1.TopTeams.accurate_passes:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 81: sofascore.v1.TopTeams.accurate_long_balls:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 82: sofascore.v1.TopTeams.accurate_crosses:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 83: sofascore.v1.TopTeams.shots:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 84: sofascore.v1.TopTeams.shots_on_target:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 85: sofascore.v1.TopTeams.successful_dribbles:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 86: sofascore.v1.TopTeams.tackles:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 87: sofascore.v1.TopTeams.interceptions:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 88: sofascore.v1.TopTeams.clearances:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 89: sofascore.v1.TopTeams.corners:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 90: sofascore.v1.TopTeams.fouls:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 91: sofascore.v1.TopTeams.penalty_goals:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 92: sofascore.v1.TopTeams.penalty_goals_conceded:type_name -> sofascore.v1.TopTeamStatItem
-	42,  // 93: sofascore.v1.TopTeams.clean_sheets:type_name -> sofascore.v1.TopTeamStatItem
-	4,   // 94: sofascore.v1.TopTeamStatItem.team:type_name -> sofascore.v1.Team
-	43,  // 95: sofascore.v1.TopTeamStatItem.statistics:type_name -> sofascore.v1.TeamLeaderboardStatistics
-	34,  // 96: sofascore.v1.StandingMessage.row:type_name -> sofascore.v1.StandingRow
-	5,   // 97: sofascore.v1.PlayerMessage.player:type_name -> sofascore.v1.Player
-	19,  // 98: sofascore.v1.MatchLineupMessage.lineup:type_name -> sofascore.v1.MatchLineup
-	21,  // 99: sofascore.v1.PlayerMatchStatsMessage.player:type_name -> sofascore.v1.MatchPlayer
-	26,  // 100: sofascore.v1.MatchStatsMessage.statistics:type_name -> sofascore.v1.StatsItem
-	37,  // 101: sofascore.v1.TeamOverallStatsMessage.statistics:type_name -> sofascore.v1.TeamOverallStats
-	41,  // 102: sofascore.v1.TopTeamsMessage.top_teams:type_name -> sofascore.v1.TopTeams
-	103, // [103:103] is the sub-list for method output_type
-	103, // [103:103] is the sub-list for method input_type
-	103, // [103:103] is the sub-list for extension type_name
-	103, // [103:103] is the sub-list for extension extendee
-	0,   // [0:103] is the sub-list for field type_name
+	16,  // 19: sofascore.v1.Event.home_score:type_name -> sofascore.v1.Score
+	16,  // 20: sofascore.v1.Event.away_score:type_name -> sofascore.v1.Score
+	11,  // 21: sofascore.v1.LeagueCategories.categories:type_name -> sofascore.v1.LeagueCategory
+	19,  // 22: sofascore.v1.LeagueUniqueTournaments.groups:type_name -> sofascore.v1.UniqueTournamentGroups
+	10,  // 23: sofascore.v1.UniqueTournamentGroups.unique_tournaments:type_name -> sofascore.v1.UniqueTournament
+	21,  // 24: sofascore.v1.MatchLineup.home:type_name -> sofascore.v1.TeamLineup
+	21,  // 25: sofascore.v1.MatchLineup.away:type_name -> sofascore.v1.TeamLineup
+	22,  // 26: sofascore.v1.TeamLineup.players:type_name -> sofascore.v1.MatchPlayer
+	2,   // 27: sofascore.v1.TeamLineup.player_color:type_name -> sofascore.v1.PlayerColor
+	2,   // 28: sofascore.v1.TeamLineup.goalkeeper_color:type_name -> sofascore.v1.PlayerColor
+	23,  // 29: sofascore.v1.TeamLineup.missing_players:type_name -> sofascore.v1.MissingPlayer
+	5,   // 30: sofascore.v1.MatchPlayer.player:type_name -> sofascore.v1.Player
+	32,  // 31: sofascore.v1.MatchPlayer.statistics:type_name -> sofascore.v1.PlayerStatistics
+	5,   // 32: sofascore.v1.MissingPlayer.player:type_name -> sofascore.v1.Player
+	24,  // 33: sofascore.v1.MatchH2h.team_duel:type_name -> sofascore.v1.H2hDuel
+	24,  // 34: sofascore.v1.MatchH2h.manager_duel:type_name -> sofascore.v1.H2hDuel
+	5,   // 35: sofascore.v1.BestPlayer.player:type_name -> sofascore.v1.Player
+	26,  // 36: sofascore.v1.MatchBestPlayers.best_home_team_players:type_name -> sofascore.v1.BestPlayer
+	26,  // 37: sofascore.v1.MatchBestPlayers.best_away_team_players:type_name -> sofascore.v1.BestPlayer
+	26,  // 38: sofascore.v1.MatchBestPlayers.player_of_the_match:type_name -> sofascore.v1.BestPlayer
+	29,  // 39: sofascore.v1.MatchStats.statistics:type_name -> sofascore.v1.MatchPeriods
+	30,  // 40: sofascore.v1.MatchPeriods.groups:type_name -> sofascore.v1.StatsGroup
+	31,  // 41: sofascore.v1.StatsGroup.statistics_items:type_name -> sofascore.v1.StatsItem
+	33,  // 42: sofascore.v1.PlayerStatistics.rating_versions:type_name -> sofascore.v1.RatingVersions
+	35,  // 43: sofascore.v1.PlayerSeasonsStats.seasons:type_name -> sofascore.v1.PlayerSeasonStats
+	4,   // 44: sofascore.v1.PlayerSeasonStats.team:type_name -> sofascore.v1.Team
+	12,  // 45: sofascore.v1.PlayerSeasonStats.season:type_name -> sofascore.v1.Season
+	40,  // 46: sofascore.v1.PlayerSeasonStats.statistics:type_name -> sofascore.v1.AggregatedStatistics
+	10,  // 47: sofascore.v1.PlayerSeasonStats.unique_tournament:type_name -> sofascore.v1.UniqueTournament
+	35,  // 48: sofascore.v1.PlayerSeasonStatsMessage.stats:type_name -> sofascore.v1.PlayerSeasonStats
+	37,  // 49: sofascore.v1.PlayerAttributes.player_attribute_overviews:type_name -> sofascore.v1.PlayerAttributeOverview
+	37,  // 50: sofascore.v1.PlayerAttributes.average_attribute_overviews:type_name -> sofascore.v1.PlayerAttributeOverview
+	38,  // 51: sofascore.v1.PlayerAttributesMessage.attributes:type_name -> sofascore.v1.PlayerAttributes
+	42,  // 52: sofascore.v1.Standings.standings:type_name -> sofascore.v1.Standing
+	43,  // 53: sofascore.v1.Standing.rows:type_name -> sofascore.v1.StandingRow
+	4,   // 54: sofascore.v1.StandingRow.team:type_name -> sofascore.v1.Team
+	45,  // 55: sofascore.v1.TeamPlayers.players:type_name -> sofascore.v1.TeamPlayerItem
+	5,   // 56: sofascore.v1.TeamPlayerItem.player:type_name -> sofascore.v1.Player
+	48,  // 57: sofascore.v1.TopPlayers.top_players:type_name -> sofascore.v1.TopPlayersStats
+	49,  // 58: sofascore.v1.TopPlayersStats.rating:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 59: sofascore.v1.TopPlayersStats.goals:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 60: sofascore.v1.TopPlayersStats.assists:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 61: sofascore.v1.TopPlayersStats.expected_goals:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 62: sofascore.v1.TopPlayersStats.expected_assists:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 63: sofascore.v1.TopPlayersStats.goals_assists_sum:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 64: sofascore.v1.TopPlayersStats.penalty_goals:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 65: sofascore.v1.TopPlayersStats.free_kick_goal:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 66: sofascore.v1.TopPlayersStats.scoring_frequency:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 67: sofascore.v1.TopPlayersStats.total_shots:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 68: sofascore.v1.TopPlayersStats.shots_on_target:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 69: sofascore.v1.TopPlayersStats.big_chances_created:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 70: sofascore.v1.TopPlayersStats.big_chances_missed:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 71: sofascore.v1.TopPlayersStats.accurate_passes:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 72: sofascore.v1.TopPlayersStats.key_passes:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 73: sofascore.v1.TopPlayersStats.accurate_long_balls:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 74: sofascore.v1.TopPlayersStats.successful_dribbles:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 75: sofascore.v1.TopPlayersStats.penalties_won:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 76: sofascore.v1.TopPlayersStats.tackles:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 77: sofascore.v1.TopPlayersStats.interceptions:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 78: sofascore.v1.TopPlayersStats.clearances:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 79: sofascore.v1.TopPlayersStats.possessions_lost:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 80: sofascore.v1.TopPlayersStats.yellow_cards:type_name -> sofascore.v1.PlayerLeaderboardItem
+	49,  // 81: sofascore.v1.TopPlayersStats.red_cards:type_name -> sofascore.v1.PlayerLeaderboardItem
+	5,   // 82: sofascore.v1.PlayerLeaderboardItem.player:type_name -> sofascore.v1.Player
+	4,   // 83: sofascore.v1.PlayerLeaderboardItem.team:type_name -> sofascore.v1.Team
+	40,  // 84: sofascore.v1.PlayerLeaderboardItem.statistics:type_name -> sofascore.v1.AggregatedStatistics
+	51,  // 85: sofascore.v1.TopTeams.avg_rating:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 86: sofascore.v1.TopTeams.goals_scored:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 87: sofascore.v1.TopTeams.goals_conceded:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 88: sofascore.v1.TopTeams.big_chances:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 89: sofascore.v1.TopTeams.big_chances_missed:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 90: sofascore.v1.TopTeams.hit_wood_work:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 91: sofascore.v1.TopTeams.yellow_cards:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 92: sofascore.v1.TopTeams.red_cards:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 93: sofascore.v1.TopTeams.average_ball_possession:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 94: sofascore.v1.TopTeams.accurate_passes:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 95: sofascore.v1.TopTeams.accurate_long_balls:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 96: sofascore.v1.TopTeams.accurate_crosses:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 97: sofascore.v1.TopTeams.shots:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 98: sofascore.v1.TopTeams.shots_on_target:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 99: sofascore.v1.TopTeams.successful_dribbles:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 100: sofascore.v1.TopTeams.tackles:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 101: sofascore.v1.TopTeams.interceptions:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 102: sofascore.v1.TopTeams.clearances:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 103: sofascore.v1.TopTeams.corners:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 104: sofascore.v1.TopTeams.fouls:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 105: sofascore.v1.TopTeams.penalty_goals:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 106: sofascore.v1.TopTeams.penalty_goals_conceded:type_name -> sofascore.v1.TopTeamStatItem
+	51,  // 107: sofascore.v1.TopTeams.clean_sheets:type_name -> sofascore.v1.TopTeamStatItem
+	4,   // 108: sofascore.v1.TopTeamStatItem.team:type_name -> sofascore.v1.Team
+	52,  // 109: sofascore.v1.TopTeamStatItem.statistics:type_name -> sofascore.v1.TeamLeaderboardStatistics
+	43,  // 110: sofascore.v1.StandingMessage.row:type_name -> sofascore.v1.StandingRow
+	5,   // 111: sofascore.v1.PlayerMessage.player:type_name -> sofascore.v1.Player
+	20,  // 112: sofascore.v1.MatchLineupMessage.lineup:type_name -> sofascore.v1.MatchLineup
+	22,  // 113: sofascore.v1.PlayerMatchStatsMessage.player:type_name -> sofascore.v1.MatchPlayer
+	31,  // 114: sofascore.v1.MatchStatsMessage.statistics:type_name -> sofascore.v1.StatsItem
+	46,  // 115: sofascore.v1.TeamOverallStatsMessage.statistics:type_name -> sofascore.v1.TeamOverallStats
+	50,  // 116: sofascore.v1.TopTeamsMessage.top_teams:type_name -> sofascore.v1.TopTeams
+	25,  // 117: sofascore.v1.MatchH2hMessage.h2h:type_name -> sofascore.v1.MatchH2h
+	27,  // 118: sofascore.v1.MatchBestPlayersMessage.best_players:type_name -> sofascore.v1.MatchBestPlayers
+	119, // [119:119] is the sub-list for method output_type
+	119, // [119:119] is the sub-list for method input_type
+	119, // [119:119] is the sub-list for extension type_name
+	119, // [119:119] is the sub-list for extension extendee
+	0,   // [0:119] is the sub-list for field type_name
 }
 
 func init() { file_sofascore_v1_sofascore_proto_init() }
@@ -6389,14 +7377,15 @@ func file_sofascore_v1_sofascore_proto_init() {
 	if File_sofascore_v1_sofascore_proto != nil {
 		return
 	}
-	file_sofascore_v1_sofascore_proto_msgTypes[26].OneofWrappers = []any{}
+	file_sofascore_v1_sofascore_proto_msgTypes[16].OneofWrappers = []any{}
+	file_sofascore_v1_sofascore_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sofascore_v1_sofascore_proto_rawDesc), len(file_sofascore_v1_sofascore_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   53,
+			NumMessages:   64,
 			NumExtensions: 0,
 			NumServices:   0,
 		},